@@ -0,0 +1,164 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/minio/minio/cmd/logger"
+	iampolicy "github.com/minio/minio/pkg/iam/policy"
+)
+
+// validateKeyRotationReq validates the admin request and returns the
+// object layer if it supports rotating the keys of encrypted objects.
+func validateKeyRotationReq(ctx context.Context, w http.ResponseWriter, r *http.Request) (*erasureServerPools, bool) {
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.KMSRotateKeysAdminAction)
+	if objectAPI == nil {
+		return nil, false
+	}
+
+	z, ok := objectAPI.(*erasureServerPools)
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return nil, false
+	}
+	return z, true
+}
+
+// writeKeyRotationID replies with the id of a key rotation.
+func writeKeyRotationID(ctx context.Context, w http.ResponseWriter, r *http.Request, id string) {
+	data, err := json.Marshal(struct {
+		ID string `json:"id"`
+	}{ID: id})
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// KMSRotateStartHandler - POST /minio/admin/v3/kms/rotate/start?bucket={bucket}&prefix={prefix}
+// ----------
+// Starts rotating the keys of the SSE-S3 encrypted object versions of
+// a bucket, replies with the id of the key rotation.
+func (a adminAPIHandlers) KMSRotateStartHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "KMSRotateStart")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	z, ok := validateKeyRotationReq(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	bucket := r.URL.Query().Get("bucket")
+	if bucket == "" {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidBucketName), r.URL)
+		return
+	}
+
+	id, err := z.startKeyRotation(ctx, bucket, r.URL.Query().Get("prefix"))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeKeyRotationID(ctx, w, r, id)
+}
+
+// KMSRotateResumeHandler - POST /minio/admin/v3/kms/rotate/resume
+// ----------
+// Resumes the last stopped or failed key rotation from its last saved
+// position, replies with the id of the key rotation.
+func (a adminAPIHandlers) KMSRotateResumeHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "KMSRotateResume")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	z, ok := validateKeyRotationReq(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	id, err := z.resumeKeyRotation(ctx)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeKeyRotationID(ctx, w, r, id)
+}
+
+// KMSRotateStopHandler - POST /minio/admin/v3/kms/rotate/stop
+// ----------
+// Stops the key rotation on all servers, it can be resumed later.
+func (a adminAPIHandlers) KMSRotateStopHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "KMSRotateStop")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	z, ok := validateKeyRotationReq(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	z.stopKeyRotation()
+	for _, nerr := range globalNotificationSys.StopKeyRotation() {
+		if nerr.Err != nil {
+			logger.GetReqInfo(ctx).SetTags("peerAddress", nerr.Host.String())
+			logger.LogIf(ctx, nerr.Err)
+		}
+	}
+
+	if err := z.markKeyRotationStopped(ctx); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// KMSRotateStatusHandler - GET /minio/admin/v3/kms/rotate/status
+// ----------
+// Reports the progress of the current or last key rotation.
+func (a adminAPIHandlers) KMSRotateStatusHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "KMSRotateStatus")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	z, ok := validateKeyRotationReq(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	status, err := z.getKeyRotationStatus(ctx)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	data, err := json.Marshal(status)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
@@ -0,0 +1,131 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/minio/minio/cmd/logger"
+	iampolicy "github.com/minio/minio/pkg/iam/policy"
+)
+
+// validateRebalanceReq validates the admin request and returns the
+// object layer if the server has more than one pool to rebalance.
+func validateRebalanceReq(ctx context.Context, w http.ResponseWriter, r *http.Request) (*erasureServerPools, bool) {
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.RebalanceAdminAction)
+	if objectAPI == nil {
+		return nil, false
+	}
+
+	z, ok := objectAPI.(*erasureServerPools)
+	if !ok || z.SinglePool() {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, errRebalanceSinglePool), r.URL)
+		return nil, false
+	}
+	return z, true
+}
+
+// RebalanceStartHandler - POST /minio/admin/v3/rebalance/start
+// ----------
+// Starts moving objects from the pools using more of their capacity
+// than the others, replies with the id of the rebalance.
+func (a adminAPIHandlers) RebalanceStartHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "RebalanceStart")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	z, ok := validateRebalanceReq(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	id, err := z.startRebalance(ctx)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	data, err := json.Marshal(struct {
+		ID string `json:"id"`
+	}{ID: id})
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// RebalanceStopHandler - POST /minio/admin/v3/rebalance/stop
+// ----------
+// Stops the rebalance on all servers, objects already moved stay in
+// their new pool.
+func (a adminAPIHandlers) RebalanceStopHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "RebalanceStop")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	z, ok := validateRebalanceReq(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	z.stopRebalance()
+	for _, nerr := range globalNotificationSys.StopRebalance() {
+		if nerr.Err != nil {
+			logger.GetReqInfo(ctx).SetTags("peerAddress", nerr.Host.String())
+			logger.LogIf(ctx, nerr.Err)
+		}
+	}
+
+	if err := z.markRebalanceStopped(ctx); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// RebalanceStatusHandler - GET /minio/admin/v3/rebalance/status
+// ----------
+// Reports the status of the current or last rebalance along with the
+// capacity used by every pool.
+func (a adminAPIHandlers) RebalanceStatusHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "RebalanceStatus")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	z, ok := validateRebalanceReq(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	status, err := z.getRebalanceStatus(ctx)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	data, err := json.Marshal(status)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
@@ -0,0 +1,246 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio/pkg/auth"
+	iampolicy "github.com/minio/minio/pkg/iam/policy"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+const serviceAccountsAdminPolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "admin:CreateServiceAccount",
+        "admin:ListServiceAccounts",
+        "admin:RemoveServiceAccount"
+      ]
+    }
+  ]
+}`
+
+const serviceAccountInlinePolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["s3:GetObject"],
+      "Resource": ["arn:aws:s3:::bucket/*"]
+    }
+  ]
+}`
+
+// prepareServiceAccountsTestBed sets up the IAM sub-system with the
+// user owning the service accounts, a user allowed to manage the
+// service accounts of other users and a user who is not.
+func prepareServiceAccountsTestBed(ctx context.Context, t *testing.T) (atb *adminErasureTestBed, owner, admin, user auth.Credentials) {
+	atb, err := prepareAdminErasureTestBed(ctx)
+	if err != nil {
+		t.Fatal("Failed to initialize a single node Erasure backend for admin handler tests.")
+	}
+	globalIAMSys.Init(ctx, atb.objLayer)
+
+	policy, err := iampolicy.ParseConfig(strings.NewReader(serviceAccountsAdminPolicy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = globalIAMSys.SetPolicy("serviceaccounts-admin", *policy); err != nil {
+		t.Fatal(err)
+	}
+
+	owner = auth.Credentials{AccessKey: "owner", SecretKey: "owner-secret"}
+	admin = auth.Credentials{AccessKey: "admin", SecretKey: "admin-secret"}
+	user = auth.Credentials{AccessKey: "user", SecretKey: "user-secret"}
+	for _, cred := range []auth.Credentials{owner, admin, user} {
+		if err = globalIAMSys.CreateUser(cred.AccessKey, madmin.UserInfo{
+			SecretKey: cred.SecretKey,
+			Status:    madmin.AccountEnabled,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = globalIAMSys.PolicyDBSet(admin.AccessKey, "serviceaccounts-admin", false); err != nil {
+		t.Fatal(err)
+	}
+	return atb, owner, admin, user
+}
+
+// serviceAccountRequest sends an admin request signed by cred and
+// returns the response, with its body decrypted on success.
+func serviceAccountRequest(t *testing.T, atb *adminErasureTestBed, cred auth.Credentials, method, path string, query url.Values, body interface{}) (int, []byte) {
+	var reqBody []byte
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if reqBody, err = madmin.EncryptData(cred.SecretKey, data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	req, err := newTestSignedRequestV4(method, adminPathPrefix+adminAPIVersionPrefix+path+"?"+query.Encode(),
+		int64(len(reqBody)), bytes.NewReader(reqBody), cred.AccessKey, cred.SecretKey, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rec := httptest.NewRecorder()
+	atb.router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK || rec.Body.Len() == 0 {
+		return rec.Code, rec.Body.Bytes()
+	}
+	data, err := madmin.DecryptData(cred.SecretKey, rec.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rec.Code, data
+}
+
+// Tests that the service accounts of another user are only managed by
+// users with the permission to.
+func TestServiceAccountsOfOtherUsers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	atb, owner, admin, user := prepareServiceAccountsTestBed(ctx, t)
+	defer atb.TearDown()
+
+	svcCred, err := globalIAMSys.NewServiceAccount(ctx, owner.AccessKey, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svcQuery := url.Values{"accessKey": []string{svcCred.AccessKey}}
+	userQuery := url.Values{"user": []string{owner.AccessKey}}
+	addReq := madmin.AddServiceAccountReq{TargetUser: owner.AccessKey}
+
+	// A user without the permission is denied.
+	if code, _ := serviceAccountRequest(t, atb, user, http.MethodPut, "/add-service-account", nil, addReq); code != http.StatusForbidden {
+		t.Errorf("expected add for another user to be denied, got %d", code)
+	}
+	if code, _ := serviceAccountRequest(t, atb, user, http.MethodGet, "/list-service-accounts", userQuery, nil); code != http.StatusForbidden {
+		t.Errorf("expected list of another user to be denied, got %d", code)
+	}
+	if code, _ := serviceAccountRequest(t, atb, user, http.MethodGet, "/info-service-account", svcQuery, nil); code != http.StatusNotFound {
+		t.Errorf("expected info of a foreign service account to be not found, got %d", code)
+	}
+	if code, _ := serviceAccountRequest(t, atb, user, http.MethodDelete, "/delete-service-account", svcQuery, nil); code != http.StatusNotFound {
+		t.Errorf("expected delete of a foreign service account to be not found, got %d", code)
+	}
+	if _, _, err = globalIAMSys.GetServiceAccount(ctx, svcCred.AccessKey); err != nil {
+		t.Fatalf("expected service account to be kept, got %v", err)
+	}
+
+	// A user with the permission succeeds.
+	code, data := serviceAccountRequest(t, atb, admin, http.MethodPut, "/add-service-account", nil, addReq)
+	if code != http.StatusOK {
+		t.Fatalf("expected add for another user to succeed, got %d: %s", code, data)
+	}
+	var addResp madmin.AddServiceAccountResp
+	if err = json.Unmarshal(data, &addResp); err != nil {
+		t.Fatal(err)
+	}
+	if parent, _ := globalIAMSys.GetServiceAccountParent(ctx, addResp.Credentials.AccessKey); parent != owner.AccessKey {
+		t.Fatalf("expected service account of %s, got %s", owner.AccessKey, parent)
+	}
+
+	code, data = serviceAccountRequest(t, atb, admin, http.MethodGet, "/list-service-accounts", userQuery, nil)
+	if code != http.StatusOK {
+		t.Fatalf("expected list of another user to succeed, got %d: %s", code, data)
+	}
+	var listResp madmin.ListServiceAccountsResp
+	if err = json.Unmarshal(data, &listResp); err != nil {
+		t.Fatal(err)
+	}
+	if len(listResp.Accounts) != 2 {
+		t.Fatalf("expected 2 service accounts, got %v", listResp.Accounts)
+	}
+
+	code, data = serviceAccountRequest(t, atb, admin, http.MethodGet, "/info-service-account", svcQuery, nil)
+	if code != http.StatusOK {
+		t.Fatalf("expected info of another user's service account to succeed, got %d: %s", code, data)
+	}
+	var infoResp madmin.InfoServiceAccountResp
+	if err = json.Unmarshal(data, &infoResp); err != nil {
+		t.Fatal(err)
+	}
+	if infoResp.ParentUser != owner.AccessKey || !infoResp.ImpliedPolicy || infoResp.AccountStatus != madmin.AccountEnabled {
+		t.Fatalf("unexpected service account info %#v", infoResp)
+	}
+
+	if code, data = serviceAccountRequest(t, atb, admin, http.MethodDelete, "/delete-service-account", svcQuery, nil); code != http.StatusNoContent {
+		t.Fatalf("expected delete of another user's service account to succeed, got %d: %s", code, data)
+	}
+	if _, _, err = globalIAMSys.GetServiceAccount(ctx, svcCred.AccessKey); err != errNoSuchServiceAccount {
+		t.Fatalf("expected service account to be deleted, got %v", err)
+	}
+}
+
+func TestGetServiceAccount(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	atb, owner, _, user := prepareServiceAccountsTestBed(ctx, t)
+	defer atb.TearDown()
+
+	inline, err := iampolicy.ParseConfig(strings.NewReader(serviceAccountInlinePolicy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		policy *iampolicy.Policy
+	}{
+		{nil},
+		{inline},
+	}
+	for i, testCase := range testCases {
+		cred, err := globalIAMSys.NewServiceAccount(ctx, owner.AccessKey, nil, testCase.policy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sa, policy, err := globalIAMSys.GetServiceAccount(ctx, cred.AccessKey)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if sa.AccessKey != cred.AccessKey || sa.ParentUser != owner.AccessKey {
+			t.Errorf("Test %d: expected service account %s of %s, got %s of %s", i+1, cred.AccessKey, owner.AccessKey, sa.AccessKey, sa.ParentUser)
+		}
+		if testCase.policy == nil && policy != nil {
+			t.Errorf("Test %d: expected no inline policy, got %v", i+1, policy)
+		}
+		if testCase.policy != nil && (policy == nil || !reflect.DeepEqual(policy, testCase.policy)) {
+			t.Errorf("Test %d: expected inline policy %v, got %v", i+1, testCase.policy, policy)
+		}
+	}
+
+	// Regular users are not service accounts.
+	if _, _, err = globalIAMSys.GetServiceAccount(ctx, user.AccessKey); err != errNoSuchServiceAccount {
+		t.Errorf("expected %v, got %v", errNoSuchServiceAccount, err)
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -52,6 +53,10 @@ import (
 
 const (
 	maxEConfigJSONSize = 262272
+
+	// Locks acquired more recently are not force unlocked, unless
+	// the request sets a different age.
+	forceUnlockDefaultOlderThan = time.Minute
 )
 
 // Only valid query params for mgmt admin APIs.
@@ -230,6 +235,83 @@ func (a adminAPIHandlers) ServiceHandler(w http.ResponseWriter, r *http.Request)
 	globalServiceSignalCh <- serviceSig
 }
 
+// MaintenanceHandler - POST /minio/admin/v3/maintenance?action={action}
+// ----------
+// Pauses (action=start) or resumes (action=stop) heal, scanner,
+// lifecycle, replication and rebalance on all servers, S3 requests
+// continue to be served while in maintenance mode.
+func (a adminAPIHandlers) MaintenanceHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "Maintenance")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.MaintenanceAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	vars := mux.Vars(r)
+	action := madmin.MaintenanceAction(vars["action"])
+	switch action {
+	case madmin.MaintenanceActionStart:
+		globalMaintenance.enable()
+	case madmin.MaintenanceActionStop:
+		globalMaintenance.disable()
+	default:
+		logger.LogIf(ctx, fmt.Errorf("Unrecognized maintenance action %s requested", action), logger.Application)
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrMalformedPOSTRequest), r.URL)
+		return
+	}
+
+	// Notify all other MinIO peers to enter or leave maintenance mode.
+	for _, nerr := range globalNotificationSys.Maintenance(action) {
+		if nerr.Err != nil {
+			logger.GetReqInfo(ctx).SetTags("peerAddress", nerr.Host.String())
+			logger.LogIf(ctx, nerr.Err)
+		}
+	}
+
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// MaintenanceStatusHandler - GET /minio/admin/v3/maintenance/status
+// ----------
+// Reports the background subsystems paused on each server.
+func (a adminAPIHandlers) MaintenanceStatusHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "MaintenanceStatus")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.MaintenanceAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	status := madmin.MaintenanceStatus{
+		Enabled: true,
+		Servers: []madmin.ServerMaintenanceStatus{globalMaintenance.status()},
+	}
+	for _, st := range globalNotificationSys.MaintenanceStatus() {
+		if st.Endpoint == "" {
+			continue
+		}
+		status.Servers = append(status.Servers, st)
+	}
+	for _, st := range status.Servers {
+		if !st.Enabled {
+			status.Enabled = false
+		}
+	}
+
+	data, err := json.Marshal(status)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
 // ServerProperties holds some server information such as, version, region
 // uptime, etc..
 type ServerProperties struct {
@@ -354,12 +436,14 @@ func (a adminAPIHandlers) DataUsageInfoHandler(w http.ResponseWriter, r *http.Re
 func lriToLockEntry(l lockRequesterInfo, resource, server string) *madmin.LockEntry {
 	entry := &madmin.LockEntry{
 		Timestamp:  l.Timestamp,
+		Elapsed:    UTCNow().Sub(l.Timestamp),
 		Resource:   resource,
 		ServerList: []string{server},
 		Source:     l.Source,
 		Owner:      l.Owner,
 		ID:         l.UID,
 		Quorum:     l.Quorum,
+		Waiting:    l.Waiting,
 	}
 	if l.Writer {
 		entry.Type = "WRITE"
@@ -369,29 +453,38 @@ func lriToLockEntry(l lockRequesterInfo, resource, server string) *madmin.LockEn
 	return entry
 }
 
-func topLockEntries(peerLocks []*PeerLocks, stale bool) madmin.LockEntries {
-	entryMap := make(map[string]*madmin.LockEntry)
+func topLockEntries(peerLocks []*PeerLocks, opts madmin.TopLockOpts) madmin.LockEntries {
+	// A request is waiting on the node which made it only, while
+	// granted on several nodes, hence keep them apart.
+	type lockKey struct {
+		uid     string
+		waiting bool
+	}
+	entryMap := make(map[lockKey]*madmin.LockEntry)
 	for _, peerLock := range peerLocks {
 		if peerLock == nil {
 			continue
 		}
 		for k, v := range peerLock.Locks {
 			for _, lockReqInfo := range v {
-				if val, ok := entryMap[lockReqInfo.UID]; ok {
+				if lockReqInfo.Waiting && !opts.Waiting {
+					continue
+				}
+				key := lockKey{lockReqInfo.UID, lockReqInfo.Waiting}
+				if val, ok := entryMap[key]; ok {
 					val.ServerList = append(val.ServerList, peerLock.Addr)
 				} else {
-					entryMap[lockReqInfo.UID] = lriToLockEntry(lockReqInfo, k, peerLock.Addr)
+					entryMap[key] = lriToLockEntry(lockReqInfo, k, peerLock.Addr)
 				}
 			}
 		}
 	}
 	var lockEntries madmin.LockEntries
 	for _, v := range entryMap {
-		if stale {
-			lockEntries = append(lockEntries, *v)
+		if v.Elapsed < opts.OlderThan {
 			continue
 		}
-		if len(v.ServerList) >= v.Quorum {
+		if opts.Stale || v.Waiting || len(v.ServerList) >= v.Quorum {
 			lockEntries = append(lockEntries, *v)
 		}
 	}
@@ -424,13 +517,35 @@ func (a adminAPIHandlers) ForceUnlockHandler(w http.ResponseWriter, r *http.Requ
 
 	vars := mux.Vars(r)
 
+	olderThan := forceUnlockDefaultOlderThan
+	if olderThanStr := r.URL.Query().Get("older-than"); olderThanStr != "" {
+		var err error
+		olderThan, err = time.ParseDuration(olderThanStr)
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+	}
+
 	var args dsync.LockArgs
-	lockersMap := make(map[string]dsync.NetLocker)
 	for _, path := range strings.Split(vars["paths"], ",") {
-		if path == "" {
-			continue
+		if path != "" {
+			args.Resources = append(args.Resources, path)
+		}
+	}
+
+	// Refuse to unlock resources locked recently, such locks
+	// most likely belong to operations which are still running.
+	if olderThan > 0 {
+		locks := topLockEntries(globalNotificationSys.GetLocks(ctx, r), madmin.TopLockOpts{Stale: true})
+		if err := checkForceUnlock(locks, args.Resources, olderThan); err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
 		}
-		args.Resources = append(args.Resources, path)
+	}
+
+	lockersMap := make(map[string]dsync.NetLocker)
+	for _, path := range args.Resources {
 		lockers, _ := z.serverPools[0].getHashedSet(path).getLockers()
 		for _, locker := range lockers {
 			if locker != nil {
@@ -444,6 +559,23 @@ func (a adminAPIHandlers) ForceUnlockHandler(w http.ResponseWriter, r *http.Requ
 	}
 }
 
+// checkForceUnlock returns an error if one of the resources has a
+// lock which was acquired less than olderThan ago.
+func checkForceUnlock(locks madmin.LockEntries, resources []string, olderThan time.Duration) error {
+	for _, lock := range locks {
+		for _, resource := range resources {
+			if lock.Resource == resource && lock.Elapsed < olderThan {
+				return AdminError{
+					Code:       AdminForceUnlockRecentLock,
+					Message:    fmt.Sprintf("%s was locked %s ago by %s, which is less than %s", resource, lock.Elapsed.Round(time.Second), lock.Owner, olderThan),
+					StatusCode: http.StatusConflict,
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // TopLocksHandler Get list of locks in use
 func (a adminAPIHandlers) TopLocksHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := newContext(r, w, "TopLocks")
@@ -464,11 +596,22 @@ func (a adminAPIHandlers) TopLocksHandler(w http.ResponseWriter, r *http.Request
 			return
 		}
 	}
-	stale := r.URL.Query().Get("stale") == "true" // list also stale locks
+	opts := madmin.TopLockOpts{
+		Stale:   r.URL.Query().Get("stale") == "true",   // list also stale locks
+		Waiting: r.URL.Query().Get("waiting") == "true", // list also lock requests not granted yet
+	}
+	if olderThanStr := r.URL.Query().Get("older-than"); olderThanStr != "" {
+		var err error
+		opts.OlderThan, err = time.ParseDuration(olderThanStr)
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+	}
 
 	peerLocks := globalNotificationSys.GetLocks(ctx, r)
 
-	topLocks := topLockEntries(peerLocks, stale)
+	topLocks := topLockEntries(peerLocks, opts)
 
 	// Marshal API response upto requested count.
 	if len(topLocks) > count && count > 0 {
@@ -684,12 +827,48 @@ func extractHealInitParams(vars map[string]string, qParms url.Values, r io.Reade
 			err = ErrRequestBodyParse
 			return
 		}
+		if !hip.hs.ModifiedAfter.IsZero() && !hip.hs.ModifiedBefore.IsZero() &&
+			!hip.hs.ModifiedAfter.Before(hip.hs.ModifiedBefore) {
+			err = ErrInvalidRequest
+			return
+		}
+		if (hip.hs.Pool != nil && *hip.hs.Pool < 0) || (hip.hs.Set != nil && *hip.hs.Set < 0) {
+			err = ErrInvalidRequest
+			return
+		}
+		// Dangling entries are only purged when looked for.
+		if hip.hs.PurgeDangling && !hip.hs.Dangling {
+			err = ErrInvalidRequest
+			return
+		}
 	}
 
 	err = ErrNone
 	return
 }
 
+// validateHealTarget checks that the pool and erasure set targeted
+// by the heal options exist, a set without a pool must exist in at
+// least one pool.
+func validateHealTarget(objAPI ObjectLayer, opts madmin.HealOpts) APIErrorCode {
+	z, ok := objAPI.(*erasureServerPools)
+	if !ok || (opts.Pool == nil && opts.Set == nil) {
+		return ErrNone
+	}
+	if opts.Pool != nil && *opts.Pool >= len(z.serverPools) {
+		return ErrInvalidRequest
+	}
+	for poolIndex, pool := range z.serverPools {
+		if opts.Pool != nil && *opts.Pool != poolIndex {
+			continue
+		}
+		if opts.Set == nil || *opts.Set < len(pool.sets) {
+			return ErrNone
+		}
+	}
+	return ErrInvalidRequest
+}
+
 // HealHandler - POST /minio/admin/v3/heal/
 // -----------
 // Start heal processing and return heal status items.
@@ -720,6 +899,9 @@ func (a adminAPIHandlers) HealHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	hip, errCode := extractHealInitParams(mux.Vars(r), r.URL.Query(), r.Body)
+	if errCode == ErrNone {
+		errCode = validateHealTarget(objectAPI, hip.hs)
+	}
 	if errCode != ErrNone {
 		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(errCode), r.URL)
 		return
@@ -861,8 +1043,27 @@ func (a adminAPIHandlers) HealHandler(w http.ResponseWriter, r *http.Request) {
 	keepConnLive(w, r, respCh)
 }
 
+// newBgHealNodeState returns the heal status breakdown of a single server.
+func newBgHealNodeState(node string, state madmin.BgHealState, err error) madmin.BgHealNodeState {
+	nodeState := madmin.BgHealNodeState{Node: node}
+	if err != nil {
+		nodeState.Error = err.Error()
+		return nodeState
+	}
+	nodeState.ScannedItemsCount = state.ScannedItemsCount
+	for _, count := range state.HealedItemsByType {
+		nodeState.HealedItemsCount += count
+	}
+	nodeState.FailedItemsCount = int64(len(state.HealFailedItems))
+	nodeState.LastHealActivity = state.LastHealActivity
+	nodeState.HealDisks = state.HealDisks
+	nodeState.Paused = state.Paused
+	return nodeState
+}
+
 func getAggregatedBackgroundHealState(ctx context.Context) (madmin.BgHealState, error) {
 	var bgHealStates []madmin.BgHealState
+	var nodes []madmin.BgHealNodeState
 
 	localHealState, ok := getLocalBackgroundHealStatus()
 	if !ok {
@@ -871,16 +1072,18 @@ func getAggregatedBackgroundHealState(ctx context.Context) (madmin.BgHealState,
 
 	// Get local heal status first
 	bgHealStates = append(bgHealStates, localHealState)
+	nodes = append(nodes, newBgHealNodeState(localHealState.Node, localHealState, nil))
 
 	if globalIsDistErasure {
 		// Get heal status from other peers
 		peersHealStates, nerrs := globalNotificationSys.BackgroundHealStatus()
 		var errCount int
-		for _, nerr := range nerrs {
+		for i, nerr := range nerrs {
 			if nerr.Err != nil {
 				logger.LogIf(ctx, nerr.Err)
 				errCount++
 			}
+			nodes = append(nodes, newBgHealNodeState(nerr.Host.String(), peersHealStates[i], nerr.Err))
 		}
 		if errCount == len(nerrs) {
 			return madmin.BgHealState{}, fmt.Errorf("all remote servers failed to report heal status, cluster is unhealthy")
@@ -890,16 +1093,64 @@ func getAggregatedBackgroundHealState(ctx context.Context) (madmin.BgHealState,
 
 	// Aggregate healing result
 	var aggregatedHealStateResult = madmin.BgHealState{
-		ScannedItemsCount: bgHealStates[0].ScannedItemsCount,
-		LastHealActivity:  bgHealStates[0].LastHealActivity,
-		NextHealRound:     bgHealStates[0].NextHealRound,
-		HealDisks:         bgHealStates[0].HealDisks,
+		ScannedItemsCount:     bgHealStates[0].ScannedItemsCount,
+		LastHealActivity:      bgHealStates[0].LastHealActivity,
+		NextHealRound:         bgHealStates[0].NextHealRound,
+		HealDisks:             bgHealStates[0].HealDisks,
+		DiskHealProgress:      make(map[string]madmin.HealDriveProgress),
+		DiskErrorHealCount:    bgHealStates[0].DiskErrorHealCount,
+		HealFailedItems:       bgHealStates[0].HealFailedItems,
+		InterruptedItemsCount: bgHealStates[0].InterruptedItemsCount,
+		SampledItemsCount:     bgHealStates[0].SampledItemsCount,
+		ExpiringItemsCount:    bgHealStates[0].ExpiringItemsCount,
+		Paused:                bgHealStates[0].Paused,
+		ScannedItemsByType:    make(map[madmin.HealItemType]int64),
+		HealedItemsByType:     make(map[madmin.HealItemType]int64),
+		DryRun:                bgHealStates[0].DryRun,
+		WouldHealItemsByType:  make(map[madmin.HealItemType]int64),
+		Nodes:                 nodes,
+	}
+
+	for _, state := range bgHealStates {
+		if state.Summary != nil {
+			if aggregatedHealStateResult.Summary == nil {
+				aggregatedHealStateResult.Summary = &madmin.HealSummary{}
+			}
+			summary := aggregatedHealStateResult.Summary
+			summary.ScannedItemsCount += state.Summary.ScannedItemsCount
+			summary.HealedItemsCount += state.Summary.HealedItemsCount
+			summary.FailedItemsCount += state.Summary.FailedItemsCount
+			summary.InterruptedItemsCount += state.Summary.InterruptedItemsCount
+			if state.Summary.Stopped.After(summary.Stopped) {
+				summary.Stopped = state.Summary.Stopped
+			}
+		}
+		for itemType, count := range state.ScannedItemsByType {
+			aggregatedHealStateResult.ScannedItemsByType[itemType] += count
+		}
+		for itemType, count := range state.HealedItemsByType {
+			aggregatedHealStateResult.HealedItemsByType[itemType] += count
+		}
+		for itemType, count := range state.WouldHealItemsByType {
+			aggregatedHealStateResult.WouldHealItemsByType[itemType] += count
+		}
+		for disk, progress := range state.DiskHealProgress {
+			aggregatedHealStateResult.DiskHealProgress[disk] = progress
+		}
+		aggregatedHealStateResult.SetHealProgress = append(aggregatedHealStateResult.SetHealProgress, state.SetHealProgress...)
 	}
 
 	bgHealStates = bgHealStates[1:]
 
 	for _, state := range bgHealStates {
 		aggregatedHealStateResult.ScannedItemsCount += state.ScannedItemsCount
+		aggregatedHealStateResult.DiskErrorHealCount += state.DiskErrorHealCount
+		aggregatedHealStateResult.InterruptedItemsCount += state.InterruptedItemsCount
+		aggregatedHealStateResult.SampledItemsCount += state.SampledItemsCount
+		aggregatedHealStateResult.ExpiringItemsCount += state.ExpiringItemsCount
+		// Healing is reported paused only if paused on all servers.
+		aggregatedHealStateResult.Paused = aggregatedHealStateResult.Paused && state.Paused
+		aggregatedHealStateResult.HealFailedItems = append(aggregatedHealStateResult.HealFailedItems, state.HealFailedItems...)
 		aggregatedHealStateResult.HealDisks = append(aggregatedHealStateResult.HealDisks, state.HealDisks...)
 		if !state.LastHealActivity.IsZero() && aggregatedHealStateResult.LastHealActivity.Before(state.LastHealActivity) {
 			aggregatedHealStateResult.LastHealActivity = state.LastHealActivity
@@ -911,6 +1162,17 @@ func getAggregatedBackgroundHealState(ctx context.Context) (madmin.BgHealState,
 		}
 	}
 
+	sortSetHealProgress(aggregatedHealStateResult.SetHealProgress)
+
+	// Report the most recent heal failures across all servers.
+	failedItems := aggregatedHealStateResult.HealFailedItems
+	sort.Slice(failedItems, func(i, j int) bool {
+		return failedItems[i].LastAttempt.After(failedItems[j].LastAttempt)
+	})
+	if len(failedItems) > healFailedItemsMax {
+		aggregatedHealStateResult.HealFailedItems = failedItems[:healFailedItemsMax]
+	}
+
 	return aggregatedHealStateResult, nil
 }
 
@@ -944,6 +1206,435 @@ func (a adminAPIHandlers) BackgroundHealStatusHandler(w http.ResponseWriter, r *
 	w.(http.Flusher).Flush()
 }
 
+// BackgroundHealActionHandler - POST /minio/admin/v3/background-heal/{action}
+// ----------
+// Pauses (action=pause) or resumes (action=resume) background healing
+// on all servers, objects already queued for heal are held until
+// healing is resumed.
+func (a adminAPIHandlers) BackgroundHealActionHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "HealBackgroundAction")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	// Check if this setup has an erasure coded backend.
+	if !globalIsErasure {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrHealNotImplemented), r.URL)
+		return
+	}
+
+	action := madmin.BgHealAction(mux.Vars(r)["action"])
+	if err := backgroundHealAction(action); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	// Notify all other MinIO peers to pause or resume background healing.
+	for _, nerr := range globalNotificationSys.BackgroundHealAction(action) {
+		if nerr.Err != nil {
+			logger.GetReqInfo(ctx).SetTags("peerAddress", nerr.Host.String())
+			logger.LogIf(ctx, nerr.Err)
+		}
+	}
+
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// BackgroundHealThrottleHandler - GET /minio/admin/v3/background-heal/throttle
+// ----------
+// Returns the settings which currently throttle background healing.
+func (a adminAPIHandlers) BackgroundHealThrottleHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "HealBackgroundThrottle")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	// Check if this setup has an erasure coded backend.
+	if !globalIsErasure {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrHealNotImplemented), r.URL)
+		return
+	}
+
+	data, err := json.Marshal(healThrottleSettings(currentHealConfig()))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// SetBackgroundHealThrottleHandler - PUT /minio/admin/v3/background-heal/throttle
+// ----------
+// Saves the given throttle settings to the heal config and applies them
+// on all servers, heal rounds in progress pick them up immediately.
+func (a adminAPIHandlers) SetBackgroundHealThrottleHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "SetHealBackgroundThrottle")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	// Check if this setup has an erasure coded backend.
+	if !globalIsErasure {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrHealNotImplemented), r.URL)
+		return
+	}
+
+	var throttle madmin.HealThrottle
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxEConfigJSONSize)).Decode(&throttle); err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrRequestBodyParse), r.URL)
+		return
+	}
+	if throttle.Sleep < 0 || throttle.IOCount < 0 || throttle.Workers < 0 || throttle.MaxIOPS < 0 {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminInvalidArgument), r.URL)
+		return
+	}
+
+	cfg, err := readServerConfig(ctx, objectAPI)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	kvs := cfg[config.HealSubSys][config.Default]
+	setHealThrottleSettings(&kvs, throttle)
+	cfg[config.HealSubSys][config.Default] = kvs
+
+	if err = validateConfig(cfg, objectAPI.SetDriveCounts()); err != nil {
+		writeCustomErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminConfigBadJSON), err.Error(), r.URL)
+		return
+	}
+
+	if err = saveServerConfig(ctx, objectAPI, cfg); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	if err = applyDynamicConfig(GlobalContext, objectAPI, cfg); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	// Other servers reload the saved heal config.
+	globalNotificationSys.SignalService(serviceReloadDynamic)
+
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// BackgroundHealReportHandler - GET /minio/admin/v3/background-heal/report
+// ----------
+// Returns the items which the current dry-run round of background
+// healing would repair on all servers.
+func (a adminAPIHandlers) BackgroundHealReportHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "HealBackgroundReport")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	// Check if this setup has an erasure coded backend.
+	if !globalIsErasure {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrHealNotImplemented), r.URL)
+		return
+	}
+
+	report, ok := getLocalBackgroundHealReport()
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	reports := []madmin.HealReport{report}
+	peerReports, nerrs := globalNotificationSys.BackgroundHealReport()
+	for i, nerr := range nerrs {
+		if nerr.Err != nil {
+			logger.GetReqInfo(ctx).SetTags("peerAddress", nerr.Host.String())
+			logger.LogIf(ctx, nerr.Err)
+			continue
+		}
+		reports = append(reports, peerReports[i])
+	}
+
+	data, err := json.Marshal(mergeHealReports(reports...))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// BackgroundHealScrubReportsHandler - GET /minio/admin/v3/background-heal/scrub-reports
+// ----------
+// Returns a summary of the saved scrub reports, oldest first.
+func (a adminAPIHandlers) BackgroundHealScrubReportsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "HealBackgroundScrubReports")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	// Check if this setup has an erasure coded backend.
+	if !globalIsErasure {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrHealNotImplemented), r.URL)
+		return
+	}
+
+	reports, err := listHealScrubReports(ctx, objectAPI)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	data, err := json.Marshal(reports)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// BackgroundHealScrubReportHandler - GET /minio/admin/v3/background-heal/scrub-report?id={id}
+// ----------
+// Returns the saved scrub report with the given id as JSON.
+func (a adminAPIHandlers) BackgroundHealScrubReportHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "HealBackgroundScrubReport")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	// Check if this setup has an erasure coded backend.
+	if !globalIsErasure {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrHealNotImplemented), r.URL)
+		return
+	}
+
+	report, err := loadHealScrubReport(ctx, objectAPI, r.URL.Query().Get("id"))
+	if err != nil {
+		switch {
+		case errors.Is(err, errHealScrubReportInvalidID):
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrInvalidRequest), r.URL)
+		case errors.Is(err, errConfigNotFound):
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrHealNoSuchScrubReport), r.URL)
+		default:
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		}
+		return
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// BackgroundHealDeadLettersHandler - GET /minio/admin/v3/background-heal/dead-letters
+// ----------
+// Returns the objects which background healing could not heal
+// after all retries on all servers, latest first.
+func (a adminAPIHandlers) BackgroundHealDeadLettersHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "HealBackgroundDeadLetters")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	// Check if this setup has an erasure coded backend.
+	if !globalIsErasure {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrHealNotImplemented), r.URL)
+		return
+	}
+
+	items, ok := getLocalBackgroundHealDeadLetters()
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	peerItems, nerrs := globalNotificationSys.BackgroundHealDeadLetters()
+	for i, nerr := range nerrs {
+		if nerr.Err != nil {
+			logger.GetReqInfo(ctx).SetTags("peerAddress", nerr.Host.String())
+			logger.LogIf(ctx, nerr.Err)
+			continue
+		}
+		items = append(items, peerItems[i]...)
+	}
+	sortHealDeadLetters(items)
+
+	data, err := json.Marshal(items)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// BackgroundHealQueueHandler - GET /minio/admin/v3/background-heal/queue
+// ----------
+// Streams the items queued for background healing on all servers,
+// one JSON object per item.
+func (a adminAPIHandlers) BackgroundHealQueueHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "HealBackgroundQueue")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	// Check if this setup has an erasure coded backend.
+	if !globalIsErasure {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrHealNotImplemented), r.URL)
+		return
+	}
+
+	items, ok := getLocalBackgroundHealQueue()
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	w.Header().Set(xhttp.ContentType, "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	enc := json.NewEncoder(w)
+	writeItems := func(items []madmin.HealQueueItem) bool {
+		for _, item := range items {
+			if err := enc.Encode(item); err != nil {
+				return false
+			}
+		}
+		w.(http.Flusher).Flush()
+		return true
+	}
+	if !writeItems(items) {
+		return
+	}
+
+	queues, nerrs := globalNotificationSys.BackgroundHealQueue()
+	for i, nerr := range nerrs {
+		if nerr.Err != nil {
+			logger.GetReqInfo(ctx).SetTags("peerAddress", nerr.Host.String())
+			logger.LogIf(ctx, nerr.Err)
+			continue
+		}
+		if !writeItems(queues[i]) {
+			return
+		}
+	}
+}
+
+// extractHealObjectsParams - Validates the object versions of the heal objects API.
+func extractHealObjectsParams(r io.Reader) (items []madmin.HealObjectsItem, err APIErrorCode) {
+	if jerr := json.NewDecoder(r).Decode(&items); jerr != nil {
+		logger.LogIf(GlobalContext, jerr, logger.Application)
+		return nil, ErrRequestBodyParse
+	}
+	if len(items) == 0 || len(items) > healObjectsMaxItems {
+		return nil, ErrInvalidRequest
+	}
+	for _, item := range items {
+		if isReservedOrInvalidBucket(item.Bucket, false) {
+			return nil, ErrInvalidBucketName
+		}
+		if !IsValidObjectName(item.Object) {
+			return nil, ErrInvalidObjectName
+		}
+	}
+	return items, ErrNone
+}
+
+// HealObjectsHandler - POST /minio/admin/v3/heal-objects
+// -----------
+// Heals the given object versions through background healing, without
+// crawling, and returns the outcome of each of them once all are healed.
+func (a adminAPIHandlers) HealObjectsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "HealObjects")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	// Check if this setup has an erasure coded backend.
+	if !globalIsErasure {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrHealNotImplemented), r.URL)
+		return
+	}
+
+	items, errCode := extractHealObjectsParams(r.Body)
+	if errCode != ErrNone {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(errCode), r.URL)
+		return
+	}
+
+	bgSeq, ok := globalBackgroundHealState.getHealSequenceByToken(bgHealingUUID)
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrServerNotInitialized), r.URL)
+		return
+	}
+
+	resultsCh := make(chan []madmin.HealObjectsResult, 1)
+	go func() {
+		resultsCh <- bgSeq.healObjects(r.Context(), items)
+	}()
+
+	setCommonHeaders(w)
+	w.Header().Set(xhttp.ContentType, string(mimeJSON))
+	w.WriteHeader(http.StatusOK)
+
+	// Send whitespace to keep the connection open until all
+	// object versions are healed.
+	ticker := time.NewTicker(time.Second * 10)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			w.Write([]byte(" "))
+			w.(http.Flusher).Flush()
+		case results := <-resultsCh:
+			if err := json.NewEncoder(w).Encode(results); err != nil {
+				logger.LogIf(ctx, err)
+			}
+			w.(http.Flusher).Flush()
+			return
+		}
+	}
+}
+
 func validateAdminReq(ctx context.Context, w http.ResponseWriter, r *http.Request, action iampolicy.AdminAction) (ObjectLayer, auth.Credentials) {
 	var cred auth.Credentials
 	var adminAPIErr APIErrorCode
@@ -980,6 +1671,7 @@ const (
 	AdminUpdateUnexpectedFailure = "XMinioAdminUpdateUnexpectedFailure"
 	AdminUpdateURLNotReachable   = "XMinioAdminUpdateURLNotReachable"
 	AdminUpdateApplyFailure      = "XMinioAdminUpdateApplyFailure"
+	AdminForceUnlockRecentLock   = "XMinioAdminForceUnlockRecentLock"
 )
 
 // toAdminAPIErrCode - converts errErasureWriteQuorum error to admin API
@@ -1056,22 +1748,81 @@ func toAdminAPIErr(ctx context.Context, err error) APIError {
 // - input entry is not of the type *trace.Info*
 // - errOnly entries are to be traced, not status code 2xx, 3xx.
 // - all entries to be traced, if not trace only S3 API requests.
-func mustTrace(entry interface{}, trcAll, errOnly bool) bool {
+// traceOpts are the trace categories a trace subscriber asked for.
+type traceOpts struct {
+	s3         bool // S3 API calls
+	internal   bool // internode calls, including storage REST calls
+	storage    bool // storage layer calls on the local drives
+	os         bool // OS calls on the local drives
+	onlyErrors bool // only HTTP calls which failed
+}
+
+// getTraceOpts returns the trace categories of the query. Without the
+// 's3' key, S3 API calls are traced like by clients predating the
+// storage and OS trace categories.
+func getTraceOpts(query url.Values) traceOpts {
+	opts := traceOpts{
+		s3:         true,
+		internal:   query.Get(peerRESTTraceAll) == "true",
+		storage:    query.Get(peerRESTTraceStorage) == "true",
+		os:         query.Get(peerRESTTraceOS) == "true",
+		onlyErrors: query.Get(peerRESTTraceErr) == "true",
+	}
+	if _, ok := query[peerRESTTraceS3]; ok {
+		opts.s3 = query.Get(peerRESTTraceS3) == "true"
+	}
+	return opts
+}
+
+// encode sets the trace categories to the query.
+func (opts traceOpts) encode(query url.Values) {
+	query.Set(peerRESTTraceS3, strconv.FormatBool(opts.s3))
+	query.Set(peerRESTTraceAll, strconv.FormatBool(opts.internal))
+	query.Set(peerRESTTraceStorage, strconv.FormatBool(opts.storage))
+	query.Set(peerRESTTraceOS, strconv.FormatBool(opts.os))
+	query.Set(peerRESTTraceErr, strconv.FormatBool(opts.onlyErrors))
+}
+
+// subscribe subscribes traceCh to the trace systems of the trace
+// categories until doneCh is closed.
+func (opts traceOpts) subscribe(traceCh chan interface{}, doneCh <-chan struct{}) {
+	if opts.s3 || opts.internal {
+		globalHTTPTrace.Subscribe(traceCh, doneCh, func(entry interface{}) bool {
+			return mustTrace(entry, opts)
+		})
+	}
+	if opts.storage {
+		globalStorageTrace.Subscribe(traceCh, doneCh, nil)
+	}
+	if opts.os {
+		globalOSTrace.Subscribe(traceCh, doneCh, nil)
+	}
+}
+
+func mustTrace(entry interface{}, opts traceOpts) bool {
 	trcInfo, ok := entry.(trace.Info)
 	if !ok {
 		return false
 	}
 
+	if trcInfo.TraceType != trace.HTTP {
+		return (trcInfo.TraceType == trace.Storage && opts.storage) ||
+			(trcInfo.TraceType == trace.OS && opts.os)
+	}
+
 	// Handle browser requests separately filter them and return.
 	if HasPrefix(trcInfo.ReqInfo.Path, minioReservedBucketPath+"/upload") {
-		if errOnly {
-			return trcInfo.RespInfo.StatusCode >= http.StatusBadRequest
+		if opts.onlyErrors {
+			return opts.s3 && trcInfo.RespInfo.StatusCode >= http.StatusBadRequest
 		}
-		return true
+		return opts.s3
 	}
 
-	trace := trcAll || !HasPrefix(trcInfo.ReqInfo.Path, minioReservedBucketPath+SlashSeparator)
-	if errOnly {
+	trace := opts.s3
+	if HasPrefix(trcInfo.ReqInfo.Path, minioReservedBucketPath+SlashSeparator) {
+		trace = opts.internal
+	}
+	if opts.onlyErrors {
 		return trace && trcInfo.RespInfo.StatusCode >= http.StatusBadRequest
 	}
 	return trace
@@ -1079,12 +1830,12 @@ func mustTrace(entry interface{}, trcAll, errOnly bool) bool {
 
 // TraceHandler - POST /minio/admin/v3/trace
 // ----------
-// The handler sends http trace to the connected HTTP client.
+// The handler sends http trace to the connected HTTP client, and on
+// request the traces of the storage layer and OS calls on the drives.
 func (a adminAPIHandlers) TraceHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := newContext(r, w, "HTTPTrace")
 
-	trcAll := r.URL.Query().Get("all") == "true"
-	trcErr := r.URL.Query().Get("err") == "true"
+	traceOpts := getTraceOpts(r.URL.Query())
 
 	// Validate request signature.
 	_, adminAPIErr := checkAdminRequestAuth(ctx, r, iampolicy.TraceAdminAction, "")
@@ -1101,15 +1852,13 @@ func (a adminAPIHandlers) TraceHandler(w http.ResponseWriter, r *http.Request) {
 
 	peers, _ := newPeerRestClients(globalEndpoints)
 
-	globalHTTPTrace.Subscribe(traceCh, ctx.Done(), func(entry interface{}) bool {
-		return mustTrace(entry, trcAll, trcErr)
-	})
+	traceOpts.subscribe(traceCh, ctx.Done())
 
 	for _, peer := range peers {
 		if peer == nil {
 			continue
 		}
-		peer.Trace(traceCh, ctx.Done(), trcAll, trcErr)
+		peer.Trace(traceCh, ctx.Done(), traceOpts)
 	}
 
 	keepAliveTicker := time.NewTicker(500 * time.Millisecond)
@@ -1530,6 +2279,91 @@ func (a adminAPIHandlers) BandwidthMonitorHandler(w http.ResponseWriter, r *http
 	}
 }
 
+// DrivesHealthHandler - GET /minio/admin/v3/drive-health
+// ----------
+// Get the health of the drives of all servers as last checked by
+// the drive health monitor of each server.
+func (a adminAPIHandlers) DrivesHealthHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "DrivesHealth")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealthInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	info := madmin.DrivesHealthInfo{
+		Servers: append(globalNotificationSys.DrivesHealth(), getLocalDrivesHealth()),
+	}
+
+	data, err := json.Marshal(info)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
+// SpeedtestHandler - POST /minio/admin/v3/speedtest?size={size}&blocksize={blocksize}&drive={bool}&net={bool}
+// ----------
+// Runs an on-demand drive and network speedtest on all servers
+func (a adminAPIHandlers) SpeedtestHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := newContext(r, w, "Speedtest")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealthInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	query := r.URL.Query()
+	size, blockSize, err := parseSpeedtestSizes(query.Get("size"), query.Get("blocksize"))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErrWithErr(ErrAdminInvalidArgument, err), r.URL)
+		return
+	}
+
+	// Do not measure while a health check measures performance.
+	nsLock := objectAPI.NewNSLock(minioMetaBucket, "health-check-in-progress")
+	if err = nsLock.GetLock(ctx, newDynamicTimeout(time.Second, time.Second)); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	defer nsLock.Unlock()
+
+	var result madmin.SpeedtestResult
+	if query.Get("drive") == "true" {
+		var local madmin.ServerDrivesSpeedtest
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			local = getLocalDrivesSpeedtest(ctx, size, blockSize)
+		}()
+		peers := globalNotificationSys.DriveSpeedtest(ctx, size, blockSize)
+		wg.Wait()
+		result.Drives = append(peers, local)
+	}
+
+	// Servers are tested one pair at a time after the drives, so
+	// that the tests do not affect each other.
+	if query.Get("net") == "true" && globalIsDistErasure {
+		result.Net = append(result.Net, globalNotificationSys.NetInfo(ctx))
+		result.Net = append(result.Net, globalNotificationSys.DispatchNetPerfInfo(ctx)...)
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
 // ServerInfoHandler - GET /minio/admin/v3/info
 // ----------
 // Get server information
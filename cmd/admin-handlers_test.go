@@ -25,12 +25,15 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"reflect"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/minio/minio/pkg/auth"
 	"github.com/minio/minio/pkg/madmin"
+	"github.com/minio/minio/pkg/trace"
 )
 
 // adminErasureTestBed - encapsulates subsystems that need to be setup for
@@ -372,3 +375,145 @@ func TestExtractHealInitParams(t *testing.T) {
 	}
 
 }
+
+func TestNewBgHealNodeState(t *testing.T) {
+	state := madmin.BgHealState{
+		ScannedItemsCount: 10,
+		HealedItemsByType: map[madmin.HealItemType]int64{
+			madmin.HealItemObject: 3,
+			madmin.HealItemBucket: 1,
+		},
+		HealFailedItems: []madmin.HealFailedItem{{Bucket: "bucket", Object: "object"}},
+		HealDisks:       []string{"/data1"},
+		Paused:          true,
+	}
+
+	nodeState := newBgHealNodeState("server1:9000", state, nil)
+	if nodeState.Node != "server1:9000" || nodeState.Error != "" {
+		t.Fatalf("unexpected node state %#v", nodeState)
+	}
+	if nodeState.ScannedItemsCount != 10 || nodeState.HealedItemsCount != 4 || nodeState.FailedItemsCount != 1 {
+		t.Fatalf("unexpected node counts %#v", nodeState)
+	}
+	if len(nodeState.HealDisks) != 1 || !nodeState.Paused {
+		t.Fatalf("unexpected node state %#v", nodeState)
+	}
+
+	nodeState = newBgHealNodeState("server2:9000", state, errServerNotInitialized)
+	if nodeState.Error != errServerNotInitialized.Error() || nodeState.ScannedItemsCount != 0 {
+		t.Fatalf("expected an offline node state, got %#v", nodeState)
+	}
+}
+
+func TestMustTrace(t *testing.T) {
+	s3Entry := trace.Info{
+		TraceType: trace.HTTP,
+		ReqInfo:   trace.RequestInfo{Path: "/bucket/object"},
+		RespInfo:  trace.ResponseInfo{StatusCode: http.StatusOK},
+	}
+	internalEntry := trace.Info{
+		TraceType: trace.HTTP,
+		ReqInfo:   trace.RequestInfo{Path: minioReservedBucketPath + "/peer/v20/serverinfo"},
+		RespInfo:  trace.ResponseInfo{StatusCode: http.StatusInternalServerError},
+	}
+	storageEntry := trace.Info{TraceType: trace.Storage, FuncName: "storage.ReadVersion"}
+	osEntry := trace.Info{TraceType: trace.OS, FuncName: "os.OpenFile"}
+
+	testCases := []struct {
+		query    url.Values
+		entry    trace.Info
+		expected bool
+	}{
+		// S3 calls are traced by default.
+		{url.Values{}, s3Entry, true},
+		{url.Values{}, internalEntry, false},
+		{url.Values{}, storageEntry, false},
+		{url.Values{}, osEntry, false},
+		// Only the requested categories are traced.
+		{url.Values{"s3": {"false"}, "storage": {"true"}}, s3Entry, false},
+		{url.Values{"s3": {"false"}, "storage": {"true"}}, storageEntry, true},
+		{url.Values{"s3": {"false"}, "storage": {"true"}}, osEntry, false},
+		{url.Values{"os": {"true"}}, osEntry, true},
+		{url.Values{"all": {"true"}}, internalEntry, true},
+		// Only failed calls are traced on request.
+		{url.Values{"err": {"true"}}, s3Entry, false},
+		{url.Values{"all": {"true"}, "err": {"true"}}, internalEntry, true},
+	}
+
+	for i, testCase := range testCases {
+		opts := getTraceOpts(testCase.query)
+		if got := mustTrace(testCase.entry, opts); got != testCase.expected {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.expected, got)
+		}
+
+		// The options must survive the round trip to the peers.
+		query := make(url.Values)
+		opts.encode(query)
+		if decoded := getTraceOpts(query); decoded != opts {
+			t.Errorf("Test %d: expected %#v, got %#v", i+1, opts, decoded)
+		}
+	}
+}
+
+func TestTopLockEntries(t *testing.T) {
+	now := UTCNow()
+	granted := lockRequesterInfo{UID: "granted", Writer: true, Timestamp: now.Add(-time.Hour), Owner: "node1", Quorum: 2}
+	stale := lockRequesterInfo{UID: "stale", Timestamp: now.Add(-2 * time.Hour), Owner: "node1", Quorum: 2}
+	waiting := lockRequesterInfo{UID: "granted", Writer: true, Timestamp: now.Add(-time.Second), Owner: "node2", Waiting: true}
+
+	peerLocks := []*PeerLocks{
+		{Addr: "node1", Locks: map[string][]lockRequesterInfo{"bucket/a": {granted}, "bucket/b": {stale}}},
+		{Addr: "node2", Locks: map[string][]lockRequesterInfo{"bucket/a": {granted, waiting}}},
+		nil,
+	}
+
+	testCases := []struct {
+		opts     madmin.TopLockOpts
+		expected []string
+	}{
+		{madmin.TopLockOpts{}, []string{"bucket/a"}},
+		{madmin.TopLockOpts{Stale: true}, []string{"bucket/b", "bucket/a"}},
+		{madmin.TopLockOpts{Waiting: true}, []string{"bucket/a", "bucket/a"}},
+		{madmin.TopLockOpts{Stale: true, OlderThan: 90 * time.Minute}, []string{"bucket/b"}},
+		{madmin.TopLockOpts{Waiting: true, OlderThan: time.Minute}, []string{"bucket/a"}},
+	}
+
+	for i, testCase := range testCases {
+		entries := topLockEntries(peerLocks, testCase.opts)
+		var resources []string
+		for _, entry := range entries {
+			resources = append(resources, entry.Resource)
+			if entry.Waiting && len(entry.ServerList) != 1 {
+				t.Errorf("Test %d: expected waiting entry on one server, got %v", i+1, entry.ServerList)
+			}
+		}
+		if !reflect.DeepEqual(resources, testCase.expected) {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.expected, resources)
+		}
+	}
+}
+
+func TestCheckForceUnlock(t *testing.T) {
+	locks := madmin.LockEntries{
+		{Resource: "bucket/old", Elapsed: time.Hour},
+		{Resource: "bucket/new", Elapsed: time.Second},
+	}
+
+	testCases := []struct {
+		resources []string
+		olderThan time.Duration
+		shouldErr bool
+	}{
+		{[]string{"bucket/old"}, time.Minute, false},
+		{[]string{"bucket/old", "bucket/new"}, time.Minute, true},
+		{[]string{"bucket/new"}, time.Millisecond, false},
+		{[]string{"bucket/unlocked"}, time.Minute, false},
+	}
+
+	for i, testCase := range testCases {
+		err := checkForceUnlock(locks, testCase.resources, testCase.olderThan)
+		if (err != nil) != testCase.shouldErr {
+			t.Errorf("Test %d: expected error %v, got %v", i+1, testCase.shouldErr, err)
+		}
+	}
+}
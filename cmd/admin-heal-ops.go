@@ -63,6 +63,7 @@ const (
 var (
 	errHealIdleTimeout   = fmt.Errorf("healing results were not consumed for too long")
 	errHealStopSignalled = fmt.Errorf("heal stop signaled")
+	errHealInterrupted   = fmt.Errorf("heal interrupted before the item could be healed")
 
 	errFnHealFromAPIErr = func(ctx context.Context, err error) error {
 		apiErr := toAdminAPIErr(ctx, err)
@@ -83,6 +84,9 @@ type healSequenceStatus struct {
 
 	// slice of available heal result records
 	Items []madmin.HealResultItem `json:"Items"`
+
+	// dangling entries found per bucket
+	Dangling map[string]madmin.HealDanglingCounts `json:"Dangling,omitempty"`
 }
 
 // structure to hold state of all heal sequences in server memory
@@ -92,13 +96,31 @@ type allHealState struct {
 	// map of heal path to heal sequence
 	healSeqMap     map[string]*healSequence
 	healLocalDisks map[Endpoint]struct{}
+
+	// signaled when local disks to heal are found at runtime
+	healLocalDisksCh chan struct{}
+
+	// progress of healing the local disks, keyed by endpoint
+	diskHealProgress map[string]*madmin.HealDriveProgress
+
+	// throttle limits the rate of heal operations on this server
+	throttle healThrottle
+
+	// adaptive delays heal operations according to the server load
+	adaptive healAdaptiveThrottle
+
+	// progress of the erasure sets being healed, keyed by pool and set
+	setHealProgress map[string]*setHealProgress
 }
 
 // newHealState - initialize global heal state management
 func newHealState(cleanup bool) *allHealState {
 	hstate := &allHealState{
-		healSeqMap:     make(map[string]*healSequence),
-		healLocalDisks: map[Endpoint]struct{}{},
+		healSeqMap:       make(map[string]*healSequence),
+		healLocalDisks:   map[Endpoint]struct{}{},
+		healLocalDisksCh: make(chan struct{}, 1),
+		diskHealProgress: make(map[string]*madmin.HealDriveProgress),
+		setHealProgress:  make(map[string]*setHealProgress),
 	}
 	if cleanup {
 		go hstate.periodicHealSeqsClean(GlobalContext)
@@ -130,16 +152,89 @@ func (ahs *allHealState) popHealLocalDisks(healLocalDisks ...Endpoint) {
 
 	for _, ep := range healLocalDisks {
 		delete(ahs.healLocalDisks, ep)
+		delete(ahs.diskHealProgress, ep.String())
+	}
+}
+
+// startDiskHealProgress starts tracking the progress of healing the
+// disk, from the progress saved before a restart if any.
+func (ahs *allHealState) startDiskHealProgress(disk string, p madmin.HealDriveProgress) {
+	ahs.Lock()
+	defer ahs.Unlock()
+
+	if p.Started.IsZero() {
+		p.Started = UTCNow()
+	}
+	ahs.diskHealProgress[disk] = &p
+}
+
+// updateDiskHealProgress adds to the progress of healing the disk,
+// untracked disks are ignored.
+func (ahs *allHealState) updateDiskHealProgress(disk string, scanned, healed, bytes uint64) {
+	ahs.Lock()
+	defer ahs.Unlock()
+
+	p, ok := ahs.diskHealProgress[disk]
+	if !ok {
+		return
+	}
+	p.ObjectsScanned += scanned
+	p.ObjectsHealed += healed
+	p.BytesHealed += bytes
+	if p.ObjectsRemaining > scanned {
+		p.ObjectsRemaining -= scanned
+	} else {
+		p.ObjectsRemaining = 0
 	}
 }
 
-func (ahs *allHealState) pushHealLocalDisks(healLocalDisks ...Endpoint) {
+// diskHealBucketDone records that a bucket is completely healed
+// on the disk, untracked disks are ignored.
+func (ahs *allHealState) diskHealBucketDone(disk string) {
+	ahs.Lock()
+	defer ahs.Unlock()
+
+	if p, ok := ahs.diskHealProgress[disk]; ok {
+		p.BucketsHealed++
+	}
+}
+
+// getDiskHealProgress returns the progress of healing all local disks.
+func (ahs *allHealState) getDiskHealProgress() map[string]madmin.HealDriveProgress {
+	ahs.RLock()
+	defer ahs.RUnlock()
+
+	if len(ahs.diskHealProgress) == 0 {
+		return nil
+	}
+	progress := make(map[string]madmin.HealDriveProgress, len(ahs.diskHealProgress))
+	for disk, p := range ahs.diskHealProgress {
+		progress[disk] = *p
+	}
+	return progress
+}
+
+// pushHealLocalDisks adds local disks to heal, returns true if
+// any of them was not already marked for healing.
+func (ahs *allHealState) pushHealLocalDisks(healLocalDisks ...Endpoint) (added bool) {
 	ahs.Lock()
 	defer ahs.Unlock()
 
 	for _, ep := range healLocalDisks {
+		if _, ok := ahs.healLocalDisks[ep]; ok {
+			continue
+		}
 		ahs.healLocalDisks[ep] = struct{}{}
+		added = true
+	}
+	if added {
+		// Wake up the disk monitor without blocking.
+		select {
+		case ahs.healLocalDisksCh <- struct{}{}:
+		default:
+		}
 	}
+	return added
 }
 
 func (ahs *allHealState) periodicHealSeqsClean(ctx context.Context) {
@@ -181,6 +276,27 @@ func (ahs *allHealState) getHealSequenceByToken(token string) (h *healSequence,
 	return nil, false
 }
 
+// pauseHealSequence pauses the heal sequence with the given token,
+// heal tasks already queued are held until the sequence is resumed.
+func (ahs *allHealState) pauseHealSequence(token string) bool {
+	h, exists := ahs.getHealSequenceByToken(token)
+	if !exists {
+		return false
+	}
+	h.pause()
+	return true
+}
+
+// resumeHealSequence resumes a heal sequence paused by pauseHealSequence.
+func (ahs *allHealState) resumeHealSequence(token string) bool {
+	h, exists := ahs.getHealSequenceByToken(token)
+	if !exists {
+		return false
+	}
+	h.resume()
+	return true
+}
+
 // getHealSequence - Retrieve a heal sequence by path. The second
 // argument returns if a heal sequence actually exists.
 func (ahs *allHealState) getHealSequence(path string) (h *healSequence, exists bool) {
@@ -346,6 +462,10 @@ type healSource struct {
 	object    string
 	versionID string
 	opts      *madmin.HealOpts // optional heal option overrides default setting
+	inflight  *healInflight    // optional, completed once the source is healed
+	queueID   uint64           // non-zero while tracked by the heal queue
+	origin    string           // what queued the source, if known
+	mrf       *erasureSets     // optional, MRF list the source is removed from once healed
 }
 
 // healSequence - state for each heal sequence initiated on the
@@ -357,6 +477,13 @@ type healSequence struct {
 	// A channel of entities (format, buckets, objects) to heal
 	sourceCh chan healSource
 
+	// A channel of objects from the MRF list, healed
+	// before the entities queued in sourceCh
+	mrfCh chan healSource
+
+	// Items waiting in sourceCh and mrfCh
+	queue healQueue
+
 	// A channel of entities with heal result
 	respCh chan healResult
 
@@ -400,9 +527,67 @@ type healSequence struct {
 	// Number of total items where healing failed against endpoint and drive state
 	healFailedItemsMap map[string]int64
 
+	// Number of total items where healing failed against item type
+	failedItemsMap map[madmin.HealItemType]int64
+
+	// Number of total items which would have been healed in dry-run
+	// mode against item type
+	wouldHealItemsMap map[madmin.HealItemType]int64
+
+	// Items which would have been healed in dry-run mode during
+	// the current heal round, bounded by healReportMax.
+	report          []madmin.HealReportItem
+	reportTruncated bool
+
+	// Most recent objects where healing failed keyed by
+	// bucket/object and version, bounded by healFailedItemsMax.
+	healFailedItems map[string]madmin.HealFailedItem
+
+	// Objects which failed to heal waiting to be retried,
+	// bounded by healRetryQueueMax.
+	healRetries map[string]*healRetry
+
+	// Objects which failed to heal after all retries keyed by
+	// bucket/object and version, bounded by healDeadLettersMax.
+	// deadLettersDirty is set when the list needs to be saved.
+	deadLetters      map[string]madmin.HealDeadLetterItem
+	deadLettersDirty bool
+
+	// Explicitly requested objects queued or being healed,
+	// keyed by bucket/object and version.
+	healInflight map[string]*healInflight
+
+	// Number of queued items which were not healed when
+	// the sequence was stopped.
+	interruptedItems int64
+
+	// Number of object versions sampled for a deep scan.
+	sampledItems int64
+
+	// Number of object versions skipped as they expire soon.
+	expiringItems int64
+
+	// Final summary of the sequence, set once it has stopped.
+	summary *madmin.HealSummary
+
+	// Number of items queued for deep heal due to read errors
+	// reported by degrading disks
+	diskErrHealCount int64
+
 	// The time of the last scan/heal activity
 	lastHealActivity time.Time
 
+	// Number of erasure sets currently crawled by the heal round
+	// which started at roundStart, lastRoundDuration is the time
+	// taken by the previous round to complete.
+	roundActive       int
+	roundStart        time.Time
+	lastRoundDuration time.Duration
+
+	// resumeCh is non-nil while the heal sequence is paused,
+	// it is closed when the heal sequence is resumed.
+	resumeCh chan struct{}
+
 	// Holds the request-info for logging
 	ctx context.Context
 
@@ -458,6 +643,9 @@ func (h *healSequence) resetHealStatusCounters() {
 	h.scannedItemsMap = make(map[madmin.HealItemType]int64)
 	h.healedItemsMap = make(map[madmin.HealItemType]int64)
 	h.healFailedItemsMap = make(map[string]int64)
+	h.wouldHealItemsMap = make(map[madmin.HealItemType]int64)
+	h.healFailedItems = make(map[string]madmin.HealFailedItem)
+	h.diskErrHealCount = 0
 }
 
 // getScannedItemsCount - returns a count of all scanned items
@@ -472,6 +660,15 @@ func (h *healSequence) getScannedItemsCount() int64 {
 	return count
 }
 
+// getDiskErrHealCount - returns a count of all items queued for heal
+// due to disk read errors
+func (h *healSequence) getDiskErrHealCount() int64 {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	return h.diskErrHealCount
+}
+
 // getScannedItemsMap - returns map of all scanned items against type
 func (h *healSequence) getScannedItemsMap() map[madmin.HealItemType]int64 {
 	h.mutex.RLock()
@@ -500,6 +697,71 @@ func (h *healSequence) getHealedItemsMap() map[madmin.HealItemType]int64 {
 	return retMap
 }
 
+// getWouldHealItemsMap - returns map of all items which would have
+// been healed in dry-run mode against item type
+func (h *healSequence) getWouldHealItemsMap() map[madmin.HealItemType]int64 {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	retMap := make(map[madmin.HealItemType]int64, len(h.wouldHealItemsMap))
+	for k, v := range h.wouldHealItemsMap {
+		retMap[k] = v
+	}
+	return retMap
+}
+
+// logWouldHeal - counts the item if the dry-run heal result shows
+// that it needs healing.
+func (h *healSequence) logWouldHeal(res madmin.HealResultItem) {
+	if !healResultNeedsHeal(res) {
+		return
+	}
+	h.mutex.Lock()
+	h.addWouldHealItem(res)
+	h.mutex.Unlock()
+}
+
+// addWouldHealItem - counts the item and adds it to the dry-run
+// report, caller must hold h.mutex.
+func (h *healSequence) addWouldHealItem(res madmin.HealResultItem) {
+	if h.wouldHealItemsMap == nil {
+		h.wouldHealItemsMap = make(map[madmin.HealItemType]int64)
+	}
+	h.wouldHealItemsMap[res.Type]++
+	h.addHealReportItem(res)
+}
+
+// healResultNeedsHeal returns true if any drive was missing
+// or corrupted before the heal.
+func healResultNeedsHeal(res madmin.HealResultItem) bool {
+	for _, d := range res.Before.Drives {
+		if d.State == madmin.DriveStateMissing || d.State == madmin.DriveStateCorrupt {
+			return true
+		}
+	}
+	return false
+}
+
+// getFailedItemsMap - returns map of all items where heal failed against type
+func (h *healSequence) getFailedItemsMap() map[madmin.HealItemType]int64 {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	retMap := make(map[madmin.HealItemType]int64, len(h.failedItemsMap))
+	for k, v := range h.failedItemsMap {
+		retMap[k] = v
+	}
+	return retMap
+}
+
+// addFailedItem - caller must hold h.mutex.
+func (h *healSequence) addFailedItem(healType madmin.HealItemType) {
+	if h.failedItemsMap == nil {
+		h.failedItemsMap = make(map[madmin.HealItemType]int64)
+	}
+	h.failedItemsMap[healType]++
+}
+
 // gethealFailedItemsMap - returns map of all items where heal failed against
 // drive endpoint and status
 func (h *healSequence) gethealFailedItemsMap() map[string]int64 {
@@ -515,6 +777,106 @@ func (h *healSequence) gethealFailedItemsMap() map[string]int64 {
 	return retMap
 }
 
+// startHealRound - marks the start of crawling an erasure set,
+// the first crawl starts a new heal round.
+func (h *healSequence) startHealRound() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.roundActive == 0 {
+		h.roundStart = UTCNow()
+		h.resetHealReport()
+	}
+	h.roundActive++
+}
+
+// endHealRound - marks the end of crawling an erasure set, the
+// heal round is complete once all erasure sets are crawled.
+func (h *healSequence) endHealRound() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.roundActive == 0 {
+		return
+	}
+	h.roundActive--
+	if h.roundActive == 0 {
+		h.lastRoundDuration = UTCNow().Sub(h.roundStart)
+	}
+}
+
+// getNextHealRound - returns the estimated completion of the current
+// heal round when one is running, otherwise the time at which the
+// next heal round is expected to begin.
+func (h *healSequence) getNextHealRound(interval time.Duration) time.Time {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	now := UTCNow()
+	if h.roundActive > 0 {
+		if h.lastRoundDuration > 0 {
+			if eta := h.roundStart.Add(h.lastRoundDuration); eta.After(now) {
+				return eta
+			}
+		}
+		// No estimate available, the round may finish any time now.
+		return now
+	}
+
+	lastActivity := h.lastHealActivity
+	if lastActivity.IsZero() {
+		lastActivity = h.startTime
+	}
+	return lastActivity.Add(interval)
+}
+
+// getLastHealActivity - returns the time of the last scan/heal activity
+func (h *healSequence) getLastHealActivity() time.Time {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	return h.lastHealActivity
+}
+
+// pause - pauses healing of buckets and objects
+func (h *healSequence) pause() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.resumeCh == nil {
+		h.resumeCh = make(chan struct{})
+	}
+}
+
+// resume - resumes healing paused by pause
+func (h *healSequence) resume() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.resumeCh != nil {
+		close(h.resumeCh)
+		h.resumeCh = nil
+	}
+}
+
+// isPaused - returns true if the heal sequence is paused
+func (h *healSequence) isPaused() bool {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	return h.resumeCh != nil
+}
+
+// waitIfPaused - blocks while the heal sequence is paused or
+// until the context is canceled.
+func (h *healSequence) waitIfPaused(ctx context.Context) {
+	h.mutex.RLock()
+	resumeCh := h.resumeCh
+	h.mutex.RUnlock()
+	if resumeCh == nil {
+		return
+	}
+	select {
+	case <-resumeCh:
+	case <-ctx.Done():
+	case <-h.ctx.Done():
+	}
+}
+
 // isQuitting - determines if the heal sequence is quitting (due to an
 // external signal)
 func (h *healSequence) isQuitting() bool {
@@ -669,7 +1031,108 @@ func (h *healSequence) logHeal(healType madmin.HealItemType) {
 	h.mutex.Unlock()
 }
 
+// logHealed - counts an item which was healed.
+func (h *healSequence) logHealed(healType madmin.HealItemType) {
+	h.mutex.Lock()
+	h.healedItemsMap[healType]++
+	h.mutex.Unlock()
+}
+
+// logHealFailed - counts an item which could not be healed.
+func (h *healSequence) logHealFailed(healType madmin.HealItemType) {
+	h.mutex.Lock()
+	h.addFailedItem(healType)
+	h.mutex.Unlock()
+}
+
+// logHealFailure records the object which could not be healed,
+// returns true if the object was not already recorded.
+func (h *healSequence) logHealFailure(bucket, object, versionID string, err error) bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	return h.addHealFailedItem(bucket, object, versionID, err)
+}
+
+// addHealFailedItem records a heal failure, if the list is full the
+// oldest failure is evicted. Returns true if the object was not already
+// recorded. Caller must hold h.mutex.
+func (h *healSequence) addHealFailedItem(bucket, object, versionID string, err error) bool {
+	if h.healFailedItems == nil {
+		h.healFailedItems = make(map[string]madmin.HealFailedItem)
+	}
+	key := healFailedItemKey(bucket, object, versionID)
+	_, found := h.healFailedItems[key]
+	if !found && len(h.healFailedItems) >= healFailedItemsMax {
+		var oldestKey string
+		var oldest time.Time
+		for k, item := range h.healFailedItems {
+			if oldestKey == "" || item.LastAttempt.Before(oldest) {
+				oldestKey, oldest = k, item.LastAttempt
+			}
+		}
+		delete(h.healFailedItems, oldestKey)
+	}
+	h.healFailedItems[key] = madmin.HealFailedItem{
+		Bucket:      bucket,
+		Object:      object,
+		VersionID:   versionID,
+		Reason:      err.Error(),
+		LastAttempt: UTCNow(),
+	}
+	return !found
+}
+
+// removeHealFailedItem forgets a previous failure once the object
+// is successfully healed. Caller must hold h.mutex.
+func (h *healSequence) removeHealFailedItem(bucket, object, versionID string) {
+	delete(h.healFailedItems, healFailedItemKey(bucket, object, versionID))
+	h.removeHealDeadLetter(bucket, object, versionID)
+}
+
+// getHealFailedItems - returns the recorded heal failures, latest first.
+func (h *healSequence) getHealFailedItems() []madmin.HealFailedItem {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	if len(h.healFailedItems) == 0 {
+		return nil
+	}
+	items := make([]madmin.HealFailedItem, 0, len(h.healFailedItems))
+	for _, item := range h.healFailedItems {
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].LastAttempt.After(items[j].LastAttempt)
+	})
+	return items
+}
+
+func healFailedItemKey(bucket, object, versionID string) string {
+	return pathJoin(bucket, object) + "/" + versionID
+}
+
+func (h *healSequence) logDiskErrHeal() {
+	h.mutex.Lock()
+	h.diskErrHealCount++
+	h.mutex.Unlock()
+}
+
+// healAuditSource returns what triggered the heal of the source.
+func (h *healSequence) healAuditSource(source healSource) string {
+	switch {
+	case source.origin != "":
+		return source.origin
+	case h.clientToken == bgHealingUUID:
+		return healAuditSourceQueued
+	}
+	return healAuditSourceAdmin
+}
+
 func (h *healSequence) queueHealTask(source healSource, healType madmin.HealItemType) error {
+	// Report the outcome to callers waiting on the source.
+	inflightRes := healResult{err: errHealInterrupted}
+	defer func() { h.finishHealSource(source, inflightRes) }()
+
 	globalHealConfigMu.Lock()
 	opts := globalHealConfig
 	globalHealConfigMu.Unlock()
@@ -679,21 +1142,63 @@ func (h *healSequence) queueHealTask(source healSource, healType madmin.HealItem
 		bucket:     source.bucket,
 		object:     source.object,
 		versionID:  source.versionID,
-		opts:       h.settings,
+		opts:       h.healFilter(opts),
 		responseCh: h.respCh,
 	}
 	if source.opts != nil {
+		filter := task.opts
 		task.opts = *source.opts
+		// Prefix and tag filters are inherited from the heal sequence.
+		if len(task.opts.IncludePrefixes) == 0 && len(task.opts.ExcludePrefixes) == 0 {
+			task.opts.IncludePrefixes = filter.IncludePrefixes
+			task.opts.ExcludePrefixes = filter.ExcludePrefixes
+		}
+		if len(task.opts.IncludeTags) == 0 && len(task.opts.ExcludeTags) == 0 {
+			task.opts.IncludeTags = filter.IncludeTags
+			task.opts.ExcludeTags = filter.ExcludeTags
+		}
 	}
+	sampled := false
 	if opts.Bitrot {
 		task.opts.ScanMode = madmin.HealDeepScan
+	} else if healType == madmin.HealItemObject && task.opts.Sampled(source.object, source.versionID) {
+		task.opts.ScanMode = madmin.HealDeepScan
+		sampled = true
+	}
+	h.applyHealDryRun(opts, &task.opts)
+
+	// Skip objects filtered out by the heal prefixes.
+	if healType == madmin.HealItemObject && healSkipObject(task.opts, source.bucket, source.object) {
+		inflightRes = healResult{}
+		return nil
+	}
+
+	// Hold on to the item while the sequence is paused, this also
+	// covers items queued directly by the scanner.
+	if healType != madmin.HealItemMetadata {
+		h.waitIfPaused(h.ctx)
+	}
+
+	// Background healing only runs within the heal schedule.
+	if h.clientToken == bgHealingUUID {
+		waitForHealSchedule(h.ctx)
 	}
 
 	// Wait and proceed if there are active requests
-	waitForLowHTTPReq(opts.IOCount, opts.Sleep)
+	waitForHealLoad(h.ctx, opts)
+
+	// All heal sequences share the server wide heal rate.
+	if globalBackgroundHealState != nil {
+		if err := globalBackgroundHealState.throttle.wait(h.ctx, opts.MaxIOPS); err != nil {
+			return nil
+		}
+	}
 
 	h.mutex.Lock()
 	h.scannedItemsMap[healType]++
+	if sampled {
+		h.sampledItems++
+	}
 	h.lastHealActivity = UTCNow()
 	h.mutex.Unlock()
 
@@ -701,6 +1206,11 @@ func (h *healSequence) queueHealTask(source healSource, healType madmin.HealItem
 
 	select {
 	case res := <-h.respCh:
+		inflightRes = res
+		h.logDangling(res.result)
+		if healType == madmin.HealItemObject && opts.Audit {
+			auditHealObject(h.healAuditSource(source), -1, -1, source.bucket, source.object, source.versionID, task.opts, res.result, res.err)
+		}
 		if !h.reportProgress {
 			// Object might have been deleted, by the time heal
 			// was attempted, we should ignore this object and
@@ -723,15 +1233,36 @@ func (h *healSequence) queueHealTask(source healSource, healType madmin.HealItem
 					// This will help users take corrective actions for drives
 					h.healFailedItemsMap[d.Endpoint+","+d.State]++
 				}
+				h.addFailedItem(healType)
+				if h.addHealFailedItem(source.bucket, source.object, source.versionID, res.err) {
+					globalHealNotifier.send(healEvent{
+						Type:      healEventObjectFailed,
+						PoolIndex: -1,
+						SetIndex:  -1,
+						Bucket:    source.bucket,
+						Object:    source.object,
+						VersionID: source.versionID,
+						Reason:    res.err.Error(),
+					})
+				}
+			} else if task.opts.DryRun {
+				// Nothing is healed in dry-run, only report what would be healed.
+				if healResultNeedsHeal(res.result) {
+					h.addWouldHealItem(res.result)
+				}
 			} else {
 				// Only object type reported for successful healing
 				h.healedItemsMap[res.result.Type]++
+				h.removeHealFailedItem(source.bucket, source.object, source.versionID)
 			}
 
 			// Report caller of any failure
 			return res.err
 		}
 		res.result.Type = healType
+		if res.err == nil && task.opts.DryRun {
+			h.logWouldHeal(res.result)
+		}
 		if res.err != nil {
 			// Object might have been deleted, by the time heal
 			// was attempted, we should ignore this object and return success.
@@ -750,45 +1281,249 @@ func (h *healSequence) queueHealTask(source healSource, healType madmin.HealItem
 	}
 }
 
+// healItemFromSource heals an entity received from sourceCh or mrfCh.
+func (h *healSequence) healItemFromSource(source healSource) {
+	h.unqueueSource(source)
+
+	var itemType madmin.HealItemType
+	switch source.bucket {
+	case nopHeal:
+		return
+	case SlashSeparator:
+		itemType = madmin.HealItemMetadata
+	default:
+		if source.object == "" {
+			itemType = madmin.HealItemBucket
+		} else {
+			itemType = madmin.HealItemObject
+		}
+		// Hold on to the item while the sequence is paused,
+		// the remaining items stay queued in sourceCh.
+		h.waitIfPaused(h.ctx)
+	}
+
+	if err := h.queueHealTask(source, itemType); err != nil {
+		switch err.(type) {
+		case ObjectExistsAsDirectory:
+		case ObjectNotFound:
+		case VersionNotFound:
+		default:
+			logger.LogIf(h.ctx, fmt.Errorf("Heal attempt failed for %s: %w",
+				pathJoin(source.bucket, source.object), err))
+		}
+	}
+}
+
 func (h *healSequence) healItemsFromSourceCh() error {
 	for {
+		// Objects from the MRF list were recently written without
+		// reaching all drives, heal them before any crawled item.
 		select {
+		case source := <-h.mrfCh:
+			h.healItemFromSource(source)
+			continue
+		default:
+		}
+
+		select {
+		case source := <-h.mrfCh:
+			h.healItemFromSource(source)
 		case source, ok := <-h.sourceCh:
 			if !ok {
 				return nil
 			}
+			h.healItemFromSource(source)
+		case <-h.ctx.Done():
+			globalHealConfigMu.Lock()
+			drainTimeout := globalHealConfig.GetDrainTimeout()
+			globalHealConfigMu.Unlock()
 
-			var itemType madmin.HealItemType
-			switch source.bucket {
-			case nopHeal:
-				continue
-			case SlashSeparator:
-				itemType = madmin.HealItemMetadata
-			default:
-				if source.object == "" {
-					itemType = madmin.HealItemBucket
-				} else {
-					itemType = madmin.HealItemObject
-				}
-			}
+			h.drainSourceCh(newObjectLayerFn(), drainTimeout)
+			return nil
+		}
+	}
+}
+
+// drainSourceCh heals the items already queued when the sequence is
+// stopped, within the grace period. Items left once the grace period
+// has elapsed, and objects waiting to be retried, are recorded as
+// interrupted. Interrupted MRF items stay in the saved MRF list. The
+// final summary of the sequence is then recorded.
+func (h *healSequence) drainSourceCh(objAPI ObjectLayer, grace time.Duration) {
+	defer h.recordHealSummary()
+
+	ctx, cancel := context.WithTimeout(context.Background(), grace)
+	defer cancel()
 
-			if err := h.queueHealTask(source, itemType); err != nil {
-				switch err.(type) {
-				case ObjectExistsAsDirectory:
-				case ObjectNotFound:
-				case VersionNotFound:
-				default:
-					logger.LogIf(h.ctx, fmt.Errorf("Heal attempt failed for %s: %w",
-						pathJoin(source.bucket, source.object), err))
+	globalHealConfigMu.Lock()
+	cfg := globalHealConfig
+	globalHealConfigMu.Unlock()
+
+	for {
+		var source healSource
+		select {
+		case s := <-h.mrfCh:
+			source = s
+		default:
+			select {
+			case s, ok := <-h.sourceCh:
+				if !ok {
+					h.interruptHealRetries()
+					return
 				}
+				source = s
+			default:
+				h.interruptHealRetries()
+				return
 			}
-		case <-h.ctx.Done():
-			return nil
+		}
+		h.unqueueSource(source)
+
+		if source.bucket == nopHeal {
+			continue
+		}
+		if objAPI == nil || ctx.Err() != nil {
+			h.logHealInterrupted(source)
+			h.finishHealSource(source, healResult{err: errHealInterrupted})
+			continue
+		}
+
+		opts := h.settings
+		if source.opts != nil {
+			opts = *source.opts
+		}
+		h.applyHealDryRun(cfg, &opts)
+
+		var (
+			res      madmin.HealResultItem
+			err      error
+			itemType madmin.HealItemType = madmin.HealItemObject
+		)
+		switch {
+		case source.bucket == SlashSeparator:
+			itemType = madmin.HealItemMetadata
+			res, err = healDiskFormat(ctx, objAPI, opts)
+		case source.object == "":
+			itemType = madmin.HealItemBucket
+			res, err = objAPI.HealBucket(ctx, source.bucket, opts)
+		default:
+			res, err = objAPI.HealObject(ctx, source.bucket, source.object, source.versionID, opts)
+		}
+
+		if err != nil && ctx.Err() != nil {
+			h.logHealInterrupted(source)
+			h.finishHealSource(source, healResult{err: errHealInterrupted})
+			continue
+		}
+		h.finishHealSource(source, healResult{result: res, err: err})
+
+		h.logHeal(itemType)
+		switch {
+		case isErrObjectNotFound(err) || isErrVersionNotFound(err):
+		case err != nil:
+			h.logHealFailed(itemType)
+			h.logHealFailure(source.bucket, source.object, source.versionID, err)
+		case !opts.DryRun:
+			h.mutex.Lock()
+			h.healedItemsMap[res.Type]++
+			h.mutex.Unlock()
 		}
 	}
 }
 
+// logHealSampled records an object version sampled for a deep scan.
+func (h *healSequence) logHealSampled() {
+	h.mutex.Lock()
+	h.sampledItems++
+	h.mutex.Unlock()
+}
+
+// getSampledItemsCount returns the number of object versions sampled
+// for a deep scan.
+func (h *healSequence) getSampledItemsCount() int64 {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	return h.sampledItems
+}
+
+// logHealExpiring records an object version which was not healed
+// as a lifecycle rule expires it soon.
+func (h *healSequence) logHealExpiring() {
+	h.mutex.Lock()
+	h.expiringItems++
+	h.mutex.Unlock()
+}
+
+// getExpiringItemsCount returns the number of object versions which
+// were not healed as a lifecycle rule expires them soon.
+func (h *healSequence) getExpiringItemsCount() int64 {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	return h.expiringItems
+}
+
+// logHealInterrupted records a queued item which was not healed.
+func (h *healSequence) logHealInterrupted(source healSource) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.interruptedItems++
+	h.addHealFailedItem(source.bucket, source.object, source.versionID, errHealInterrupted)
+}
+
+// interruptHealRetries records all objects waiting to be retried as interrupted.
+func (h *healSequence) interruptHealRetries() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	for key, r := range h.healRetries {
+		h.interruptedItems++
+		h.addHealFailedItem(r.source.bucket, r.source.object, r.source.versionID, errHealInterrupted)
+		delete(h.healRetries, key)
+	}
+}
+
+// recordHealSummary records the final totals of the heal sequence.
+func (h *healSequence) recordHealSummary() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	summary := &madmin.HealSummary{
+		FailedItemsCount:      int64(len(h.healFailedItems)),
+		InterruptedItemsCount: h.interruptedItems,
+		Stopped:               UTCNow(),
+	}
+	for _, v := range h.scannedItemsMap {
+		summary.ScannedItemsCount += v
+	}
+	for _, v := range h.healedItemsMap {
+		summary.HealedItemsCount += v
+	}
+	h.summary = summary
+}
+
+// getInterruptedItemsCount returns the number of queued items
+// which were not healed when the sequence was stopped.
+func (h *healSequence) getInterruptedItemsCount() int64 {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	return h.interruptedItems
+}
+
+// getHealSummary returns the final summary of the sequence,
+// nil while the sequence is running.
+func (h *healSequence) getHealSummary() *madmin.HealSummary {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	if h.summary == nil {
+		return nil
+	}
+	summary := *h.summary
+	return &summary
+}
+
 func (h *healSequence) healFromSourceCh() {
+	go h.healRetriesLoop(h.ctx)
 	h.healItemsFromSourceCh()
 }
 
@@ -813,7 +1548,16 @@ func (h *healSequence) healItems(objAPI ObjectLayer, bucketsOnly bool) error {
 	}
 
 	// Heal buckets and objects
-	return h.healBuckets(objAPI, bucketsOnly)
+	if err := h.healBuckets(objAPI, bucketsOnly); err != nil {
+		return err
+	}
+
+	// Leftover multipart uploads do not belong to a known bucket,
+	// only look for them when all buckets are healed.
+	if h.settings.Dangling && h.bucket == "" && !bucketsOnly {
+		return h.healDanglingUploads(objAPI)
+	}
+	return nil
 }
 
 // traverseAndHeal - traverses on-disk data and performs healing
@@ -0,0 +1,353 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestHealSequenceFailedItems(t *testing.T) {
+	h := newBgHealSequence()
+	defer h.cancelCtx()
+
+	errHeal := errors.New("heal failed")
+	for i := 0; i < healFailedItemsMax+10; i++ {
+		h.logHealFailure("bucket", fmt.Sprintf("object-%d", i), "", errHeal)
+	}
+
+	items := h.getHealFailedItems()
+	if len(items) != healFailedItemsMax {
+		t.Fatalf("expected %d failed items, got %d", healFailedItemsMax, len(items))
+	}
+	for i := 1; i < len(items); i++ {
+		if items[i].LastAttempt.After(items[i-1].LastAttempt) {
+			t.Fatalf("expected failed items to be sorted latest first")
+		}
+	}
+	if items[0].Reason != errHeal.Error() || items[0].Bucket != "bucket" {
+		t.Fatalf("unexpected failed item %#v", items[0])
+	}
+
+	h.mutex.Lock()
+	h.removeHealFailedItem("bucket", items[0].Object, "")
+	h.mutex.Unlock()
+	if len(h.getHealFailedItems()) != healFailedItemsMax-1 {
+		t.Fatal("expected healed object to be removed from failed items")
+	}
+}
+
+func TestHealSequencePauseResume(t *testing.T) {
+	h := newBgHealSequence()
+	defer h.cancelCtx()
+
+	h.pause()
+	if !h.isPaused() {
+		t.Fatal("expected heal sequence to be paused")
+	}
+
+	done := make(chan struct{})
+	go func() {
+		h.waitIfPaused(context.Background())
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("expected waitIfPaused to block while paused")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	h.resume()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected waitIfPaused to return after resume")
+	}
+	if h.isPaused() {
+		t.Fatal("expected heal sequence to be resumed")
+	}
+}
+
+func TestBackgroundHealAction(t *testing.T) {
+	saved := globalBackgroundHealState
+	defer func() {
+		globalBackgroundHealState = saved
+	}()
+
+	globalBackgroundHealState = newHealState(false)
+	if err := backgroundHealAction(madmin.BgHealActionPause); err != errServerNotInitialized {
+		t.Fatalf("expected %v without background heal sequence, got %v", errServerNotInitialized, err)
+	}
+
+	h := newBgHealSequence()
+	defer h.cancelCtx()
+	globalBackgroundHealState.healSeqMap[SlashSeparator] = h
+
+	if err := backgroundHealAction(madmin.BgHealActionPause); err != nil {
+		t.Fatal(err)
+	}
+	if !h.isPaused() {
+		t.Fatal("expected background heal sequence to be paused")
+	}
+	if err := backgroundHealAction(madmin.BgHealActionResume); err != nil {
+		t.Fatal(err)
+	}
+	if h.isPaused() {
+		t.Fatal("expected background heal sequence to be resumed")
+	}
+	if err := backgroundHealAction(madmin.BgHealAction("stop")); err == nil {
+		t.Fatal("expected unsupported action to fail")
+	}
+}
+
+func TestHealSequenceNextHealRound(t *testing.T) {
+	h := newBgHealSequence()
+	defer h.cancelCtx()
+
+	interval := time.Hour
+	if next := h.getNextHealRound(interval); !next.Equal(h.startTime.Add(interval)) {
+		t.Fatalf("expected next heal round at %s, got %s", h.startTime.Add(interval), next)
+	}
+
+	h.logHeal(madmin.HealItemObject)
+	lastActivity := h.getLastHealActivity()
+	if next := h.getNextHealRound(interval); !next.Equal(lastActivity.Add(interval)) {
+		t.Fatalf("expected next heal round at %s, got %s", lastActivity.Add(interval), next)
+	}
+
+	// Round in progress with a known duration of the previous round.
+	h.startHealRound()
+	h.mutex.Lock()
+	h.lastRoundDuration = 2 * interval
+	roundStart := h.roundStart
+	h.mutex.Unlock()
+	if next := h.getNextHealRound(interval); !next.Equal(roundStart.Add(2 * interval)) {
+		t.Fatalf("expected heal round to complete at %s, got %s", roundStart.Add(2*interval), next)
+	}
+	h.endHealRound()
+
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	if h.roundActive != 0 || h.lastRoundDuration >= 2*interval {
+		t.Fatalf("expected heal round to be complete")
+	}
+}
+
+func TestHealSequenceWouldHeal(t *testing.T) {
+	h := newBgHealSequence()
+	defer h.cancelCtx()
+
+	healthy := madmin.HealResultItem{Type: madmin.HealItemObject}
+	healthy.Before.Drives = []madmin.HealDriveInfo{{State: madmin.DriveStateOk}, {State: madmin.DriveStateOffline}}
+	degraded := madmin.HealResultItem{Type: madmin.HealItemObject}
+	degraded.Before.Drives = []madmin.HealDriveInfo{{State: madmin.DriveStateOk}, {State: madmin.DriveStateMissing}}
+
+	h.logWouldHeal(healthy)
+	h.logWouldHeal(degraded)
+	h.logWouldHeal(degraded)
+
+	if count := h.getWouldHealItemsMap()[madmin.HealItemObject]; count != 2 {
+		t.Fatalf("expected 2 objects to be reported as would heal, got %d", count)
+	}
+	if count := h.getHealedItemsMap()[madmin.HealItemObject]; count != 0 {
+		t.Fatalf("expected no healed objects in dry-run, got %d", count)
+	}
+}
+
+func TestDiskHealProgress(t *testing.T) {
+	ahs := newHealState(false)
+	ep, err := NewEndpoint("/tmp/disk1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ahs.pushHealLocalDisks(ep)
+
+	// Untracked disks are ignored.
+	ahs.updateDiskHealProgress(ep.String(), 1, 1, 1)
+	if progress := ahs.getDiskHealProgress(); progress != nil {
+		t.Fatalf("expected no progress, got %v", progress)
+	}
+
+	ahs.startDiskHealProgress(ep.String(), madmin.HealDriveProgress{ObjectsRemaining: 12})
+	ahs.updateDiskHealProgress(ep.String(), 10, 0, 0)
+	ahs.updateDiskHealProgress(ep.String(), 0, 4, 4096)
+	ahs.diskHealBucketDone(ep.String())
+	p := ahs.getDiskHealProgress()[ep.String()]
+	if p.ObjectsScanned != 10 || p.ObjectsHealed != 4 || p.BytesHealed != 4096 || p.Started.IsZero() {
+		t.Fatalf("unexpected progress %#v", p)
+	}
+	if p.ObjectsRemaining != 2 || p.BucketsHealed != 1 {
+		t.Fatalf("unexpected progress %#v", p)
+	}
+
+	// The estimate of remaining objects never goes below zero.
+	ahs.updateDiskHealProgress(ep.String(), 5, 0, 0)
+	if p = ahs.getDiskHealProgress()[ep.String()]; p.ObjectsRemaining != 0 {
+		t.Fatalf("unexpected progress %#v", p)
+	}
+
+	ahs.popHealLocalDisks(ep)
+	if progress := ahs.getDiskHealProgress(); progress != nil {
+		t.Fatalf("expected progress to be cleared, got %v", progress)
+	}
+}
+
+func TestHealSequenceDrain(t *testing.T) {
+	h := newBgHealSequence()
+	h.sourceCh = make(chan healSource, 2)
+	h.logHeal(madmin.HealItemObject)
+	h.sourceCh <- healSource{bucket: nopHeal}
+	h.sourceCh <- healSource{bucket: "bucket", object: "object"}
+	h.retryHeal(healSource{bucket: "bucket", object: "retried"}, errDiskNotFound, 0, 0)
+
+	if h.getHealSummary() != nil {
+		t.Fatal("expected no summary before the sequence is stopped")
+	}
+
+	// Without an object layer nothing can be healed, queued
+	// items must be recorded as interrupted.
+	h.drainSourceCh(nil, time.Second)
+
+	if n := h.getInterruptedItemsCount(); n != 2 {
+		t.Fatalf("expected 2 interrupted items, got %d", n)
+	}
+	if n := h.getHealRetriesCount(); n != 0 {
+		t.Fatalf("expected no pending retries, got %d", n)
+	}
+	for _, item := range h.getHealFailedItems() {
+		if item.Reason != errHealInterrupted.Error() {
+			t.Fatalf("unexpected failure reason %s", item.Reason)
+		}
+	}
+
+	summary := h.getHealSummary()
+	if summary == nil {
+		t.Fatal("expected a summary once the sequence is stopped")
+	}
+	if summary.ScannedItemsCount != 1 || summary.InterruptedItemsCount != 2 || summary.FailedItemsCount != 2 {
+		t.Fatalf("unexpected summary %#v", summary)
+	}
+}
+
+func TestHealSequenceDrainDryRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	bucket := "bucket"
+	if err = obj.MakeBucketWithLocation(ctx, bucket, BucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	data := bytes.Repeat([]byte("a"), 1024)
+	for _, object := range []string{"missing", "dangling"} {
+		if _, err = obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// "missing" lacks its metadata on one drive, "dangling" is
+	// left on one drive only and would be purged by a real heal.
+	if err = os.Remove(pathJoin(fsDirs[0], bucket, "missing", xlStorageFormatFile)); err != nil {
+		t.Fatal(err)
+	}
+	for _, dir := range fsDirs[1:] {
+		if err = os.RemoveAll(pathJoin(dir, bucket, "dangling")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	globalHealConfigMu.Lock()
+	restoreHealConfig := globalHealConfig
+	globalHealConfig.DryRun = true
+	globalHealConfigMu.Unlock()
+	defer func() {
+		globalHealConfigMu.Lock()
+		globalHealConfig = restoreHealConfig
+		globalHealConfigMu.Unlock()
+	}()
+
+	h := newBgHealSequence()
+	h.sourceCh = make(chan healSource, 2)
+	opts := &madmin.HealOpts{ScanMode: madmin.HealNormalScan, Remove: true, PurgeDangling: true}
+	h.sourceCh <- healSource{bucket: bucket, object: "missing", opts: opts}
+	h.sourceCh <- healSource{bucket: bucket, object: "dangling", opts: opts}
+	h.drainSourceCh(obj, time.Minute)
+
+	if _, err = os.Stat(pathJoin(fsDirs[0], bucket, "missing", xlStorageFormatFile)); !os.IsNotExist(err) {
+		t.Fatalf("expected the dry run not to heal the object, got %v", err)
+	}
+	if _, err = os.Stat(pathJoin(fsDirs[0], bucket, "dangling", xlStorageFormatFile)); err != nil {
+		t.Fatalf("expected the dry run not to purge the dangling object, got %v", err)
+	}
+}
+
+func TestHealSequenceItemsByType(t *testing.T) {
+	h := newBgHealSequence()
+	h.logHealed(madmin.HealItemObject)
+	h.logHealFailed(madmin.HealItemObject)
+	h.logHealFailed(madmin.HealItemObject)
+	h.logHealFailed(madmin.HealItemBucket)
+
+	if healed := h.getHealedItemsMap()[madmin.HealItemObject]; healed != 1 {
+		t.Fatalf("expected 1 healed object, got %d", healed)
+	}
+	failed := h.getFailedItemsMap()
+	if failed[madmin.HealItemObject] != 2 || failed[madmin.HealItemBucket] != 1 {
+		t.Fatalf("unexpected failed items %v", failed)
+	}
+}
+
+func TestPushHealLocalDisks(t *testing.T) {
+	ahs := newHealState(false)
+	ep, err := NewEndpoint("/tmp/disk1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !ahs.pushHealLocalDisks(ep) {
+		t.Fatal("expected disk to be added")
+	}
+	select {
+	case <-ahs.healLocalDisksCh:
+	default:
+		t.Fatal("expected disk monitor to be signaled")
+	}
+
+	// Disks already marked for healing are not signaled again.
+	if ahs.pushHealLocalDisks(ep) {
+		t.Fatal("expected disk to be already added")
+	}
+	select {
+	case <-ahs.healLocalDisksCh:
+		t.Fatal("unexpected disk monitor signal")
+	default:
+	}
+}
@@ -51,6 +51,9 @@ func registerAdminRouter(router *mux.Router, enableConfigOps, enableIAMOps bool)
 	for _, adminVersion := range adminVersions {
 		// Restart and stop MinIO service.
 		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/service").HandlerFunc(httpTraceAll(adminAPI.ServiceHandler)).Queries("action", "{action:.*}")
+		// Pause and resume background subsystems for maintenance.
+		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/maintenance").HandlerFunc(httpTraceAll(adminAPI.MaintenanceHandler)).Queries("action", "{action:.*}")
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/maintenance/status").HandlerFunc(httpTraceAll(adminAPI.MaintenanceStatusHandler))
 		// Update MinIO servers.
 		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/update").HandlerFunc(httpTraceAll(adminAPI.ServerUpdateHandler)).Queries("updateURL", "{updateURL:.*}")
 
@@ -71,6 +74,30 @@ func registerAdminRouter(router *mux.Router, enableConfigOps, enableIAMOps bool)
 			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/heal/{bucket}/{prefix:.*}").HandlerFunc(httpTraceAll(adminAPI.HealHandler))
 
 			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/background-heal/status").HandlerFunc(httpTraceAll(adminAPI.BackgroundHealStatusHandler))
+			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/background-heal/{action:pause|resume}").HandlerFunc(httpTraceAll(adminAPI.BackgroundHealActionHandler))
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/background-heal/report").HandlerFunc(httpTraceAll(adminAPI.BackgroundHealReportHandler))
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/background-heal/scrub-reports").HandlerFunc(httpTraceAll(adminAPI.BackgroundHealScrubReportsHandler))
+			adminRouter.Methods(http.MethodGet).Path(adminVersion+"/background-heal/scrub-report").HandlerFunc(httpTraceHdrs(adminAPI.BackgroundHealScrubReportHandler)).Queries("id", "{id:.*}")
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/background-heal/queue").HandlerFunc(httpTraceHdrs(adminAPI.BackgroundHealQueueHandler))
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/background-heal/throttle").HandlerFunc(httpTraceAll(adminAPI.BackgroundHealThrottleHandler))
+			adminRouter.Methods(http.MethodPut).Path(adminVersion + "/background-heal/throttle").HandlerFunc(httpTraceAll(adminAPI.SetBackgroundHealThrottleHandler))
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/background-heal/dead-letters").HandlerFunc(httpTraceAll(adminAPI.BackgroundHealDeadLettersHandler))
+			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/heal-objects").HandlerFunc(httpTraceAll(adminAPI.HealObjectsHandler))
+
+			// Pool rebalance operations
+			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/rebalance/start").HandlerFunc(httpTraceAll(adminAPI.RebalanceStartHandler))
+			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/rebalance/stop").HandlerFunc(httpTraceAll(adminAPI.RebalanceStopHandler))
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/rebalance/status").HandlerFunc(httpTraceAll(adminAPI.RebalanceStatusHandler))
+
+			// Key rotation of encrypted objects
+			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/kms/rotate/start").HandlerFunc(httpTraceAll(adminAPI.KMSRotateStartHandler))
+			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/kms/rotate/resume").HandlerFunc(httpTraceAll(adminAPI.KMSRotateResumeHandler))
+			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/kms/rotate/stop").HandlerFunc(httpTraceAll(adminAPI.KMSRotateStopHandler))
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/kms/rotate/status").HandlerFunc(httpTraceAll(adminAPI.KMSRotateStatusHandler))
+
+			// Remote tiers for lifecycle transitions
+			adminRouter.Methods(http.MethodPut).Path(adminVersion + "/tier").HandlerFunc(httpTraceHdrs(adminAPI.AddTierHandler))
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/tier").HandlerFunc(httpTraceAll(adminAPI.ListTiersHandler))
 
 			/// Health operations
 
@@ -122,6 +149,7 @@ func registerAdminRouter(router *mux.Router, enableConfigOps, enableIAMOps bool)
 			// Service accounts ops
 			adminRouter.Methods(http.MethodPut).Path(adminVersion + "/add-service-account").HandlerFunc(httpTraceHdrs(adminAPI.AddServiceAccount))
 			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/list-service-accounts").HandlerFunc(httpTraceHdrs(adminAPI.ListServiceAccounts))
+			adminRouter.Methods(http.MethodGet).Path(adminVersion+"/info-service-account").HandlerFunc(httpTraceHdrs(adminAPI.InfoServiceAccount)).Queries("accessKey", "{accessKey:.*}")
 			adminRouter.Methods(http.MethodDelete).Path(adminVersion+"/delete-service-account").HandlerFunc(httpTraceHdrs(adminAPI.DeleteServiceAccount)).Queries("accessKey", "{accessKey:.*}")
 
 			if adminVersion == adminAPIVersionV2Prefix {
@@ -176,6 +204,27 @@ func registerAdminRouter(router *mux.Router, enableConfigOps, enableIAMOps bool)
 			adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-quota").HandlerFunc(
 				httpTraceHdrs(adminAPI.PutBucketQuotaConfigHandler)).Queries("bucket", "{bucket:.*}")
 
+			// GetBucketStorageClassConfig
+			adminRouter.Methods(http.MethodGet).Path(adminVersion+"/get-bucket-storage-class").HandlerFunc(
+				httpTraceHdrs(adminAPI.GetBucketStorageClassConfigHandler)).Queries("bucket", "{bucket:.*}")
+			// PutBucketStorageClassConfig
+			adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-storage-class").HandlerFunc(
+				httpTraceHdrs(adminAPI.PutBucketStorageClassConfigHandler)).Queries("bucket", "{bucket:.*}")
+
+			// GetBucketHealConfig
+			adminRouter.Methods(http.MethodGet).Path(adminVersion+"/get-bucket-heal").HandlerFunc(
+				httpTraceHdrs(adminAPI.GetBucketHealConfigHandler)).Queries("bucket", "{bucket:.*}")
+			// PutBucketHealConfig
+			adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-heal").HandlerFunc(
+				httpTraceHdrs(adminAPI.PutBucketHealConfigHandler)).Queries("bucket", "{bucket:.*}")
+
+			// GetBucketListCacheConfig
+			adminRouter.Methods(http.MethodGet).Path(adminVersion+"/get-bucket-list-cache").HandlerFunc(
+				httpTraceHdrs(adminAPI.GetBucketListCacheConfigHandler)).Queries("bucket", "{bucket:.*}")
+			// PutBucketListCacheConfig
+			adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-list-cache").HandlerFunc(
+				httpTraceHdrs(adminAPI.PutBucketListCacheConfigHandler)).Queries("bucket", "{bucket:.*}")
+
 			// Bucket replication operations
 			// GetBucketTargetHandler
 			adminRouter.Methods(http.MethodGet).Path(adminVersion+"/list-remote-targets").HandlerFunc(
@@ -186,6 +235,12 @@ func registerAdminRouter(router *mux.Router, enableConfigOps, enableIAMOps bool)
 			// RemoveRemoteTargetHandler
 			adminRouter.Methods(http.MethodDelete).Path(adminVersion+"/remove-remote-target").HandlerFunc(
 				httpTraceHdrs(adminAPI.RemoveRemoteTargetHandler)).Queries("bucket", "{bucket:.*}", "arn", "{arn:.*}")
+			// ResyncBucketReplicationHandler
+			adminRouter.Methods(http.MethodPost).Path(adminVersion+"/replication/resync").HandlerFunc(
+				httpTraceHdrs(adminAPI.ResyncBucketReplicationHandler)).Queries("bucket", "{bucket:.*}")
+			// ReplicationResyncStatusHandler
+			adminRouter.Methods(http.MethodGet).Path(adminVersion+"/replication/resync/status").HandlerFunc(
+				httpTraceHdrs(adminAPI.ReplicationResyncStatusHandler)).Queries("bucket", "{bucket:.*}")
 		}
 
 		if globalIsDistErasure {
@@ -216,6 +271,10 @@ func registerAdminRouter(router *mux.Router, enableConfigOps, enableIAMOps bool)
 				HandlerFunc(httpTraceHdrs(adminAPI.HealthInfoHandler))
 			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/bandwidth").
 				HandlerFunc(httpTraceHdrs(adminAPI.BandwidthMonitorHandler))
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/drive-health").
+				HandlerFunc(httpTraceHdrs(adminAPI.DrivesHealthHandler))
+			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/speedtest").
+				HandlerFunc(httpTraceHdrs(adminAPI.SpeedtestHandler))
 		}
 	}
 
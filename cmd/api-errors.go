@@ -270,6 +270,7 @@ const (
 	ErrHealMissingBucket
 	ErrHealAlreadyRunning
 	ErrHealOverlappingPaths
+	ErrHealNoSuchScrubReport
 	ErrIncorrectContinuationToken
 
 	// S3 Select Errors
@@ -1288,6 +1289,11 @@ var errorCodes = errorCodeMap{
 		Description:    "",
 		HTTPStatusCode: http.StatusBadRequest,
 	},
+	ErrHealNoSuchScrubReport: {
+		Code:           "XMinioHealNoSuchScrubReport",
+		Description:    "The specified scrub report does not exist",
+		HTTPStatusCode: http.StatusNotFound,
+	},
 	ErrBackendDown: {
 		Code:           "XMinioBackendDown",
 		Description:    "Object storage backend is unreachable",
@@ -1775,6 +1781,8 @@ func toAPIErrorCode(ctx context.Context, err error) (apiErr APIErrorCode) {
 		apiErr = ErrAdminInvalidArgument
 	case errNoSuchUser:
 		apiErr = ErrAdminNoSuchUser
+	case errNoSuchServiceAccount:
+		apiErr = ErrServiceAccountNotFound
 	case errNoSuchGroup:
 		apiErr = ErrAdminNoSuchGroup
 	case errGroupNotEmpty:
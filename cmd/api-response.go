@@ -350,7 +350,7 @@ type DeleteError struct {
 	Code      string
 	Message   string
 	Key       string
-	VersionID string `xml:"VersionId"`
+	VersionID string `xml:"VersionId,omitempty"`
 }
 
 // DeleteObjectsResponse container for multiple object deletes.
@@ -700,11 +700,17 @@ func generateListMultipartUploadsResponse(bucket string, multipartsInfo ListMult
 // generate multi objects delete response.
 func generateMultiDeleteResponse(quiet bool, deletedObjects []DeletedObject, errs []DeleteError) DeleteObjectsResponse {
 	deleteResp := DeleteObjectsResponse{}
+	// In quiet mode only the errors are reported, successful
+	// deletes are omitted from the response.
 	if !quiet {
-		deleteResp.DeletedObjects = deletedObjects
-	}
-	if len(errs) == len(deletedObjects) {
-		deleteResp.DeletedObjects = nil
+		for _, dobj := range deletedObjects {
+			// Skip slots of objects which failed to delete
+			// or were duplicates in the request.
+			if dobj.ObjectName == "" {
+				continue
+			}
+			deleteResp.DeletedObjects = append(deleteResp.DeletedObjects, dobj)
+		}
 	}
 	deleteResp.Errors = errs
 	return deleteResp
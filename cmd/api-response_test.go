@@ -17,6 +17,7 @@
 package cmd
 
 import (
+	"bytes"
 	"net/http"
 	"testing"
 )
@@ -122,3 +123,61 @@ func TestGetURLScheme(t *testing.T) {
 		t.Errorf("Expected %s, got %s", httpsScheme, gotScheme)
 	}
 }
+
+// Tests multi-delete response for mixed version and non-version deletes.
+func TestGenerateMultiDeleteResponse(t *testing.T) {
+	deletedObjects := []DeletedObject{
+		// Delete marker created for a non-version delete.
+		{ObjectName: "obj1", DeleteMarker: true, DeleteMarkerVersionID: "dm-1"},
+		// Permanent deletion of a specific version.
+		{ObjectName: "obj2", VersionID: "v-2"},
+		// Permanent deletion of a delete marker version.
+		{ObjectName: "obj3", VersionID: "dm-3", DeleteMarker: true, DeleteMarkerVersionID: "dm-3"},
+		// Slot of an object which failed to delete.
+		{},
+		// Non-versioned delete.
+		{ObjectName: "obj5"},
+	}
+	deleteErrors := []DeleteError{
+		{Code: "NoSuchVersion", Message: "not found", Key: "obj4", VersionID: "v-4"},
+	}
+
+	resp := generateMultiDeleteResponse(false, deletedObjects, deleteErrors)
+	if len(resp.DeletedObjects) != 4 {
+		t.Fatalf("Expected 4 deleted objects, got %d", len(resp.DeletedObjects))
+	}
+	if len(resp.Errors) != 1 {
+		t.Fatalf("Expected 1 error, got %d", len(resp.Errors))
+	}
+
+	encoded := encodeResponse(resp)
+	for _, expected := range []string{
+		"<Deleted><DeleteMarker>true</DeleteMarker><DeleteMarkerVersionId>dm-1</DeleteMarkerVersionId><Key>obj1</Key></Deleted>",
+		"<Deleted><Key>obj2</Key><VersionId>v-2</VersionId></Deleted>",
+		"<Deleted><DeleteMarker>true</DeleteMarker><DeleteMarkerVersionId>dm-3</DeleteMarkerVersionId><Key>obj3</Key><VersionId>dm-3</VersionId></Deleted>",
+		"<Deleted><Key>obj5</Key></Deleted>",
+		"<Error><Code>NoSuchVersion</Code><Message>not found</Message><Key>obj4</Key><VersionId>v-4</VersionId></Error>",
+	} {
+		if !bytes.Contains(encoded, []byte(expected)) {
+			t.Errorf("Expected %s in response, got %s", expected, string(encoded))
+		}
+	}
+	if bytes.Contains(encoded, []byte("<Deleted></Deleted>")) {
+		t.Errorf("Unexpected empty deleted entry in response %s", string(encoded))
+	}
+
+	// Quiet mode reports only errors.
+	resp = generateMultiDeleteResponse(true, deletedObjects, deleteErrors)
+	if len(resp.DeletedObjects) != 0 {
+		t.Errorf("Expected no deleted objects in quiet mode, got %d", len(resp.DeletedObjects))
+	}
+	if len(resp.Errors) != 1 {
+		t.Errorf("Expected 1 error in quiet mode, got %d", len(resp.Errors))
+	}
+
+	// Non-version delete errors do not report an empty VersionId.
+	resp = generateMultiDeleteResponse(true, nil, []DeleteError{{Code: "AccessDenied", Key: "obj6"}})
+	if encoded = encodeResponse(resp); bytes.Contains(encoded, []byte("<VersionId>")) {
+		t.Errorf("Unexpected VersionId in response %s", string(encoded))
+	}
+}
@@ -18,6 +18,7 @@ package cmd
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/minio/minio/cmd/logger"
@@ -91,6 +92,52 @@ func waitForLowHTTPReq(maxIO int, maxWait time.Duration) {
 	}
 }
 
+// healThrottle is a token bucket limiting the rate of heal
+// operations on this server, shared by all erasure sets.
+type healThrottle struct {
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// wait blocks until a heal operation is allowed at the given
+// rate per second, a rate <= 0 never blocks.
+func (t *healThrottle) wait(ctx context.Context, rate int) error {
+	for {
+		if rate <= 0 {
+			return nil
+		}
+
+		t.mu.Lock()
+		now := time.Now()
+		if t.last.IsZero() {
+			t.tokens = float64(rate)
+		} else {
+			t.tokens += now.Sub(t.last).Seconds() * float64(rate)
+		}
+		// Allow bursts of up to one second worth of operations.
+		if t.tokens > float64(rate) {
+			t.tokens = float64(rate)
+		}
+		t.last = now
+		if t.tokens >= 1 {
+			t.tokens--
+			t.mu.Unlock()
+			return nil
+		}
+		delay := time.Duration((1 - t.tokens) / float64(rate) * float64(time.Second))
+		t.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
 // Wait for heal requests and process them
 func (h *healRoutine) run(ctx context.Context, objAPI ObjectLayer) {
 	for {
@@ -100,6 +147,9 @@ func (h *healRoutine) run(ctx context.Context, objAPI ObjectLayer) {
 				return
 			}
 
+			// Hold on to the task while heal is paused for maintenance.
+			globalMaintenance.waitIfPaused(ctx, madmin.MaintenanceHeal)
+
 			var res madmin.HealResultItem
 			var err error
 			switch task.bucket {
@@ -0,0 +1,57 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestHealThrottle(t *testing.T) {
+	var throttle healThrottle
+	ctx := context.Background()
+
+	// Unlimited rate never blocks.
+	for i := 0; i < 1000; i++ {
+		if err := throttle.wait(ctx, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	const rate = 50
+	start := time.Now()
+	// First second worth of operations is allowed as a burst,
+	// the next 25 operations take at least 500ms.
+	for i := 0; i < rate+rate/2; i++ {
+		if err := throttle.wait(ctx, rate); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Fatalf("expected heal operations to be throttled, took %s", elapsed)
+	}
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	for i := 0; i < rate*2; i++ {
+		if err := throttle.wait(cctx, rate); err != nil {
+			return
+		}
+	}
+	t.Fatal("expected canceled context to abort throttling")
+}
@@ -27,18 +27,106 @@ import (
 	"github.com/minio/minio/cmd/logger"
 	"github.com/minio/minio/pkg/color"
 	"github.com/minio/minio/pkg/console"
+	"github.com/minio/minio/pkg/madmin"
 )
 
 const (
 	defaultMonitorNewDiskInterval = time.Second * 10
 	healingTrackerFilename        = ".healing.bin"
+
+	// Interval between saves of the progress of healing a
+	// disk to its healing tracker.
+	healingTrackerSaveInterval = time.Minute
 )
 
 //go:generate msgp -file $GOFILE -unexported
 type healingTracker struct {
 	ID string
 
-	// future add more tracking capabilities
+	// Progress of healing the disk, saved periodically
+	// so that it is kept across restarts.
+	Started          time.Time
+	LastUpdate       time.Time
+	ObjectsScanned   uint64
+	ObjectsHealed    uint64
+	ObjectsRemaining uint64
+	BytesHealed      uint64
+	BucketsHealed    int
+}
+
+// loadHealingTracker reads the healing tracker of the disk.
+func loadHealingTracker(ctx context.Context, disk StorageAPI) (healingTracker, error) {
+	var h healingTracker
+	b, err := disk.ReadAll(ctx, minioMetaBucket,
+		pathJoin(bucketMetaPrefix, slashSeparator, healingTrackerFilename))
+	if err != nil {
+		return h, err
+	}
+	_, err = h.UnmarshalMsg(b)
+	return h, err
+}
+
+// save writes the healing tracker to the disk.
+func (h healingTracker) save(ctx context.Context, disk StorageAPI) error {
+	b, err := h.MarshalMsg(nil)
+	if err != nil {
+		return err
+	}
+	return disk.WriteAll(ctx, minioMetaBucket,
+		pathJoin(bucketMetaPrefix, slashSeparator, healingTrackerFilename), b)
+}
+
+// progress returns the heal progress recorded by the tracker.
+func (h healingTracker) progress() madmin.HealDriveProgress {
+	return madmin.HealDriveProgress{
+		Started:          h.Started,
+		ObjectsScanned:   h.ObjectsScanned,
+		ObjectsHealed:    h.ObjectsHealed,
+		ObjectsRemaining: h.ObjectsRemaining,
+		BytesHealed:      h.BytesHealed,
+		BucketsHealed:    h.BucketsHealed,
+	}
+}
+
+// update records the heal progress in the tracker.
+func (h *healingTracker) update(p madmin.HealDriveProgress) {
+	h.Started = p.Started
+	h.LastUpdate = UTCNow()
+	h.ObjectsScanned = p.ObjectsScanned
+	h.ObjectsHealed = p.ObjectsHealed
+	h.ObjectsRemaining = p.ObjectsRemaining
+	h.BytesHealed = p.BytesHealed
+	h.BucketsHealed = p.BucketsHealed
+}
+
+// saveHealProgress periodically saves the progress of healing the disk
+// to its healing tracker, until the returned function is called. The
+// tracker is never written once the returned function has returned.
+func saveHealProgress(ctx context.Context, disk StorageAPI, h healingTracker) (stop func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		t := time.NewTicker(healingTrackerSaveInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+			}
+			p, ok := globalBackgroundHealState.getDiskHealProgress()[disk.String()]
+			if !ok {
+				continue
+			}
+			h.update(p)
+			logger.LogIf(ctx, h.save(ctx, disk))
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
 }
 
 func initAutoHeal(ctx context.Context, objAPI ObjectLayer) {
@@ -77,6 +165,9 @@ func initAutoHeal(ctx context.Context, objAPI ObjectLayer) {
 	}
 
 	go monitorLocalDisksAndHeal(ctx, z, bgSeq)
+
+	// Periodically deep verify all objects, if configured.
+	go runHealScrub(ctx, z)
 }
 
 func getLocalDisksToHeal() (disksToHeal Endpoints) {
@@ -120,107 +211,152 @@ wait:
 		case <-ctx.Done():
 			return
 		case <-diskCheckTimer.C:
-			// Reset to next interval.
-			diskCheckTimer.Reset(defaultMonitorNewDiskInterval)
+		case <-globalBackgroundHealState.healLocalDisksCh:
+			// Drives found at runtime are healed right away.
+			if !diskCheckTimer.Stop() {
+				<-diskCheckTimer.C
+			}
+		}
+		// Reset to next interval.
+		diskCheckTimer.Reset(defaultMonitorNewDiskInterval)
 
-			var erasureSetInPoolDisksToHeal []map[int][]StorageAPI
+		var erasureSetInPoolDisksToHeal []map[int][]StorageAPI
 
-			healDisks := globalBackgroundHealState.getHealLocalDisks()
-			if len(healDisks) > 0 {
-				// Reformat disks
-				bgSeq.sourceCh <- healSource{bucket: SlashSeparator}
+		healDisks := globalBackgroundHealState.getHealLocalDisks()
+		if len(healDisks) > 0 {
+			// Reformat disks
+			bgSeq.sourceCh <- healSource{bucket: SlashSeparator}
 
-				// Ensure that reformatting disks is finished
-				bgSeq.sourceCh <- healSource{bucket: nopHeal}
+			// Ensure that reformatting disks is finished
+			bgSeq.sourceCh <- healSource{bucket: nopHeal}
 
-				logger.Info(fmt.Sprintf("Found drives to heal %d, proceeding to heal content...",
-					len(healDisks)))
+			logger.Info(fmt.Sprintf("Found drives to heal %d, proceeding to heal content...",
+				len(healDisks)))
 
-				erasureSetInPoolDisksToHeal = make([]map[int][]StorageAPI, len(z.serverPools))
-				for i := range z.serverPools {
-					erasureSetInPoolDisksToHeal[i] = map[int][]StorageAPI{}
-				}
+			erasureSetInPoolDisksToHeal = make([]map[int][]StorageAPI, len(z.serverPools))
+			for i := range z.serverPools {
+				erasureSetInPoolDisksToHeal[i] = map[int][]StorageAPI{}
 			}
+		}
+
+		if serverDebugLog {
+			console.Debugf(color.Green("healDisk:")+" disk check timer fired, attempting to heal %d drives\n", len(healDisks))
+		}
 
-			if serverDebugLog {
-				console.Debugf(color.Green("healDisk:")+" disk check timer fired, attempting to heal %d drives\n", len(healDisks))
+		// heal only if new disks found.
+		for _, endpoint := range healDisks {
+			disk, format, err := connectEndpoint(endpoint)
+			if err != nil {
+				printEndpointError(endpoint, err, true)
+				continue
 			}
 
-			// heal only if new disks found.
-			for _, endpoint := range healDisks {
-				disk, format, err := connectEndpoint(endpoint)
-				if err != nil {
-					printEndpointError(endpoint, err, true)
-					continue
-				}
+			poolIdx := globalEndpoints.GetLocalPoolIdx(disk.Endpoint())
+			if poolIdx < 0 {
+				continue
+			}
 
-				poolIdx := globalEndpoints.GetLocalPoolIdx(disk.Endpoint())
-				if poolIdx < 0 {
-					continue
-				}
+			// Calculate the set index where the current endpoint belongs
+			z.serverPools[poolIdx].erasureDisksMu.RLock()
+			// Protect reading reference format.
+			setIndex, _, err := findDiskIndex(z.serverPools[poolIdx].format, format)
+			z.serverPools[poolIdx].erasureDisksMu.RUnlock()
+			if err != nil {
+				printEndpointError(endpoint, err, false)
+				continue
+			}
 
-				// Calculate the set index where the current endpoint belongs
-				z.serverPools[poolIdx].erasureDisksMu.RLock()
-				// Protect reading reference format.
-				setIndex, _, err := findDiskIndex(z.serverPools[poolIdx].format, format)
-				z.serverPools[poolIdx].erasureDisksMu.RUnlock()
-				if err != nil {
-					printEndpointError(endpoint, err, false)
-					continue
-				}
+			erasureSetInPoolDisksToHeal[poolIdx][setIndex] = append(erasureSetInPoolDisksToHeal[poolIdx][setIndex], disk)
+		}
 
-				erasureSetInPoolDisksToHeal[poolIdx][setIndex] = append(erasureSetInPoolDisksToHeal[poolIdx][setIndex], disk)
-			}
+		buckets, _ := z.ListBuckets(ctx)
 
-			buckets, _ := z.ListBuckets(ctx)
-
-			// Heal latest buckets first.
-			sort.Slice(buckets, func(i, j int) bool {
-				return buckets[i].Created.After(buckets[j].Created)
-			})
-
-			for i, setMap := range erasureSetInPoolDisksToHeal {
-				for setIndex, disks := range setMap {
-					for _, disk := range disks {
-						logger.Info("Healing disk '%s' on %s pool", disk, humanize.Ordinal(i+1))
-
-						// So someone changed the drives underneath, healing tracker missing.
-						if !disk.Healing() {
-							logger.Info("Healing tracker missing on '%s', disk was swapped again on %s pool", disk, humanize.Ordinal(i+1))
-							diskID, err := disk.GetDiskID()
-							if err != nil {
-								logger.LogIf(ctx, err)
-								// reading format.json failed or not found, proceed to look
-								// for new disks to be healed again, we cannot proceed further.
-								goto wait
-							}
-
-							if err := saveHealingTracker(disk, diskID); err != nil {
-								logger.LogIf(ctx, err)
-								// Unable to write healing tracker, permission denied or some
-								// other unexpected error occurred. Proceed to look for new
-								// disks to be healed again, we cannot proceed further.
-								goto wait
-							}
-						}
+		// Heal latest buckets first.
+		sort.Slice(buckets, func(i, j int) bool {
+			return buckets[i].Created.After(buckets[j].Created)
+		})
+
+		// Heal buckets with a higher heal priority first.
+		sortBucketsByHealPriority(buckets, bucketHealPriority)
+
+		for i, setMap := range erasureSetInPoolDisksToHeal {
+			for setIndex, disks := range setMap {
+				for _, disk := range disks {
+					logger.Info("Healing disk '%s' on %s pool", disk, humanize.Ordinal(i+1))
 
-						err := z.serverPools[i].sets[setIndex].healErasureSet(ctx, buckets)
+					// So someone changed the drives underneath, healing tracker missing.
+					if !disk.Healing() {
+						logger.Info("Healing tracker missing on '%s', disk was swapped again on %s pool", disk, humanize.Ordinal(i+1))
+						diskID, err := disk.GetDiskID()
 						if err != nil {
 							logger.LogIf(ctx, err)
-							continue
+							// reading format.json failed or not found, proceed to look
+							// for new disks to be healed again, we cannot proceed further.
+							goto wait
 						}
 
+						if err := saveHealingTracker(disk, diskID); err != nil {
+							logger.LogIf(ctx, err)
+							// Unable to write healing tracker, permission denied or some
+							// other unexpected error occurred. Proceed to look for new
+							// disks to be healed again, we cannot proceed further.
+							goto wait
+						}
+					}
+
+					// Wait for the heal schedule, fresh drives are
+					// only held back if configured to.
+					waitForHealSchedule(ctx)
+
+					// Resume the progress saved before a restart, if any.
+					tracker, err := loadHealingTracker(ctx, disk)
+					if err != nil {
+						logger.LogIf(ctx, err)
+					}
+					progress := tracker.progress()
+					progress.ObjectsRemaining = 0
+					if total := z.serverPools[i].sets[setIndex].healObjectsEstimate(ctx); total > progress.ObjectsScanned {
+						progress.ObjectsRemaining = total - progress.ObjectsScanned
+					}
+					globalBackgroundHealState.startDiskHealProgress(disk.String(), progress)
+					stopSaving := saveHealProgress(ctx, disk, tracker)
+					ev := healEvent{
+						Type:      healEventDriveStarted,
+						PoolIndex: i,
+						SetIndex:  setIndex,
+						Drive:     disk.String(),
+						Started:   UTCNow(),
+					}
+					globalHealNotifier.send(ev)
+
+					err = z.serverPools[i].sets[setIndex].healErasureSet(ctx, buckets, disk.String())
+					stopSaving()
+					progress = globalBackgroundHealState.getDiskHealProgress()[disk.String()]
+					if err == nil {
 						logger.Info("Healing disk '%s' on %s pool complete", disk, humanize.Ordinal(i+1))
 
-						if err := disk.Delete(ctx, pathJoin(minioMetaBucket, bucketMetaPrefix),
-							healingTrackerFilename, false); err != nil && !errors.Is(err, errFileNotFound) {
-							logger.LogIf(ctx, err)
-							continue
+						err = disk.Delete(ctx, pathJoin(minioMetaBucket, bucketMetaPrefix),
+							healingTrackerFilename, false)
+						if errors.Is(err, errFileNotFound) {
+							err = nil
 						}
+					}
 
-						// Only upon success pop the healed disk.
-						globalBackgroundHealState.popHealLocalDisks(disk.Endpoint())
+					ev.Scanned, ev.Healed = progress.ObjectsScanned, progress.ObjectsHealed
+					if err != nil {
+						logger.LogIf(ctx, err)
+						// Keep the progress made for the next attempt.
+						tracker.update(progress)
+						logger.LogIf(ctx, tracker.save(ctx, disk))
+						ev.Type, ev.Reason = healEventDriveFailed, err.Error()
+						globalHealNotifier.send(ev)
+						continue
 					}
+					ev.Type = healEventDriveFinished
+					globalHealNotifier.send(ev)
+
+					// Only upon success pop the healed disk.
+					globalBackgroundHealState.popHealLocalDisks(disk.Endpoint())
 				}
 			}
 		}
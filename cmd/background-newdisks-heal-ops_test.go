@@ -0,0 +1,80 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// Tests that the heal progress saved in the healing tracker of a
+// disk is kept across loads.
+func TestHealingTrackerProgress(t *testing.T) {
+	disk, diskPath, err := newXLStorageTestSetup()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(diskPath)
+
+	ctx := context.Background()
+	if err = saveHealingTracker(disk, "disk-id"); err != nil {
+		t.Fatal(err)
+	}
+	if !disk.Healing() {
+		t.Fatal("expected disk to be healing")
+	}
+
+	h, err := loadHealingTracker(ctx, disk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.ID != "disk-id" || !h.Started.IsZero() {
+		t.Fatalf("unexpected healing tracker %#v", h)
+	}
+
+	progress := madmin.HealDriveProgress{
+		Started:          time.Now().UTC().Truncate(time.Second),
+		ObjectsScanned:   100,
+		ObjectsHealed:    40,
+		ObjectsRemaining: 900,
+		BytesHealed:      1 << 20,
+		BucketsHealed:    2,
+	}
+	h.update(progress)
+	if err = h.save(ctx, disk); err != nil {
+		t.Fatal(err)
+	}
+
+	if h, err = loadHealingTracker(ctx, disk); err != nil {
+		t.Fatal(err)
+	}
+	if h.ID != "disk-id" || h.LastUpdate.IsZero() {
+		t.Fatalf("unexpected healing tracker %#v", h)
+	}
+	got := h.progress()
+	if !got.Started.Equal(progress.Started) {
+		t.Fatalf("expected start %s, got %s", progress.Started, got.Started)
+	}
+	got.Started = progress.Started
+	if got != progress {
+		t.Fatalf("expected %#v, got %#v", progress, got)
+	}
+}
@@ -450,6 +450,12 @@ func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter,
 	if _, err := globalBucketMetadataSys.GetLifecycleConfig(bucket); err == nil {
 		hasLifecycleConfig = true
 	}
+	versioned := globalBucketVersioningSys.Enabled(bucket)
+	versionSuspended := globalBucketVersioningSys.Suspended(bucket)
+
+	// Tracks requested versions which are delete markers, such that
+	// their permanent removal is reported as a delete marker removal.
+	delMarkerVersions := make([]bool, len(deleteObjects.Objects))
 	dErrs := make([]DeleteError, len(deleteObjects.Objects))
 	for index, object := range deleteObjects.Objects {
 		if apiErrCode := checkRequestAuthType(ctx, r, policy.DeleteObjectAction, bucket, object.ObjectName); apiErrCode != ErrNone {
@@ -480,11 +486,16 @@ func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter,
 			}
 		}
 
-		if replicateDeletes || hasLockEnabled || hasLifecycleConfig {
+		goi, gerr = ObjectInfo{}, nil
+		if replicateDeletes || hasLockEnabled || hasLifecycleConfig ||
+			(object.VersionID != "" && (versioned || versionSuspended)) {
 			goi, gerr = getObjectInfoFn(ctx, bucket, object.ObjectName, ObjectOptions{
 				VersionID: object.VersionID,
 			})
 		}
+		if object.VersionID != "" && goi.DeleteMarker {
+			delMarkerVersions[index] = true
+		}
 		if hasLifecycleConfig && gerr == nil {
 			object.PurgeTransitioned = goi.TransitionStatus
 		}
@@ -545,8 +556,8 @@ func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter,
 
 	deleteList := toNames(objectsToDelete)
 	dObjects, errs := deleteObjectsFn(ctx, bucket, deleteList, ObjectOptions{
-		Versioned:        globalBucketVersioningSys.Enabled(bucket),
-		VersionSuspended: globalBucketVersioningSys.Suspended(bucket),
+		Versioned:        versioned,
+		VersionSuspended: versionSuspended,
 	})
 	deletedObjects := make([]DeletedObject, len(deleteObjects.Objects))
 	for i := range errs {
@@ -581,8 +592,22 @@ func (api objectAPIHandlers) DeleteMultipleObjectsHandler(w http.ResponseWriter,
 		}
 	}
 
+	// Per S3 spec, permanently removing a delete marker version
+	// reports both the VersionId and DeleteMarker, whereas creating
+	// a delete marker reports only the DeleteMarkerVersionId. Only
+	// the response is annotated, replication and events rely on
+	// deletedObjects as returned by the object layer.
+	respObjects := make([]DeletedObject, len(deletedObjects))
+	for i, dobj := range deletedObjects {
+		if dobj.ObjectName != "" && delMarkerVersions[i] && !dobj.DeleteMarker {
+			dobj.DeleteMarker = true
+			dobj.DeleteMarkerVersionID = dobj.VersionID
+		}
+		respObjects[i] = dobj
+	}
+
 	// Generate response
-	response := generateMultiDeleteResponse(deleteObjects.Quiet, deletedObjects, deleteErrors)
+	response := generateMultiDeleteResponse(deleteObjects.Quiet, respObjects, deleteErrors)
 	encodedSuccessResponse := encodeResponse(response)
 
 	// Write success response.
@@ -909,6 +934,11 @@ func (api objectAPIHandlers) PostPolicyBucketHandler(w http.ResponseWriter, r *h
 		}
 	}
 
+	if err = enforceBucketQuota(ctx, bucket, fileSize); err != nil {
+		writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL, guessIsBrowserReq(r))
+		return
+	}
+
 	// Extract metadata to be saved from received Form.
 	metadata := make(map[string]string)
 	err = extractMetadataFromMime(ctx, textproto.MIMEHeader(formValues), metadata)
@@ -0,0 +1,70 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// parseBucketHealConfig parses BucketHealConfig from json
+func parseBucketHealConfig(data []byte) (healCfg *madmin.BucketHealConfig, err error) {
+	healCfg = &madmin.BucketHealConfig{}
+	if err = json.Unmarshal(data, healCfg); err != nil {
+		return healCfg, err
+	}
+	return healCfg, nil
+}
+
+// bucketHealPriority returns the heal priority of the bucket.
+func bucketHealPriority(bucket string) int {
+	if globalBucketMetadataSys == nil || isMinioMetaBucketName(bucket) {
+		return 0
+	}
+	healCfg, err := globalBucketMetadataSys.GetHealConfig(bucket)
+	if err != nil {
+		return 0
+	}
+	return healCfg.Priority
+}
+
+// bucketHealOnRead returns whether degraded objects found during a
+// read of the bucket are queued for healing.
+func bucketHealOnRead(bucket string) bool {
+	if globalBucketMetadataSys == nil || isMinioMetaBucketName(bucket) {
+		return true
+	}
+	healCfg, err := globalBucketMetadataSys.GetHealConfig(bucket)
+	if err != nil {
+		return true
+	}
+	return healCfg.HealOnReadEnabled()
+}
+
+// sortBucketsByHealPriority orders the buckets by decreasing heal
+// priority, the order of buckets with the same priority is kept.
+func sortBucketsByHealPriority(buckets []BucketInfo, priority func(bucket string) int) {
+	priorities := make(map[string]int, len(buckets))
+	for _, bucket := range buckets {
+		priorities[bucket.Name] = priority(bucket.Name)
+	}
+	sort.SliceStable(buckets, func(i, j int) bool {
+		return priorities[buckets[i].Name] > priorities[buckets[j].Name]
+	})
+}
@@ -0,0 +1,72 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseBucketHealConfig(t *testing.T) {
+	healCfg, err := parseBucketHealConfig([]byte(`{"priority":10}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if healCfg.Priority != 10 {
+		t.Fatalf("expected priority 10, got %d", healCfg.Priority)
+	}
+	if _, err = parseBucketHealConfig([]byte(`{"priority":"high"}`)); err == nil {
+		t.Fatal("expected invalid priority to fail")
+	}
+	if !healCfg.HealOnReadEnabled() {
+		t.Fatal("expected heal on read to be enabled by default")
+	}
+	if healCfg, err = parseBucketHealConfig([]byte(`{"healOnRead":false}`)); err != nil {
+		t.Fatal(err)
+	}
+	if healCfg.HealOnReadEnabled() {
+		t.Fatal("expected heal on read to be disabled")
+	}
+}
+
+func TestSortBucketsByHealPriority(t *testing.T) {
+	priorities := map[string]int{
+		"critical": 10,
+		"archive":  -1,
+		"logs":     5,
+	}
+	buckets := []BucketInfo{
+		{Name: "archive"},
+		{Name: "newest"},
+		{Name: "logs"},
+		{Name: "oldest"},
+		{Name: "critical"},
+	}
+	sortBucketsByHealPriority(buckets, func(bucket string) int {
+		return priorities[bucket]
+	})
+
+	var got []string
+	for _, bucket := range buckets {
+		got = append(got, bucket.Name)
+	}
+	// Buckets with the same priority keep their order.
+	expected := []string{"critical", "logs", "newest", "oldest", "archive"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
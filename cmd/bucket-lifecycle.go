@@ -100,6 +100,7 @@ func initBackgroundExpiry(ctx context.Context, objectAPI ObjectLayer) {
 	globalExpiryState = newExpiryState()
 	go func() {
 		for t := range globalExpiryState.expiryCh {
+			globalMaintenance.waitIfPaused(ctx, madmin.MaintenanceLifecycle)
 			applyExpiryRule(ctx, objectAPI, t.objInfo, false, t.versionExpiry)
 		}
 	}()
@@ -150,6 +151,7 @@ func (t *transitionState) addWorker(ctx context.Context, objectAPI ObjectLayer)
 				if !ok {
 					return
 				}
+				globalMaintenance.waitIfPaused(ctx, madmin.MaintenanceLifecycle)
 				if err := transitionObject(ctx, objectAPI, oi); err != nil {
 					logger.LogIf(ctx, err)
 				}
@@ -189,6 +191,11 @@ func validateLifecycleTransition(ctx context.Context, bucket string, lfc *lifecy
 func validateTransitionDestination(ctx context.Context, bucket string, targetLabel string) (bool, string, error) {
 	tgt := globalBucketTargetSys.GetRemoteTargetWithLabel(ctx, bucket, targetLabel)
 	if tgt == nil {
+		if globalTierConfigMgr != nil {
+			if cfg, _, ok := globalTierConfigMgr.getTier(targetLabel); ok {
+				return false, cfg.Bucket, nil
+			}
+		}
 		return false, "", BucketRemoteTargetNotFound{Bucket: bucket}
 	}
 	arn, err := madmin.ParseARN(tgt.Arn)
@@ -307,11 +314,7 @@ func deleteTransitionedObject(ctx context.Context, objectAPI ObjectLayer, bucket
 	if err != nil {
 		return err
 	}
-	arn := getLifecycleTransitionTargetArn(ctx, lc, bucket, lcOpts)
-	if arn == nil {
-		return fmt.Errorf("remote target not configured")
-	}
-	tgt := globalBucketTargetSys.GetRemoteTargetClient(ctx, arn.String())
+	tgt := getLifecycleTransitionTarget(ctx, lc, bucket, lcOpts)
 	if tgt == nil {
 		return fmt.Errorf("remote target not configured")
 	}
@@ -330,7 +333,8 @@ func deleteTransitionedObject(ctx context.Context, objectAPI ObjectLayer, bucket
 
 	// When an object is past expiry, delete the data from transitioned tier and
 	// metadata from source
-	if err := tgt.RemoveObject(context.Background(), arn.Bucket, object, miniogo.RemoveObjectOptions{VersionID: lcOpts.VersionID}); err != nil {
+	remoteObject, remoteVersionID := tgt.remoteObject(bucket, object, lcOpts.VersionID)
+	if err := tgt.client.RemoveObject(context.Background(), tgt.bucket, remoteObject, miniogo.RemoveObjectOptions{VersionID: remoteVersionID}); err != nil {
 		logger.LogIf(ctx, err)
 	}
 
@@ -371,11 +375,7 @@ func transitionObject(ctx context.Context, objectAPI ObjectLayer, objInfo Object
 		Name:     objInfo.Name,
 		UserTags: objInfo.UserTags,
 	}
-	arn := getLifecycleTransitionTargetArn(ctx, lc, objInfo.Bucket, lcOpts)
-	if arn == nil {
-		return fmt.Errorf("remote target not configured")
-	}
-	tgt := globalBucketTargetSys.GetRemoteTargetClient(ctx, arn.String())
+	tgt := getLifecycleTransitionTarget(ctx, lc, objInfo.Bucket, lcOpts)
 	if tgt == nil {
 		return fmt.Errorf("remote target not configured")
 	}
@@ -399,7 +399,16 @@ func transitionObject(ctx context.Context, objectAPI ObjectLayer, objInfo Object
 		return err
 
 	}
-	if _, err = tgt.PutObject(ctx, arn.Bucket, oi.Name, gr, oi.Size, putOpts); err != nil {
+	if tgt.tier != "" {
+		// Tiers are plain remote buckets which neither preserve the
+		// source versions nor enforce the object lock of the source.
+		putOpts.Internal = miniogo.AdvancedPutOptions{}
+		putOpts.Mode = ""
+		putOpts.RetainUntilDate = time.Time{}
+		putOpts.LegalHold = ""
+	}
+	remoteObject, _ := tgt.remoteObject(oi.Bucket, oi.Name, oi.VersionID)
+	if _, err = tgt.client.PutObject(ctx, tgt.bucket, remoteObject, gr, oi.Size, putOpts); err != nil {
 		gr.Close()
 		return err
 	}
@@ -427,11 +436,55 @@ func transitionObject(ctx context.Context, objectAPI ObjectLayer, objInfo Object
 	return err
 }
 
-// getLifecycleTransitionTargetArn returns transition ARN for storage class specified in the config.
-func getLifecycleTransitionTargetArn(ctx context.Context, lc *lifecycle.Lifecycle, bucket string, obj lifecycle.ObjectOpts) *madmin.ARN {
+// transitionTarget is the remote bucket objects are transitioned to,
+// either a remote target of the bucket or a remote tier.
+type transitionTarget struct {
+	client *miniogo.Client
+	bucket string
+	// tier and prefix are only set for remote tiers.
+	tier   string
+	prefix string
+}
+
+// remoteObject returns the name and version of the object on the
+// transition target. Remote tiers are shared by all buckets and need
+// not be versioned, so every object version gets its own name there.
+func (t transitionTarget) remoteObject(bucket, object, versionID string) (string, string) {
+	if t.tier == "" {
+		return object, versionID
+	}
+	if versionID == "" {
+		versionID = nullVersionID
+	}
+	return pathJoin(t.prefix, bucket, versionID, object), ""
+}
+
+// getTransitionTarget returns the transition target with the storage
+// class label, looking up the remote targets of the bucket before the
+// remote tiers.
+func getTransitionTarget(ctx context.Context, bucket, storageClass string) *transitionTarget {
+	if arn := globalBucketTargetSys.GetRemoteArnWithLabel(ctx, bucket, storageClass); arn != nil {
+		tgt := globalBucketTargetSys.GetRemoteTargetClient(ctx, arn.String())
+		if tgt == nil {
+			return nil
+		}
+		return &transitionTarget{client: tgt.Client, bucket: arn.Bucket}
+	}
+	if globalTierConfigMgr == nil {
+		return nil
+	}
+	cfg, client, ok := globalTierConfigMgr.getTier(storageClass)
+	if !ok || client == nil {
+		return nil
+	}
+	return &transitionTarget{client: client, bucket: cfg.Bucket, tier: cfg.Name, prefix: cfg.Prefix}
+}
+
+// getLifecycleTransitionTarget returns the transition target for storage class specified in the config.
+func getLifecycleTransitionTarget(ctx context.Context, lc *lifecycle.Lifecycle, bucket string, obj lifecycle.ObjectOpts) *transitionTarget {
 	for _, rule := range lc.FilterActionableRules(obj) {
 		if rule.Transition.StorageClass != "" {
-			return globalBucketTargetSys.GetRemoteArnWithLabel(ctx, bucket, rule.Transition.StorageClass)
+			return getTransitionTarget(ctx, bucket, rule.Transition.StorageClass)
 		}
 	}
 	return nil
@@ -445,7 +498,7 @@ func getTransitionedObjectReader(ctx context.Context, bucket, object string, rs
 		return nil, err
 	}
 
-	arn := getLifecycleTransitionTargetArn(ctx, lc, bucket, lifecycle.ObjectOpts{
+	tgt := getLifecycleTransitionTarget(ctx, lc, bucket, lifecycle.ObjectOpts{
 		Name:         object,
 		UserTags:     oi.UserTags,
 		ModTime:      oi.ModTime,
@@ -453,10 +506,6 @@ func getTransitionedObjectReader(ctx context.Context, bucket, object string, rs
 		DeleteMarker: oi.DeleteMarker,
 		IsLatest:     oi.IsLatest,
 	})
-	if arn == nil {
-		return nil, fmt.Errorf("remote target not configured")
-	}
-	tgt := globalBucketTargetSys.GetRemoteTargetClient(ctx, arn.String())
 	if tgt == nil {
 		return nil, fmt.Errorf("remote target not configured")
 	}
@@ -464,7 +513,10 @@ func getTransitionedObjectReader(ctx context.Context, bucket, object string, rs
 	if err != nil {
 		return nil, ErrorRespToObjectError(err, bucket, object)
 	}
-	gopts := miniogo.GetObjectOptions{VersionID: opts.VersionID}
+	// The transitioned data is stored under the version resolved by
+	// the object layer, opts has no version ID when reading the latest.
+	remoteObject, remoteVersionID := tgt.remoteObject(bucket, object, oi.VersionID)
+	gopts := miniogo.GetObjectOptions{VersionID: remoteVersionID}
 
 	// get correct offsets for encrypted object
 	if off >= 0 && length >= 0 {
@@ -473,7 +525,7 @@ func getTransitionedObjectReader(ctx context.Context, bucket, object string, rs
 		}
 	}
 
-	reader, err := tgt.GetObject(ctx, arn.Bucket, object, gopts)
+	reader, err := tgt.client.GetObject(ctx, tgt.bucket, remoteObject, gopts)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,120 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+const (
+	// Staleness of the listing cache of a bucket if not configured.
+	listCacheDefaultStaleness = 15 * time.Minute
+
+	// Smallest staleness which can be configured, the cache is
+	// rebuilt once half of it has passed.
+	listCacheMinStaleness = time.Minute
+
+	// Interval at which the listing caches are checked for refresh.
+	listCacheRefreshInterval = 30 * time.Second
+)
+
+// parseBucketListCacheConfig parses BucketListCacheConfig from json
+func parseBucketListCacheConfig(data []byte) (listCacheCfg *madmin.BucketListCacheConfig, err error) {
+	listCacheCfg = &madmin.BucketListCacheConfig{}
+	if err = json.Unmarshal(data, listCacheCfg); err != nil {
+		return listCacheCfg, err
+	}
+	if listCacheCfg.MaxStaleness != 0 && listCacheCfg.MaxStaleness < listCacheMinStaleness {
+		return listCacheCfg, fmt.Errorf("list cache staleness must be at least %s", listCacheMinStaleness)
+	}
+	return listCacheCfg, nil
+}
+
+// bucketListCacheStaleness returns the maximum staleness of listings
+// served from the listing cache of the bucket, ok is false if the
+// bucket has no listing cache.
+func bucketListCacheStaleness(bucket string) (staleness time.Duration, ok bool) {
+	if globalBucketMetadataSys == nil || isMinioMetaBucketName(bucket) {
+		return 0, false
+	}
+	listCacheCfg, err := globalBucketMetadataSys.GetListCacheConfig(bucket)
+	if err != nil || !listCacheCfg.Enabled {
+		return 0, false
+	}
+	if listCacheCfg.MaxStaleness == 0 {
+		return listCacheDefaultStaleness, true
+	}
+	return listCacheCfg.MaxStaleness, true
+}
+
+// runListCacheRefresh keeps the listing caches of the buckets which
+// have them enabled within their staleness bound. It is run by the
+// scanner leader.
+func runListCacheRefresh(ctx context.Context, objAPI ObjectLayer) {
+	z, ok := objAPI.(*erasureServerPools)
+	if !ok {
+		return
+	}
+
+	ticker := time.NewTicker(listCacheRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		buckets, err := z.ListBuckets(ctx)
+		if err != nil {
+			logger.LogIf(ctx, err)
+			continue
+		}
+		for _, bucket := range buckets {
+			staleness, ok := bucketListCacheStaleness(bucket.Name)
+			if !ok {
+				continue
+			}
+			z.refreshListCache(ctx, bucket.Name, staleness)
+		}
+	}
+}
+
+// refreshListCache starts building a new listing cache of the whole
+// bucket unless one was started within half of the staleness bound.
+// The listing continues in the background after the first results.
+func (z *erasureServerPools) refreshListCache(ctx context.Context, bucket string, staleness time.Duration) {
+	_, err := z.listPath(ctx, listPathOptions{
+		Bucket:       bucket,
+		Recursive:    true,
+		Limit:        maxObjectList,
+		InclDeleted:  true,
+		AskDisks:     globalAPIConfig.getListQuorum(),
+		MaxStaleness: staleness / 2,
+	})
+	if err != nil && err != io.EOF {
+		logger.LogIf(ctx, fmt.Errorf("unable to refresh the listing cache of bucket %s: %w", bucket, err))
+	}
+}
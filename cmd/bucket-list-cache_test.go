@@ -0,0 +1,91 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseBucketListCacheConfig(t *testing.T) {
+	listCacheCfg, err := parseBucketListCacheConfig([]byte(`{"enabled":true}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !listCacheCfg.Enabled || listCacheCfg.MaxStaleness != 0 {
+		t.Fatalf("unexpected config %+v", listCacheCfg)
+	}
+	if listCacheCfg, err = parseBucketListCacheConfig([]byte(`{"enabled":true,"maxStaleness":300000000000}`)); err != nil {
+		t.Fatal(err)
+	}
+	if listCacheCfg.MaxStaleness != 5*time.Minute {
+		t.Fatalf("expected staleness 5m, got %s", listCacheCfg.MaxStaleness)
+	}
+	if _, err = parseBucketListCacheConfig([]byte(`{"enabled":true,"maxStaleness":1000000000}`)); err == nil {
+		t.Fatal("expected staleness below the minimum to fail")
+	}
+	if _, err = parseBucketListCacheConfig([]byte(`{"enabled":"yes"}`)); err == nil {
+		t.Fatal("expected invalid config to fail")
+	}
+}
+
+func TestMetacacheMatchesMaxStaleness(t *testing.T) {
+	now := time.Now()
+	cache := metacache{
+		id:           "bucket-cache",
+		bucket:       "bucket",
+		root:         "",
+		recursive:    true,
+		status:       scanStateSuccess,
+		started:      now.Add(-10 * time.Minute),
+		ended:        now.Add(-5 * time.Minute),
+		lastUpdate:   now.Add(-5 * time.Minute),
+		lastHandout:  now,
+		startedCycle: 10,
+		endedCycle:   10,
+		dataVersion:  metacacheStreamVersion,
+	}
+
+	// Bucket changed since the cache was built.
+	o := listPathOptions{
+		Bucket:      "bucket",
+		BaseDir:     "prefix/",
+		Prefix:      "prefix/",
+		Separator:   slashSeparator,
+		OldestCycle: 12,
+	}
+	if cache.matches(&o, 0) {
+		t.Fatal("expected cache with changes since it was started not to match")
+	}
+
+	o.MaxStaleness = 15 * time.Minute
+	if !cache.matches(&o, 0) {
+		t.Fatal("expected cache within max staleness to match")
+	}
+
+	o.MaxStaleness = 5 * time.Minute
+	if cache.matches(&o, 0) {
+		t.Fatal("expected cache older than max staleness not to match")
+	}
+
+	if cache.worthKeeping(12+dataUsageUpdateDirCycles, 0) {
+		t.Fatal("expected cache with an old cycle not to be worth keeping")
+	}
+	if !cache.worthKeeping(12+dataUsageUpdateDirCycles, 15*time.Minute) {
+		t.Fatal("expected cache within max staleness to be worth keeping")
+	}
+}
@@ -51,6 +51,7 @@ func (sys *BucketMetadataSys) Remove(bucket string) {
 	sys.Lock()
 	delete(sys.metadataMap, bucket)
 	globalBucketMonitor.DeleteBucket(bucket)
+	globalBucketHTTPStats.delete(bucket)
 	sys.Unlock()
 }
 
@@ -153,6 +154,12 @@ func (sys *BucketMetadataSys) Update(bucket string, configFile string, configDat
 		meta.TaggingConfigXML = configData
 	case bucketQuotaConfigFile:
 		meta.QuotaConfigJSON = configData
+	case bucketStorageClassConfigFile:
+		meta.StorageClassConfigJSON = configData
+	case bucketHealConfigFile:
+		meta.HealConfigJSON = configData
+	case bucketListCacheConfigFile:
+		meta.ListCacheConfigJSON = configData
 	case objectLockConfig:
 		if !globalIsErasure && !globalIsDistErasure {
 			return NotImplemented{}
@@ -344,6 +351,36 @@ func (sys *BucketMetadataSys) GetQuotaConfig(bucket string) (*madmin.BucketQuota
 	return meta.quotaConfig, nil
 }
 
+// GetStorageClassConfig returns configured bucket default storage class
+// The returned object may not be modified.
+func (sys *BucketMetadataSys) GetStorageClassConfig(bucket string) (*madmin.BucketStorageClass, error) {
+	meta, err := sys.GetConfig(bucket)
+	if err != nil {
+		return nil, err
+	}
+	return meta.storageClassConfig, nil
+}
+
+// GetHealConfig returns configured bucket heal settings
+// The returned object may not be modified.
+func (sys *BucketMetadataSys) GetHealConfig(bucket string) (*madmin.BucketHealConfig, error) {
+	meta, err := sys.GetConfig(bucket)
+	if err != nil {
+		return nil, err
+	}
+	return meta.healConfig, nil
+}
+
+// GetListCacheConfig returns configured bucket listing cache settings
+// The returned object may not be modified.
+func (sys *BucketMetadataSys) GetListCacheConfig(bucket string) (*madmin.BucketListCacheConfig, error) {
+	meta, err := sys.GetConfig(bucket)
+	if err != nil {
+		return nil, err
+	}
+	return meta.listCacheConfig, nil
+}
+
 // GetReplicationConfig returns configured bucket replication config
 // The returned object may not be modified.
 func (sys *BucketMetadataSys) GetReplicationConfig(ctx context.Context, bucket string) (*replication.Config, error) {
@@ -78,6 +78,9 @@ type BucketMetadata struct {
 	ReplicationConfigXML        []byte
 	BucketTargetsConfigJSON     []byte
 	BucketTargetsConfigMetaJSON []byte
+	StorageClassConfigJSON      []byte
+	HealConfigJSON              []byte
+	ListCacheConfigJSON         []byte
 
 	// Unexported fields. Must be updated atomically.
 	policyConfig           *policy.Policy
@@ -91,6 +94,9 @@ type BucketMetadata struct {
 	replicationConfig      *replication.Config
 	bucketTargetConfig     *madmin.BucketTargets
 	bucketTargetConfigMeta map[string]string
+	storageClassConfig     *madmin.BucketStorageClass
+	healConfig             *madmin.BucketHealConfig
+	listCacheConfig        *madmin.BucketListCacheConfig
 }
 
 // newBucketMetadata creates BucketMetadata with the supplied name and Created to Now.
@@ -107,6 +113,9 @@ func newBucketMetadata(name string) BucketMetadata {
 		},
 		bucketTargetConfig:     &madmin.BucketTargets{},
 		bucketTargetConfigMeta: make(map[string]string),
+		storageClassConfig:     &madmin.BucketStorageClass{},
+		healConfig:             &madmin.BucketHealConfig{},
+		listCacheConfig:        &madmin.BucketListCacheConfig{},
 	}
 }
 
@@ -247,6 +256,33 @@ func (b *BucketMetadata) parseAllConfigs(ctx context.Context, objectAPI ObjectLa
 	} else {
 		b.bucketTargetConfig = &madmin.BucketTargets{}
 	}
+
+	if len(b.StorageClassConfigJSON) != 0 {
+		b.storageClassConfig, err = parseBucketStorageClass(b.Name, b.StorageClassConfigJSON)
+		if err != nil {
+			return err
+		}
+	} else {
+		b.storageClassConfig = &madmin.BucketStorageClass{}
+	}
+
+	if len(b.HealConfigJSON) != 0 {
+		b.healConfig, err = parseBucketHealConfig(b.HealConfigJSON)
+		if err != nil {
+			return err
+		}
+	} else {
+		b.healConfig = &madmin.BucketHealConfig{}
+	}
+
+	if len(b.ListCacheConfigJSON) != 0 {
+		b.listCacheConfig, err = parseBucketListCacheConfig(b.ListCacheConfigJSON)
+		if err != nil {
+			return err
+		}
+	} else {
+		b.listCacheConfig = &madmin.BucketListCacheConfig{}
+	}
 	return nil
 }
 
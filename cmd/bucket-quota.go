@@ -82,7 +82,7 @@ func (sys *BucketQuotaSys) check(ctx context.Context, bucket string, size int64)
 		return err
 	}
 
-	if q != nil && q.Type == madmin.HardQuota && q.Quota > 0 {
+	if hardQuota(q) {
 		v, err := sys.bucketStorageCache.Get()
 		if err != nil {
 			return err
@@ -97,7 +97,7 @@ func (sys *BucketQuotaSys) check(ctx context.Context, bucket string, size int64)
 			return nil
 		}
 
-		if (bui.Size + uint64(size)) >= q.Quota {
+		if (bui.Size + uint64(size)) > q.Quota {
 			return BucketQuotaExceeded{Bucket: bucket}
 		}
 	}
@@ -105,6 +105,19 @@ func (sys *BucketQuotaSys) check(ctx context.Context, bucket string, size int64)
 	return nil
 }
 
+// hardQuota returns true if the quota config limits the total size
+// of the bucket.
+func hardQuota(q *madmin.BucketQuota) bool {
+	return q != nil && q.Type == madmin.HardQuota && q.Quota > 0
+}
+
+// hasHardQuota returns true if the total size of the bucket is limited
+// by a hard quota.
+func (sys *BucketQuotaSys) hasHardQuota(bucket string) bool {
+	q, err := sys.Get(bucket)
+	return err == nil && hardQuota(q)
+}
+
 func enforceBucketQuota(ctx context.Context, bucket string, size int64) error {
 	if size < 0 {
 		return nil
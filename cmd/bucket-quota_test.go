@@ -0,0 +1,41 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestHardQuota(t *testing.T) {
+	testCases := []struct {
+		quota    *madmin.BucketQuota
+		expected bool
+	}{
+		{nil, false},
+		{&madmin.BucketQuota{}, false},
+		{&madmin.BucketQuota{Type: madmin.HardQuota}, false},
+		{&madmin.BucketQuota{Type: madmin.FIFOQuota, Quota: 1 << 30}, false},
+		{&madmin.BucketQuota{Type: madmin.HardQuota, Quota: 1 << 30}, true},
+	}
+	for i, testCase := range testCases {
+		if got := hardQuota(testCase.quota); got != testCase.expected {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.expected, got)
+		}
+	}
+}
@@ -0,0 +1,292 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+const (
+	replicationResyncMetaName = "replication-resync.json"
+
+	// Maximum number of failed object versions kept in the resync
+	// progress.
+	replicationResyncFailuresMax = 1000
+)
+
+var (
+	errReplicationResyncStarted    = persistedJobStartedError("ReplicationResync", "replication resync of the bucket")
+	errReplicationResyncNotStarted = persistedJobNotStartedError("ReplicationResync", "replication resync of the bucket")
+)
+
+// replicationResyncMeta is the progress of the replication resync of
+// a bucket, saved to the backend so that it can be read from any
+// server.
+type replicationResyncMeta struct {
+	persistedJobMeta
+	Bucket        string                            `json:"bucket"`
+	Status        string                            `json:"status"`
+	Scanned       uint64                            `json:"scanned"`
+	Queued        uint64                            `json:"queued"`
+	Replicated    uint64                            `json:"replicated"`
+	Failed        uint64                            `json:"failed"`
+	FailedObjects []madmin.ReplicationResyncFailure `json:"failedObjects,omitempty"`
+	Error         string                            `json:"error,omitempty"`
+}
+
+// running returns true if the resync is still in progress.
+func (m replicationResyncMeta) running(now time.Time) bool {
+	return m.Status == madmin.ReplicationResyncStarted && !m.stale(now)
+}
+
+// addResult records the replication outcome of an object version.
+func (m *replicationResyncMeta) addResult(oi ObjectInfo, err error) {
+	if err == nil {
+		m.Replicated++
+		return
+	}
+	m.Failed++
+	if len(m.FailedObjects) < replicationResyncFailuresMax {
+		m.FailedObjects = append(m.FailedObjects, madmin.ReplicationResyncFailure{
+			Object:    oi.Name,
+			VersionID: oi.VersionID,
+			Error:     err.Error(),
+		})
+	}
+}
+
+func replicationResyncMetaPath(bucket string) string {
+	return pathJoin(bucketConfigPrefix, bucket, replicationResyncMetaName)
+}
+
+func loadReplicationResyncMeta(ctx context.Context, objAPI ObjectLayer, bucket string) (replicationResyncMeta, error) {
+	var meta replicationResyncMeta
+	err := loadPersistedJobMeta(ctx, objAPI, replicationResyncMetaPath(bucket), &meta)
+	return meta, err
+}
+
+func saveReplicationResyncMeta(ctx context.Context, objAPI ObjectLayer, meta replicationResyncMeta) error {
+	return savePersistedJobMeta(ctx, objAPI, replicationResyncMetaPath(meta.Bucket), &meta)
+}
+
+// replicationResyncState holds the replication resyncs running on
+// this server by bucket.
+type replicationResyncState struct {
+	mu      sync.Mutex
+	resyncs map[string]*replicationResyncMeta
+}
+
+var globalReplicationResync = &replicationResyncState{
+	resyncs: make(map[string]*replicationResyncMeta),
+}
+
+// status returns a copy of the resync of the bucket running on this
+// server, false if there is none.
+func (s *replicationResyncState) status(bucket string) (replicationResyncMeta, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	meta, ok := s.resyncs[bucket]
+	if !ok {
+		return replicationResyncMeta{}, false
+	}
+	m := *meta
+	m.FailedObjects = append([]madmin.ReplicationResyncFailure(nil), meta.FailedObjects...)
+	return m, true
+}
+
+func (s *replicationResyncState) update(bucket string, update func(*replicationResyncMeta)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if meta, ok := s.resyncs[bucket]; ok {
+		update(meta)
+	}
+}
+
+// job returns the persisted job of the resync of the bucket running
+// on this server.
+func (s *replicationResyncState) job(objAPI ObjectLayer, bucket string) persistedJob {
+	return persistedJob{
+		objAPI:     objAPI,
+		configFile: replicationResyncMetaPath(bucket),
+		status: func() (persistedJobState, bool) {
+			meta, ok := s.status(bucket)
+			return &meta, ok
+		},
+	}
+}
+
+// start starts queueing all object versions of the bucket for
+// replication to its replication target, it returns the resync id.
+func (s *replicationResyncState) start(ctx context.Context, objAPI ObjectLayer, bucket string) (string, error) {
+	if _, err := getReplicationConfig(ctx, bucket); err != nil {
+		return "", err
+	}
+
+	if _, ok := s.status(bucket); ok {
+		return "", errReplicationResyncStarted
+	}
+
+	// The backend is not accessed under mu, which would hold off the
+	// resyncs of all buckets. The saved resync is running once saved,
+	// which fails a concurrent start of the same resync.
+	var meta replicationResyncMeta
+	err := startPersistedJob(ctx, objAPI, replicationResyncMetaPath(bucket), func() error {
+		var err error
+		meta, err = loadReplicationResyncMeta(ctx, objAPI, bucket)
+		if err != nil && !errors.Is(err, errConfigNotFound) {
+			return err
+		}
+		if err == nil && meta.running(UTCNow()) {
+			return errReplicationResyncStarted
+		}
+
+		meta = replicationResyncMeta{
+			persistedJobMeta: newPersistedJobMeta(),
+			Bucket:           bucket,
+			Status:           madmin.ReplicationResyncStarted,
+		}
+		return saveReplicationResyncMeta(ctx, objAPI, meta)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resyncs[bucket] = &meta
+	s.run(GlobalContext, objAPI, bucket)
+	return meta.ID, nil
+}
+
+// run resyncs the bucket in the background, the caller must hold mu.
+func (s *replicationResyncState) run(ctx context.Context, objAPI ObjectLayer, bucket string) {
+	s.job(objAPI, bucket).run(ctx, func(ctx context.Context) error {
+		return s.resync(ctx, objAPI, bucket)
+	}, func(err error) {
+		s.update(bucket, func(m *replicationResyncMeta) {
+			m.StoppedAt = UTCNow()
+			switch {
+			case err == nil:
+				m.Status = madmin.ReplicationResyncCompleted
+			case errors.Is(err, context.Canceled):
+				m.Status = madmin.ReplicationResyncStopped
+			default:
+				m.Status = madmin.ReplicationResyncFailed
+				m.Error = err.Error()
+			}
+		})
+	}, func() {
+		s.mu.Lock()
+		delete(s.resyncs, bucket)
+		s.mu.Unlock()
+	})
+}
+
+// resync lists all object versions of the bucket and queues the ones
+// which have to be replicated to the replication workers of the resync.
+func (s *replicationResyncState) resync(ctx context.Context, objAPI ObjectLayer, bucket string) error {
+	resyncCh := make(chan ObjectInfo)
+	var wg sync.WaitGroup
+	for i := 0; i < globalAPIConfig.getReplicationWorkers(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for oi := range resyncCh {
+				globalMaintenance.waitIfPaused(ctx, madmin.MaintenanceReplication)
+				err := replicateObject(ctx, oi, objAPI)
+				s.update(bucket, func(m *replicationResyncMeta) {
+					m.addResult(oi, err)
+				})
+			}
+		}()
+	}
+	defer func() {
+		close(resyncCh)
+		wg.Wait()
+	}()
+
+	var marker, versionIDMarker string
+	for {
+		res, err := objAPI.ListObjectVersions(ctx, bucket, "", marker, versionIDMarker, "", maxObjectList)
+		if err != nil {
+			return err
+		}
+		for _, oi := range res.Objects {
+			s.update(bucket, func(m *replicationResyncMeta) {
+				m.Scanned++
+			})
+			if oi.DeleteMarker {
+				continue
+			}
+			if replicate, _ := mustReplicater(ctx, bucket, oi.Name, oi.UserDefined, oi.ReplicationStatus.String()); !replicate {
+				continue
+			}
+			s.update(bucket, func(m *replicationResyncMeta) {
+				m.Queued++
+			})
+			select {
+			case resyncCh <- oi:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if !res.IsTruncated {
+			return nil
+		}
+		marker, versionIDMarker = res.NextMarker, res.NextVersionIDMarker
+	}
+}
+
+// getReplicationResyncStatus returns the progress of the current or
+// last replication resync of the bucket.
+func getReplicationResyncStatus(ctx context.Context, objAPI ObjectLayer, bucket string) (madmin.ReplicationResyncStatus, error) {
+	meta, ok := globalReplicationResync.status(bucket)
+	if !ok {
+		var err error
+		if meta, err = loadReplicationResyncMeta(ctx, objAPI, bucket); err != nil {
+			if errors.Is(err, errConfigNotFound) {
+				return madmin.ReplicationResyncStatus{}, errReplicationResyncNotStarted
+			}
+			return madmin.ReplicationResyncStatus{}, err
+		}
+	}
+
+	status := madmin.ReplicationResyncStatus{
+		ID:            meta.ID,
+		Bucket:        meta.Bucket,
+		Status:        meta.Status,
+		StartedAt:     meta.StartedAt,
+		StoppedAt:     meta.StoppedAt,
+		LastUpdate:    meta.LastUpdate,
+		Scanned:       meta.Scanned,
+		Queued:        meta.Queued,
+		Replicated:    meta.Replicated,
+		Failed:        meta.Failed,
+		FailedObjects: meta.FailedObjects,
+		Error:         meta.Error,
+	}
+	// A resync whose server went away is reported as stopped.
+	if !ok && meta.Status == madmin.ReplicationResyncStarted && !meta.running(UTCNow()) {
+		status.Status = madmin.ReplicationResyncStopped
+	}
+	return status, nil
+}
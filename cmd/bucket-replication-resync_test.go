@@ -0,0 +1,103 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestReplicationResyncMetaAddResult(t *testing.T) {
+	now := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	meta := replicationResyncMeta{persistedJobMeta: persistedJobMeta{LastUpdate: now}, Status: madmin.ReplicationResyncStarted}
+	if !meta.running(now) {
+		t.Errorf("expected resync to be running")
+	}
+	if meta.running(now.Add(2 * persistedJobStaleAfter)) {
+		t.Errorf("expected a stale resync not to be running")
+	}
+
+	meta.addResult(ObjectInfo{Name: "object"}, nil)
+	for i := 0; i < replicationResyncFailuresMax+1; i++ {
+		meta.addResult(ObjectInfo{Name: "object-" + strconv.Itoa(i), VersionID: "v1"}, errors.New("failed"))
+	}
+	if meta.Replicated != 1 || meta.Failed != replicationResyncFailuresMax+1 {
+		t.Fatalf("unexpected resync progress %d replicated, %d failed", meta.Replicated, meta.Failed)
+	}
+	if len(meta.FailedObjects) != replicationResyncFailuresMax {
+		t.Fatalf("expected %d failed objects, got %d", replicationResyncFailuresMax, len(meta.FailedObjects))
+	}
+	if f := meta.FailedObjects[0]; f.Object != "object-0" || f.VersionID != "v1" || f.Error != "failed" {
+		t.Fatalf("unexpected failed object %#v", f)
+	}
+}
+
+func TestReplicationResync(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	// Listing object versions requires the object layer to be set.
+	defer setObjectLayer(newObjectLayerFn())
+	setObjectLayer(obj)
+
+	bucket := "bucket"
+	if err = obj.MakeBucketWithLocation(ctx, bucket, BucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	data := []byte("data")
+	for i := 0; i < 3; i++ {
+		_, err = obj.PutObject(ctx, bucket, "object-"+strconv.Itoa(i), mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := &replicationResyncState{resyncs: make(map[string]*replicationResyncMeta)}
+	if _, err = s.start(ctx, obj, bucket); !errors.As(err, &BucketReplicationConfigNotFound{}) {
+		t.Fatalf("expected a missing replication configuration, got %v", err)
+	}
+	if _, err = getReplicationResyncStatus(ctx, obj, bucket); !errors.Is(err, errReplicationResyncNotStarted) {
+		t.Fatalf("expected %v, got %v", errReplicationResyncNotStarted, err)
+	}
+
+	// Versions are only queued if the replication configuration of
+	// the bucket matches them.
+	s.resyncs[bucket] = &replicationResyncMeta{Bucket: bucket, Status: madmin.ReplicationResyncStarted}
+	if err = s.resync(ctx, obj, bucket); err != nil {
+		t.Fatal(err)
+	}
+	meta, ok := s.status(bucket)
+	if !ok {
+		t.Fatal("expected the resync to be running")
+	}
+	if meta.Scanned != 3 || meta.Queued != 0 {
+		t.Fatalf("unexpected resync progress %#v", meta)
+	}
+}
@@ -559,10 +559,11 @@ func getReplicationAction(oi1 ObjectInfo, oi2 minio.ObjectInfo) replicationActio
 
 // replicateObject replicates the specified version of the object to destination bucket
 // The source object is then updated to reflect the replication status.
-func replicateObject(ctx context.Context, objInfo ObjectInfo, objectAPI ObjectLayer) {
+// An error is returned if the object version was not replicated.
+func replicateObject(ctx context.Context, objInfo ObjectInfo, objectAPI ObjectLayer) error {
 	z, ok := objectAPI.(*erasureServerPools)
 	if !ok {
-		return
+		return NotImplemented{}
 	}
 
 	bucket := objInfo.Bucket
@@ -577,18 +578,19 @@ func replicateObject(ctx context.Context, objInfo ObjectInfo, objectAPI ObjectLa
 			Object:     objInfo,
 			Host:       "Internal: [Replication]",
 		})
-		return
+		return err
 	}
 	tgt := globalBucketTargetSys.GetRemoteTargetClient(ctx, cfg.RoleArn)
 	if tgt == nil {
-		logger.LogIf(ctx, fmt.Errorf("failed to get target for bucket:%s arn:%s", bucket, cfg.RoleArn))
+		err = fmt.Errorf("failed to get target for bucket:%s arn:%s", bucket, cfg.RoleArn)
+		logger.LogIf(ctx, err)
 		sendEvent(eventArgs{
 			EventName:  event.ObjectReplicationNotTracked,
 			BucketName: bucket,
 			Object:     objInfo,
 			Host:       "Internal: [Replication]",
 		})
-		return
+		return err
 	}
 	gr, err := objectAPI.GetObjectNInfo(ctx, bucket, object, nil, http.Header{}, readLock, ObjectOptions{
 		VersionID: objInfo.VersionID,
@@ -601,7 +603,7 @@ func replicateObject(ctx context.Context, objInfo ObjectInfo, objectAPI ObjectLa
 			Host:       "Internal: [Replication]",
 		})
 		logger.LogIf(ctx, err)
-		return
+		return err
 	}
 	defer gr.Close() // hold read lock for entire transaction
 
@@ -615,19 +617,20 @@ func replicateObject(ctx context.Context, objInfo ObjectInfo, objectAPI ObjectLa
 			Object:     objInfo,
 			Host:       "Internal: [Replication]",
 		})
-		return
+		return err
 	}
 
 	dest := cfg.GetDestination()
 	if dest.Bucket == "" {
-		logger.LogIf(ctx, fmt.Errorf("Unable to replicate object %s(%s), bucket is empty", objInfo.Name, objInfo.VersionID))
+		err = fmt.Errorf("Unable to replicate object %s(%s), bucket is empty", objInfo.Name, objInfo.VersionID)
+		logger.LogIf(ctx, err)
 		sendEvent(eventArgs{
 			EventName:  event.ObjectReplicationNotTracked,
 			BucketName: bucket,
 			Object:     objInfo,
 			Host:       "Internal: [Replication]",
 		})
-		return
+		return err
 	}
 
 	rtype := replicateAll
@@ -641,10 +644,11 @@ func replicateObject(ctx context.Context, objInfo ObjectInfo, objectAPI ObjectLa
 		if rtype == replicateNone {
 			// object with same VersionID already exists, replication kicked off by
 			// PutObject might have completed.
-			return
+			return nil
 		}
 	}
 	replicationStatus := replication.Completed
+	var replErr error
 	// use core client to avoid doing multipart on PUT
 	c := &miniogo.Core{Client: tgt.Client}
 	if rtype != replicateAll {
@@ -656,6 +660,7 @@ func replicateObject(ctx context.Context, objInfo ObjectInfo, objectAPI ObjectLa
 		dstOpts := miniogo.PutObjectOptions{Internal: miniogo.AdvancedPutOptions{SourceVersionID: objInfo.VersionID}}
 		if _, err = c.CopyObject(ctx, dest.Bucket, object, dest.Bucket, object, getCopyObjMetadata(objInfo, dest), srcOpts, dstOpts); err != nil {
 			replicationStatus = replication.Failed
+			replErr = err
 			logger.LogIf(ctx, fmt.Errorf("Unable to replicate metadata for object %s/%s(%s): %s", bucket, objInfo.Name, objInfo.VersionID, err))
 		}
 	} else {
@@ -668,7 +673,7 @@ func replicateObject(ctx context.Context, objInfo ObjectInfo, objectAPI ObjectLa
 				Object:     objInfo,
 				Host:       "Internal: [Replication]",
 			})
-			return
+			return err
 		}
 
 		putOpts, err := putReplicationOpts(ctx, dest, objInfo)
@@ -680,16 +685,20 @@ func replicateObject(ctx context.Context, objInfo ObjectInfo, objectAPI ObjectLa
 				Object:     objInfo,
 				Host:       "Internal: [Replication]",
 			})
-			return
+			return err
 		}
 
-		// Setup bandwidth throttling
+		// Setup bandwidth throttling, every node replicates with
+		// its share of the bandwidth limit of the target.
 		peers, _ := globalEndpoints.peers()
 		totalNodesCount := len(peers)
 		if totalNodesCount == 0 {
 			totalNodesCount = 1 // For standalone erasure coding
 		}
 		b := target.BandwidthLimit / int64(totalNodesCount)
+		if b == 0 && target.BandwidthLimit > 0 {
+			b = 1
+		}
 		var headerSize int
 		for k, v := range putOpts.Header() {
 			headerSize += len(k) + len(v)
@@ -699,6 +708,7 @@ func replicateObject(ctx context.Context, objInfo ObjectInfo, objectAPI ObjectLa
 		r := bandwidth.NewMonitoredReader(ctx, globalBucketMonitor, objInfo.Bucket, objInfo.Name, gr, headerSize, b, target.BandwidthLimit)
 		if _, err = c.PutObject(ctx, dest.Bucket, object, r, size, "", "", putOpts); err != nil {
 			replicationStatus = replication.Failed
+			replErr = err
 			logger.LogIf(ctx, fmt.Errorf("Unable to replicate for object %s/%s(%s): %w", bucket, objInfo.Name, objInfo.VersionID, err))
 		}
 		defer r.Close()
@@ -734,6 +744,7 @@ func replicateObject(ctx context.Context, objInfo ObjectInfo, objectAPI ObjectLa
 		Object:     objInfo,
 		Host:       "Internal: [Replication]",
 	})
+	return replErr
 }
 
 // filterReplicationStatusMetadata filters replication status metadata for COPY
@@ -819,11 +830,13 @@ func (r *replicationState) addWorker(ctx context.Context, objectAPI ObjectLayer)
 				if !ok {
 					return
 				}
+				globalMaintenance.waitIfPaused(ctx, madmin.MaintenanceReplication)
 				replicateObject(ctx, oi, objectAPI)
 			case doi, ok := <-r.replicaDeleteCh:
 				if !ok {
 					return
 				}
+				globalMaintenance.waitIfPaused(ctx, madmin.MaintenanceReplication)
 				replicateDelete(ctx, doi, objectAPI)
 			}
 		}
@@ -0,0 +1,58 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/minio/minio/cmd/config/storageclass"
+	xhttp "github.com/minio/minio/cmd/http"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// parseBucketStorageClass parses BucketStorageClass from json
+func parseBucketStorageClass(bucket string, data []byte) (scCfg *madmin.BucketStorageClass, err error) {
+	scCfg = &madmin.BucketStorageClass{}
+	if err = json.Unmarshal(data, scCfg); err != nil {
+		return scCfg, err
+	}
+	// Empty storage class removes the bucket default.
+	if scCfg.StorageClass != "" && !storageclass.IsValid(scCfg.StorageClass) {
+		return scCfg, fmt.Errorf("Invalid storage class %s for bucket %s", scCfg.StorageClass, bucket)
+	}
+	return scCfg, nil
+}
+
+// applyBucketStorageClass sets the bucket default storage class on
+// the object metadata if the client did not specify one.
+func applyBucketStorageClass(bucket string, opts *ObjectOptions) {
+	if isMinioMetaBucketName(bucket) || globalBucketMetadataSys == nil {
+		return
+	}
+	if opts.UserDefined[xhttp.AmzStorageClass] != "" {
+		return
+	}
+	scCfg, err := globalBucketMetadataSys.GetStorageClassConfig(bucket)
+	if err != nil || scCfg.StorageClass == "" {
+		return
+	}
+	if opts.UserDefined == nil {
+		opts.UserDefined = make(map[string]string)
+	}
+	opts.UserDefined[xhttp.AmzStorageClass] = scCfg.StorageClass
+}
@@ -0,0 +1,64 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"testing"
+)
+
+func TestParseBucketStorageClass(t *testing.T) {
+	testCases := []struct {
+		data      string
+		expected  string
+		shouldErr bool
+	}{
+		{`{"storageClass":"STANDARD"}`, "STANDARD", false},
+		{`{"storageClass":"REDUCED_REDUNDANCY"}`, "REDUCED_REDUNDANCY", false},
+		{`{"storageClass":""}`, "", false},
+		{`{}`, "", false},
+		{`{"storageClass":"GLACIER"}`, "", true},
+		{`{"storageClass":`, "", true},
+	}
+	for i, testCase := range testCases {
+		scCfg, err := parseBucketStorageClass("bucket", []byte(testCase.data))
+		if testCase.shouldErr {
+			if err == nil {
+				t.Errorf("Test %d: expected error, got nil", i+1)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Test %d: unexpected error %v", i+1, err)
+			continue
+		}
+		if scCfg.StorageClass != testCase.expected {
+			t.Errorf("Test %d: expected %s, got %s", i+1, testCase.expected, scCfg.StorageClass)
+		}
+	}
+}
+
+func TestStorageClassCount(t *testing.T) {
+	var sc storageClassCount
+	sc.add("")
+	sc.add("STANDARD")
+	sc.add("REDUCED_REDUNDANCY")
+	sc.add("REDUCED_REDUNDANCY")
+	m := sc.toMap()
+	if m["STANDARD"] != 2 || m["REDUCED_REDUNDANCY"] != 2 {
+		t.Fatalf("unexpected storage class distribution %v", m)
+	}
+}
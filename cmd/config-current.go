@@ -623,6 +623,7 @@ func applyDynamicConfig(ctx context.Context, objAPI ObjectLayer, s config.Config
 	globalHealConfigMu.Lock()
 	globalHealConfig = healCfg
 	globalHealConfigMu.Unlock()
+	globalHealNotifier.update(healCfg)
 
 	logger.LogIf(ctx, scannerSleeper.Update(scannerCfg.Delay, scannerCfg.MaxWait))
 
@@ -37,6 +37,7 @@ const (
 	apiListQuorum                 = "list_quorum"
 	apiExtendListCacheLife        = "extend_list_cache_life"
 	apiReplicationWorkers         = "replication_workers"
+	apiBucketMetrics              = "bucket_metrics"
 	EnvAPIRequestsMax             = "MINIO_API_REQUESTS_MAX"
 	EnvAPIRequestsDeadline        = "MINIO_API_REQUESTS_DEADLINE"
 	EnvAPIClusterDeadline         = "MINIO_API_CLUSTER_DEADLINE"
@@ -46,6 +47,7 @@ const (
 	EnvAPIExtendListCacheLife     = "MINIO_API_EXTEND_LIST_CACHE_LIFE"
 	EnvAPISecureCiphers           = "MINIO_API_SECURE_CIPHERS"
 	EnvAPIReplicationWorkers      = "MINIO_API_REPLICATION_WORKERS"
+	EnvAPIBucketMetrics           = "MINIO_API_BUCKET_METRICS"
 )
 
 // Deprecated key and ENVs
@@ -89,6 +91,10 @@ var (
 			Key:   apiReplicationWorkers,
 			Value: "100",
 		},
+		config.KV{
+			Key:   apiBucketMetrics,
+			Value: config.EnableOn,
+		},
 	}
 )
 
@@ -102,6 +108,7 @@ type Config struct {
 	ListQuorum              string        `json:"list_strict_quorum"`
 	ExtendListLife          time.Duration `json:"extend_list_cache_life"`
 	ReplicationWorkers      int           `json:"replication_workers"`
+	BucketMetrics           bool          `json:"bucket_metrics"`
 }
 
 // UnmarshalJSON - Validate SS and RRS parity when unmarshalling JSON.
@@ -188,6 +195,11 @@ func LookupConfig(kvs config.KVS) (cfg Config, err error) {
 		return cfg, config.ErrInvalidReplicationWorkersValue(nil).Msg("Minimum number of replication workers should be 1")
 	}
 
+	bucketMetrics, err := config.ParseBool(env.Get(EnvAPIBucketMetrics, kvs.Get(apiBucketMetrics)))
+	if err != nil {
+		return cfg, err
+	}
+
 	return Config{
 		RequestsMax:             requestsMax,
 		RequestsDeadline:        requestsDeadline,
@@ -197,5 +209,6 @@ func LookupConfig(kvs config.KVS) (cfg Config, err error) {
 		ListQuorum:              listQuorum,
 		ExtendListLife:          listLife,
 		ReplicationWorkers:      replicationWorkers,
+		BucketMetrics:           bucketMetrics,
 	}, nil
 }
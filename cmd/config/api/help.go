@@ -51,5 +51,11 @@ var (
 			Optional:    true,
 			Type:        "number",
 		},
+		config.HelpKV{
+			Key:         apiBucketMetrics,
+			Description: `set to "off" to disable the per bucket metrics of buckets with high cardinality, defaults to "on"`,
+			Optional:    true,
+			Type:        "on|off",
+		},
 	}
 )
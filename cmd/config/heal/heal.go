@@ -18,22 +18,107 @@ package heal
 
 import (
 	"fmt"
+	"runtime"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/minio/minio/cmd/config"
 	"github.com/minio/minio/pkg/env"
+	xnet "github.com/minio/minio/pkg/net"
 )
 
 // Compression environment variables
 const (
-	Bitrot  = "bitrotscan"
-	Sleep   = "max_sleep"
-	IOCount = "max_io"
+	Bitrot   = "bitrotscan"
+	Sleep    = "max_sleep"
+	IOCount  = "max_io"
+	Workers  = "workers"
+	Interval = "interval"
+	MaxIOPS  = "max_iops"
+	Priority = "priority"
+	Schedule = "schedule"
 
-	EnvBitrot  = "MINIO_HEAL_BITROTSCAN"
-	EnvSleep   = "MINIO_HEAL_MAX_SLEEP"
-	EnvIOCount = "MINIO_HEAL_MAX_IO"
+	BucketWorkers = "bucket_workers"
+
+	ScheduleDrives = "schedule_drives"
+
+	Adaptive   = "adaptive"
+	MaxLatency = "max_latency"
+
+	DrainTimeout = "drain_timeout"
+	SampleRate   = "sample_rate"
+	Audit        = "audit"
+	DryRun       = "dry_run"
+
+	ScrubInterval = "scrub_interval"
+	ExpiryHorizon = "expiry_horizon"
+
+	RetryAttempts = "retry_attempts"
+	RetryDelay    = "retry_delay"
+
+	IncludePrefixes = "include_prefixes"
+	ExcludePrefixes = "exclude_prefixes"
+	IncludeTags     = "include_tags"
+	ExcludeTags     = "exclude_tags"
+
+	NotifyEndpoint       = "notify_endpoint"
+	NotifyAuthToken      = "notify_auth_token"
+	NotifyObjectFailures = "notify_object_failures"
+
+	EnvBitrot   = "MINIO_HEAL_BITROTSCAN"
+	EnvSleep    = "MINIO_HEAL_MAX_SLEEP"
+	EnvIOCount  = "MINIO_HEAL_MAX_IO"
+	EnvWorkers  = "MINIO_HEAL_WORKERS"
+	EnvInterval = "MINIO_HEAL_INTERVAL"
+	EnvMaxIOPS  = "MINIO_HEAL_MAX_IOPS"
+	EnvPriority = "MINIO_HEAL_PRIORITY"
+	EnvSchedule = "MINIO_HEAL_SCHEDULE"
+
+	EnvBucketWorkers = "MINIO_HEAL_BUCKET_WORKERS"
+
+	EnvScheduleDrives = "MINIO_HEAL_SCHEDULE_DRIVES"
+
+	EnvAdaptive   = "MINIO_HEAL_ADAPTIVE"
+	EnvMaxLatency = "MINIO_HEAL_MAX_LATENCY"
+
+	EnvDrainTimeout = "MINIO_HEAL_DRAIN_TIMEOUT"
+	EnvSampleRate   = "MINIO_HEAL_SAMPLE_RATE"
+	EnvAudit        = "MINIO_HEAL_AUDIT"
+	EnvDryRun       = "MINIO_HEAL_DRY_RUN"
+
+	EnvScrubInterval = "MINIO_HEAL_SCRUB_INTERVAL"
+	EnvExpiryHorizon = "MINIO_HEAL_EXPIRY_HORIZON"
+
+	EnvRetryAttempts = "MINIO_HEAL_RETRY_ATTEMPTS"
+	EnvRetryDelay    = "MINIO_HEAL_RETRY_DELAY"
+
+	EnvIncludePrefixes = "MINIO_HEAL_INCLUDE_PREFIXES"
+	EnvExcludePrefixes = "MINIO_HEAL_EXCLUDE_PREFIXES"
+	EnvIncludeTags     = "MINIO_HEAL_INCLUDE_TAGS"
+	EnvExcludeTags     = "MINIO_HEAL_EXCLUDE_TAGS"
+
+	EnvNotifyEndpoint       = "MINIO_HEAL_NOTIFY_ENDPOINT"
+	EnvNotifyAuthToken      = "MINIO_HEAL_NOTIFY_AUTH_TOKEN"
+	EnvNotifyObjectFailures = "MINIO_HEAL_NOTIFY_OBJECT_FAILURES"
+
+	// DefaultInterval is the default interval between heal rounds.
+	DefaultInterval = 24 * time.Hour
+
+	// DefaultDrainTimeout is the default time allowed for queued
+	// heal tasks to finish when healing is stopped.
+	DefaultDrainTimeout = 10 * time.Second
+
+	// DefaultMaxLatency is the default drive latency above which
+	// adaptive throttling backs off.
+	DefaultMaxLatency = 100 * time.Millisecond
+
+	// DefaultRetryAttempts is the default number of attempts to heal
+	// an object, including the first one.
+	DefaultRetryAttempts = 5
+
+	// DefaultRetryDelay is the default delay before the first retry.
+	DefaultRetryDelay = 5 * time.Second
 )
 
 // Config represents the heal settings.
@@ -43,6 +128,129 @@ type Config struct {
 	// maximum sleep duration between objects to slow down heal operation.
 	Sleep   time.Duration `json:"sleep"`
 	IOCount int           `json:"iocount"`
+	// maximum number of buckets healed concurrently, defaults to GOMAXPROCS.
+	Workers int `json:"workers"`
+	// maximum number of buckets healed concurrently on each erasure
+	// set, defaults to GOMAXPROCS divided by the drives per set.
+	BucketWorkers int `json:"bucketWorkers"`
+	// interval between the end of a heal round and the start of the next one.
+	Interval time.Duration `json:"interval"`
+	// maximum heal operations per second shared by all erasure sets
+	// healing on this server, 0 means unlimited.
+	MaxIOPS int `json:"maxiops"`
+	// Priority heals objects found on the fewest drives first.
+	Priority bool `json:"priority"`
+	// daily window during which background healing runs, healing
+	// of fresh drives is only held back when ScheduleDrives is set.
+	Schedule       Window `json:"schedule"`
+	ScheduleDrives bool   `json:"scheduleDrives"`
+	// Adaptive adjusts the delay between heal operations, up to
+	// Sleep, to the number of in-flight requests compared to
+	// IOCount and to the latency of local drives compared to
+	// MaxLatency.
+	Adaptive   bool          `json:"adaptive"`
+	MaxLatency time.Duration `json:"maxLatency"`
+	// time allowed for queued heal tasks to finish when healing
+	// is stopped, remaining tasks are recorded as interrupted.
+	DrainTimeout time.Duration `json:"drainTimeout"`
+	// fraction of objects deep verified for bitrot by background
+	// healing, between 0 and 1.
+	SampleRate float64 `json:"sampleRate"`
+	// Audit sends a record of every object healed by background
+	// healing to the audit targets.
+	Audit bool `json:"audit"`
+	// DryRun only verifies objects during background healing,
+	// the objects which would be repaired are reported instead.
+	DryRun bool `json:"dryRun"`
+	// every object version is deep verified for bitrot once per
+	// ScrubInterval, independently of background healing, 0
+	// disables scrubbing.
+	ScrubInterval time.Duration `json:"scrubInterval"`
+	// object versions which a lifecycle rule expires within
+	// ExpiryHorizon are not healed, 0 heals all versions.
+	ExpiryHorizon time.Duration `json:"expiryHorizon"`
+	// number of attempts to heal an object which fails to heal,
+	// including the first one, before it is added to the dead-letter
+	// list. Retries are delayed by RetryDelay, doubled on every attempt.
+	RetryAttempts int           `json:"retryAttempts"`
+	RetryDelay    time.Duration `json:"retryDelay"`
+	// filters applied to objects during background healing, prefixes
+	// containing '*' or '?' are matched as globs. Only objects with
+	// one of the include prefixes and all of the include tags are
+	// healed, objects with an exclude prefix or tag are skipped.
+	IncludePrefixes []string          `json:"includePrefixes"`
+	ExcludePrefixes []string          `json:"excludePrefixes"`
+	IncludeTags     map[string]string `json:"includeTags"`
+	ExcludeTags     map[string]string `json:"excludeTags"`
+	// HTTP endpoint notified when healing of drives and heal rounds
+	// start and finish, empty disables notifications. Objects which
+	// fail to heal are notified when NotifyObjectFailures is set.
+	NotifyEndpoint       string `json:"notifyEndpoint"`
+	NotifyAuthToken      string `json:"notifyAuthToken"`
+	NotifyObjectFailures bool   `json:"notifyObjectFailures"`
+}
+
+// GetInterval returns the interval between heal rounds,
+// if not configured defaults to DefaultInterval.
+func (opts Config) GetInterval() time.Duration {
+	if opts.Interval <= 0 {
+		return DefaultInterval
+	}
+	return opts.Interval
+}
+
+// GetDrainTimeout returns the time allowed for queued heal tasks to
+// finish, if not configured defaults to DefaultDrainTimeout.
+func (opts Config) GetDrainTimeout() time.Duration {
+	if opts.DrainTimeout <= 0 {
+		return DefaultDrainTimeout
+	}
+	return opts.DrainTimeout
+}
+
+// GetRetryAttempts returns the number of attempts to heal an object,
+// if not configured defaults to DefaultRetryAttempts.
+func (opts Config) GetRetryAttempts() int {
+	if opts.RetryAttempts <= 0 {
+		return DefaultRetryAttempts
+	}
+	return opts.RetryAttempts
+}
+
+// GetRetryDelay returns the delay before the first retry,
+// if not configured defaults to DefaultRetryDelay.
+func (opts Config) GetRetryDelay() time.Duration {
+	if opts.RetryDelay <= 0 {
+		return DefaultRetryDelay
+	}
+	return opts.RetryDelay
+}
+
+// GetWorkers returns the number of heal workers, if not
+// configured defaults to GOMAXPROCS.
+func (opts Config) GetWorkers() int {
+	if opts.Workers <= 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return opts.Workers
+}
+
+// GetBucketWorkers returns the number of buckets healed concurrently on
+// an erasure set of setDriveCount drives. Every bucket being healed walks
+// all drives of the set, if not configured it defaults to GOMAXPROCS
+// divided by the number of drives, with a minimum of one.
+func (opts Config) GetBucketWorkers(setDriveCount int) int {
+	if opts.BucketWorkers > 0 {
+		return opts.BucketWorkers
+	}
+	workers := runtime.GOMAXPROCS(0)
+	if setDriveCount > 0 {
+		workers /= setDriveCount
+	}
+	if workers < 1 {
+		workers = 1
+	}
+	return workers
 }
 
 var (
@@ -60,6 +268,102 @@ var (
 			Key:   IOCount,
 			Value: "10",
 		},
+		config.KV{
+			Key:   Workers,
+			Value: "",
+		},
+		config.KV{
+			Key:   BucketWorkers,
+			Value: "",
+		},
+		config.KV{
+			Key:   Interval,
+			Value: "24h",
+		},
+		config.KV{
+			Key:   MaxIOPS,
+			Value: "0",
+		},
+		config.KV{
+			Key:   Priority,
+			Value: config.EnableOff,
+		},
+		config.KV{
+			Key:   Schedule,
+			Value: "",
+		},
+		config.KV{
+			Key:   ScheduleDrives,
+			Value: config.EnableOff,
+		},
+		config.KV{
+			Key:   Adaptive,
+			Value: config.EnableOff,
+		},
+		config.KV{
+			Key:   MaxLatency,
+			Value: "100ms",
+		},
+		config.KV{
+			Key:   DrainTimeout,
+			Value: "10s",
+		},
+		config.KV{
+			Key:   SampleRate,
+			Value: "0",
+		},
+		config.KV{
+			Key:   Audit,
+			Value: config.EnableOff,
+		},
+		config.KV{
+			Key:   DryRun,
+			Value: config.EnableOff,
+		},
+		config.KV{
+			Key:   ScrubInterval,
+			Value: "",
+		},
+		config.KV{
+			Key:   ExpiryHorizon,
+			Value: "",
+		},
+		config.KV{
+			Key:   RetryAttempts,
+			Value: "5",
+		},
+		config.KV{
+			Key:   RetryDelay,
+			Value: "5s",
+		},
+		config.KV{
+			Key:   IncludePrefixes,
+			Value: "",
+		},
+		config.KV{
+			Key:   ExcludePrefixes,
+			Value: "",
+		},
+		config.KV{
+			Key:   IncludeTags,
+			Value: "",
+		},
+		config.KV{
+			Key:   ExcludeTags,
+			Value: "",
+		},
+		config.KV{
+			Key:   NotifyEndpoint,
+			Value: "",
+		},
+		config.KV{
+			Key:   NotifyAuthToken,
+			Value: "",
+		},
+		config.KV{
+			Key:   NotifyObjectFailures,
+			Value: config.EnableOn,
+		},
 	}
 
 	// Help provides help for config values
@@ -82,6 +386,150 @@ var (
 			Optional:    true,
 			Type:        "int",
 		},
+		config.HelpKV{
+			Key:         Workers,
+			Description: `maximum number of buckets healed concurrently, defaults to number of CPUs. eg. 4`,
+			Optional:    true,
+			Type:        "int",
+		},
+		config.HelpKV{
+			Key:         BucketWorkers,
+			Description: `maximum number of buckets healed concurrently on each erasure set, defaults to number of CPUs divided by drives per set. eg. 2`,
+			Optional:    true,
+			Type:        "int",
+		},
+		config.HelpKV{
+			Key:         Interval,
+			Description: `interval between heal rounds, defaults to '24h'`,
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         MaxIOPS,
+			Description: `maximum heal operations per second on each server across all erasure sets, '0' for unlimited`,
+			Optional:    true,
+			Type:        "int",
+		},
+		config.HelpKV{
+			Key:         Priority,
+			Description: `heal objects available on the fewest drives first, when all drives of the erasure set are online`,
+			Optional:    true,
+			Type:        "on|off",
+		},
+		config.HelpKV{
+			Key:         Schedule,
+			Description: `daily window in server local time during which background heal runs, eg. '20:00-08:00'`,
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         ScheduleDrives,
+			Description: `restrict healing of fresh drives to the heal schedule as well`,
+			Optional:    true,
+			Type:        "on|off",
+		},
+		config.HelpKV{
+			Key:         Adaptive,
+			Description: `adapt the delay between heal operations to the client load and the latency of local drives`,
+			Optional:    true,
+			Type:        "on|off",
+		},
+		config.HelpKV{
+			Key:         MaxLatency,
+			Description: `drive latency above which adaptive heal backs off, defaults to '100ms'`,
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         DrainTimeout,
+			Description: `time allowed for queued heal tasks to finish when healing is stopped, defaults to '10s'`,
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         SampleRate,
+			Description: `fraction of objects deep verified for bitrot by background heal, between '0' and '1'. eg. 0.01`,
+			Optional:    true,
+			Type:        "float",
+		},
+		config.HelpKV{
+			Key:         Audit,
+			Description: `send a record of every object healed on the server to the audit targets`,
+			Optional:    true,
+			Type:        "on|off",
+		},
+		config.HelpKV{
+			Key:         DryRun,
+			Description: `only verify objects during background heal and report those which would be repaired`,
+			Optional:    true,
+			Type:        "on|off",
+		},
+		config.HelpKV{
+			Key:         ScrubInterval,
+			Description: `deep verify every object for bitrot once per interval, disabled when empty. eg. '720h'`,
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         ExpiryHorizon,
+			Description: `skip healing object versions which expire by lifecycle within the horizon, disabled when empty. eg. '48h'`,
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         RetryAttempts,
+			Description: `attempts to heal an object before it is added to the dead-letter list, '1' disables retries, defaults to '5'`,
+			Optional:    true,
+			Type:        "int",
+		},
+		config.HelpKV{
+			Key:         RetryDelay,
+			Description: `delay before retrying an object which failed to heal, doubled on every attempt, defaults to '5s'`,
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         IncludePrefixes,
+			Description: `comma separated object prefixes or globs healed by background heal, all objects when empty. eg. 'archive/,*.parquet'`,
+			Optional:    true,
+			Type:        "csv",
+		},
+		config.HelpKV{
+			Key:         ExcludePrefixes,
+			Description: `comma separated object prefixes or globs skipped by background heal. eg. 'tmp/'`,
+			Optional:    true,
+			Type:        "csv",
+		},
+		config.HelpKV{
+			Key:         IncludeTags,
+			Description: `comma separated object tags required for background heal. eg. 'tier=gold'`,
+			Optional:    true,
+			Type:        "csv",
+		},
+		config.HelpKV{
+			Key:         ExcludeTags,
+			Description: `comma separated object tags skipped by background heal. eg. 'tier=tmp,expiry=1d'`,
+			Optional:    true,
+			Type:        "csv",
+		},
+		config.HelpKV{
+			Key:         NotifyEndpoint,
+			Description: `HTTP(s) endpoint notified of the progress of healing drives, buckets and heal rounds`,
+			Optional:    true,
+			Type:        "url",
+		},
+		config.HelpKV{
+			Key:         NotifyAuthToken,
+			Description: `opaque string or JWT authorization token sent to the notify endpoint`,
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         NotifyObjectFailures,
+			Description: `notify the endpoint of every object which fails to heal, defaults to 'on'`,
+			Optional:    true,
+			Type:        "on|off",
+		},
 	}
 )
 
@@ -102,5 +550,191 @@ func LookupConfig(kvs config.KVS) (cfg Config, err error) {
 	if err != nil {
 		return cfg, fmt.Errorf("'heal:max_io' value invalid: %w", err)
 	}
+	if workers := env.Get(EnvWorkers, kvs.Get(Workers)); workers != "" {
+		cfg.Workers, err = strconv.Atoi(workers)
+		if err != nil {
+			return cfg, fmt.Errorf("'heal:workers' value invalid: %w", err)
+		}
+		if cfg.Workers < 0 {
+			return cfg, fmt.Errorf("'heal:workers' value invalid: %d", cfg.Workers)
+		}
+	}
+	if workers := env.Get(EnvBucketWorkers, kvs.Get(BucketWorkers)); workers != "" {
+		cfg.BucketWorkers, err = strconv.Atoi(workers)
+		if err != nil {
+			return cfg, fmt.Errorf("'heal:bucket_workers' value invalid: %w", err)
+		}
+		if cfg.BucketWorkers < 0 {
+			return cfg, fmt.Errorf("'heal:bucket_workers' value invalid: %d", cfg.BucketWorkers)
+		}
+	}
+	cfg.Interval = DefaultInterval
+	if interval := env.Get(EnvInterval, kvs.Get(Interval)); interval != "" {
+		cfg.Interval, err = time.ParseDuration(interval)
+		if err != nil {
+			return cfg, fmt.Errorf("'heal:interval' value invalid: %w", err)
+		}
+		if cfg.Interval <= 0 {
+			return cfg, fmt.Errorf("'heal:interval' value invalid: %s", cfg.Interval)
+		}
+	}
+	if maxIOPS := env.Get(EnvMaxIOPS, kvs.Get(MaxIOPS)); maxIOPS != "" {
+		cfg.MaxIOPS, err = strconv.Atoi(maxIOPS)
+		if err != nil {
+			return cfg, fmt.Errorf("'heal:max_iops' value invalid: %w", err)
+		}
+		if cfg.MaxIOPS < 0 {
+			return cfg, fmt.Errorf("'heal:max_iops' value invalid: %d", cfg.MaxIOPS)
+		}
+	}
+	if priority := env.Get(EnvPriority, kvs.Get(Priority)); priority != "" {
+		cfg.Priority, err = config.ParseBool(priority)
+		if err != nil {
+			return cfg, fmt.Errorf("'heal:priority' value invalid: %w", err)
+		}
+	}
+	if schedule := env.Get(EnvSchedule, kvs.Get(Schedule)); schedule != "" {
+		cfg.Schedule, err = ParseWindow(schedule)
+		if err != nil {
+			return cfg, fmt.Errorf("'heal:schedule' value invalid: %w", err)
+		}
+	}
+	if scheduleDrives := env.Get(EnvScheduleDrives, kvs.Get(ScheduleDrives)); scheduleDrives != "" {
+		cfg.ScheduleDrives, err = config.ParseBool(scheduleDrives)
+		if err != nil {
+			return cfg, fmt.Errorf("'heal:schedule_drives' value invalid: %w", err)
+		}
+	}
+	if adaptive := env.Get(EnvAdaptive, kvs.Get(Adaptive)); adaptive != "" {
+		cfg.Adaptive, err = config.ParseBool(adaptive)
+		if err != nil {
+			return cfg, fmt.Errorf("'heal:adaptive' value invalid: %w", err)
+		}
+	}
+	cfg.MaxLatency = DefaultMaxLatency
+	if maxLatency := env.Get(EnvMaxLatency, kvs.Get(MaxLatency)); maxLatency != "" {
+		cfg.MaxLatency, err = time.ParseDuration(maxLatency)
+		if err != nil {
+			return cfg, fmt.Errorf("'heal:max_latency' value invalid: %w", err)
+		}
+	}
+	cfg.DrainTimeout = DefaultDrainTimeout
+	if drainTimeout := env.Get(EnvDrainTimeout, kvs.Get(DrainTimeout)); drainTimeout != "" {
+		cfg.DrainTimeout, err = time.ParseDuration(drainTimeout)
+		if err != nil {
+			return cfg, fmt.Errorf("'heal:drain_timeout' value invalid: %w", err)
+		}
+		if cfg.DrainTimeout <= 0 {
+			return cfg, fmt.Errorf("'heal:drain_timeout' value invalid: %s", cfg.DrainTimeout)
+		}
+	}
+	if sampleRate := env.Get(EnvSampleRate, kvs.Get(SampleRate)); sampleRate != "" {
+		cfg.SampleRate, err = strconv.ParseFloat(sampleRate, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("'heal:sample_rate' value invalid: %w", err)
+		}
+		if cfg.SampleRate < 0 || cfg.SampleRate > 1 {
+			return cfg, fmt.Errorf("'heal:sample_rate' value invalid: %v", cfg.SampleRate)
+		}
+	}
+	if audit := env.Get(EnvAudit, kvs.Get(Audit)); audit != "" {
+		cfg.Audit, err = config.ParseBool(audit)
+		if err != nil {
+			return cfg, fmt.Errorf("'heal:audit' value invalid: %w", err)
+		}
+	}
+	if dryRun := env.Get(EnvDryRun, kvs.Get(DryRun)); dryRun != "" {
+		cfg.DryRun, err = config.ParseBool(dryRun)
+		if err != nil {
+			return cfg, fmt.Errorf("'heal:dry_run' value invalid: %w", err)
+		}
+	}
+	if scrubInterval := env.Get(EnvScrubInterval, kvs.Get(ScrubInterval)); scrubInterval != "" {
+		cfg.ScrubInterval, err = time.ParseDuration(scrubInterval)
+		if err != nil {
+			return cfg, fmt.Errorf("'heal:scrub_interval' value invalid: %w", err)
+		}
+		if cfg.ScrubInterval < 0 {
+			return cfg, fmt.Errorf("'heal:scrub_interval' value invalid: %s", cfg.ScrubInterval)
+		}
+	}
+	if expiryHorizon := env.Get(EnvExpiryHorizon, kvs.Get(ExpiryHorizon)); expiryHorizon != "" {
+		cfg.ExpiryHorizon, err = time.ParseDuration(expiryHorizon)
+		if err != nil {
+			return cfg, fmt.Errorf("'heal:expiry_horizon' value invalid: %w", err)
+		}
+		if cfg.ExpiryHorizon < 0 {
+			return cfg, fmt.Errorf("'heal:expiry_horizon' value invalid: %s", cfg.ExpiryHorizon)
+		}
+	}
+	cfg.RetryAttempts = DefaultRetryAttempts
+	if retryAttempts := env.Get(EnvRetryAttempts, kvs.Get(RetryAttempts)); retryAttempts != "" {
+		cfg.RetryAttempts, err = strconv.Atoi(retryAttempts)
+		if err != nil {
+			return cfg, fmt.Errorf("'heal:retry_attempts' value invalid: %w", err)
+		}
+		if cfg.RetryAttempts < 1 {
+			return cfg, fmt.Errorf("'heal:retry_attempts' value invalid: %d", cfg.RetryAttempts)
+		}
+	}
+	cfg.RetryDelay = DefaultRetryDelay
+	if retryDelay := env.Get(EnvRetryDelay, kvs.Get(RetryDelay)); retryDelay != "" {
+		cfg.RetryDelay, err = time.ParseDuration(retryDelay)
+		if err != nil {
+			return cfg, fmt.Errorf("'heal:retry_delay' value invalid: %w", err)
+		}
+		if cfg.RetryDelay <= 0 {
+			return cfg, fmt.Errorf("'heal:retry_delay' value invalid: %s", cfg.RetryDelay)
+		}
+	}
+	cfg.IncludePrefixes = parsePrefixes(env.Get(EnvIncludePrefixes, kvs.Get(IncludePrefixes)))
+	cfg.ExcludePrefixes = parsePrefixes(env.Get(EnvExcludePrefixes, kvs.Get(ExcludePrefixes)))
+	cfg.IncludeTags, err = parseTags(env.Get(EnvIncludeTags, kvs.Get(IncludeTags)))
+	if err != nil {
+		return cfg, fmt.Errorf("'heal:include_tags' value invalid: %w", err)
+	}
+	cfg.ExcludeTags, err = parseTags(env.Get(EnvExcludeTags, kvs.Get(ExcludeTags)))
+	if err != nil {
+		return cfg, fmt.Errorf("'heal:exclude_tags' value invalid: %w", err)
+	}
+	if endpoint := env.Get(EnvNotifyEndpoint, kvs.Get(NotifyEndpoint)); endpoint != "" {
+		if _, err = xnet.ParseHTTPURL(endpoint); err != nil {
+			return cfg, fmt.Errorf("'heal:notify_endpoint' value invalid: %w", err)
+		}
+		cfg.NotifyEndpoint = endpoint
+		cfg.NotifyAuthToken = env.Get(EnvNotifyAuthToken, kvs.Get(NotifyAuthToken))
+	}
+	if objectFailures := env.Get(EnvNotifyObjectFailures, kvs.Get(NotifyObjectFailures)); objectFailures != "" {
+		cfg.NotifyObjectFailures, err = config.ParseBool(objectFailures)
+		if err != nil {
+			return cfg, fmt.Errorf("'heal:notify_object_failures' value invalid: %w", err)
+		}
+	}
 	return cfg, nil
 }
+
+// parsePrefixes parses a comma separated list of prefixes.
+func parsePrefixes(s string) (prefixes []string) {
+	for _, prefix := range strings.Split(s, config.ValueSeparator) {
+		if prefix = strings.TrimSpace(prefix); prefix != "" {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	return prefixes
+}
+
+// parseTags parses a comma separated list of 'key=value' tags.
+func parseTags(s string) (map[string]string, error) {
+	var tags map[string]string
+	for _, tag := range parsePrefixes(s) {
+		kv := strings.SplitN(tag, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("tag '%s' is not of the form 'key=value'", tag)
+		}
+		if tags == nil {
+			tags = make(map[string]string)
+		}
+		tags[kv[0]] = kv[1]
+	}
+	return tags, nil
+}
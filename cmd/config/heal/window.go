@@ -0,0 +1,105 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package heal
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Window is a daily window in the server local time zone
+// during which background healing is allowed to run, a window
+// may wrap around midnight. The zero value allows healing at
+// any time.
+type Window struct {
+	// offsets from midnight
+	Start time.Duration `json:"start"`
+	End   time.Duration `json:"end"`
+}
+
+// ParseWindow parses a window of the form 'HH:MM-HH:MM'.
+func ParseWindow(s string) (w Window, err error) {
+	parts := strings.Split(s, "-")
+	if len(parts) != 2 {
+		return w, fmt.Errorf("invalid heal schedule %q, expected 'HH:MM-HH:MM'", s)
+	}
+	if w.Start, err = parseClock(parts[0]); err != nil {
+		return w, err
+	}
+	if w.End, err = parseClock(parts[1]); err != nil {
+		return w, err
+	}
+	if w.Start == w.End {
+		return w, fmt.Errorf("invalid heal schedule %q, start and end must differ", s)
+	}
+	return w, nil
+}
+
+func parseClock(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("invalid heal schedule time %q, expected 'HH:MM'", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// IsZero returns true if healing is allowed at any time.
+func (s Window) IsZero() bool {
+	return s.Start == s.End
+}
+
+// Contains returns true if t is within the window.
+func (s Window) Contains(t time.Time) bool {
+	if s.IsZero() {
+		return true
+	}
+	offset := sinceMidnight(t)
+	if s.Start < s.End {
+		return offset >= s.Start && offset < s.End
+	}
+	// Window wraps around midnight.
+	return offset >= s.Start || offset < s.End
+}
+
+// Next returns the earliest time at or after t within the window.
+func (s Window) Next(t time.Time) time.Time {
+	if s.Contains(t) {
+		return t
+	}
+	offset := sinceMidnight(t)
+	midnight := t.Add(-offset)
+	if offset < s.Start {
+		return midnight.Add(s.Start)
+	}
+	return midnight.AddDate(0, 0, 1).Add(s.Start)
+}
+
+// String returns the window in the form 'HH:MM-HH:MM'.
+func (s Window) String() string {
+	if s.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%02d:%02d-%02d:%02d",
+		int(s.Start.Hours()), int(s.Start.Minutes())%60,
+		int(s.End.Hours()), int(s.End.Minutes())%60)
+}
+
+func sinceMidnight(t time.Time) time.Duration {
+	y, m, d := t.Date()
+	return t.Sub(time.Date(y, m, d, 0, 0, 0, 0, t.Location()))
+}
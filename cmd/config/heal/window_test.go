@@ -0,0 +1,86 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package heal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseWindow(t *testing.T) {
+	testCases := []struct {
+		window  string
+		success bool
+	}{
+		{"20:00-08:00", true},
+		{"01:30-05:45", true},
+		{"20:00", false},
+		{"20:00-25:00", false},
+		{"08:00-08:00", false},
+		{"8pm-8am", false},
+	}
+	for _, testCase := range testCases {
+		w, err := ParseWindow(testCase.window)
+		if testCase.success && err != nil {
+			t.Errorf("%s: unexpected error %v", testCase.window, err)
+		}
+		if !testCase.success && err == nil {
+			t.Errorf("%s: expected an error", testCase.window)
+		}
+		if testCase.success && w.String() != testCase.window {
+			t.Errorf("%s: expected string %s, got %s", testCase.window, testCase.window, w.String())
+		}
+	}
+}
+
+func TestWindowContains(t *testing.T) {
+	at := func(hour, min int) time.Time {
+		return time.Date(2021, 3, 1, hour, min, 0, 0, time.UTC)
+	}
+	overnight, err := ParseWindow("20:00-08:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	daytime, err := ParseWindow("09:00-17:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		w        Window
+		t        time.Time
+		contains bool
+		next     time.Time
+	}{
+		{Window{}, at(12, 0), true, at(12, 0)},
+		{overnight, at(21, 0), true, at(21, 0)},
+		{overnight, at(7, 59), true, at(7, 59)},
+		{overnight, at(8, 0), false, at(20, 0)},
+		{overnight, at(12, 0), false, at(20, 0)},
+		{daytime, at(8, 0), false, at(9, 0)},
+		{daytime, at(12, 0), true, at(12, 0)},
+		{daytime, at(17, 0), false, at(9, 0).AddDate(0, 0, 1)},
+	}
+	for i, testCase := range testCases {
+		if got := testCase.w.Contains(testCase.t); got != testCase.contains {
+			t.Errorf("case %d: expected contains %v, got %v", i+1, testCase.contains, got)
+		}
+		if got := testCase.w.Next(testCase.t); !got.Equal(testCase.next) {
+			t.Errorf("case %d: expected next %s, got %s", i+1, testCase.next, got)
+		}
+	}
+}
@@ -179,7 +179,7 @@ var (
 		},
 		config.HelpKV{
 			Key:         target.KafkaSASLMechanism,
-			Description: "sasl authentication mechanism, default 'plain'",
+			Description: "sasl authentication mechanism, 'plain', 'sha256' (SCRAM-SHA-256) or 'sha512' (SCRAM-SHA-512), default 'plain'",
 			Optional:    true,
 			Type:        "string",
 		},
@@ -462,6 +462,12 @@ var (
 			Optional:    true,
 			Type:        "string",
 		},
+		config.HelpKV{
+			Key:         target.NATSJetStream,
+			Description: "set to 'on', to publish to a JetStream stream and wait for the events to be persisted",
+			Optional:    true,
+			Type:        "on|off",
+		},
 		config.HelpKV{
 			Key:         target.NATSCertAuthority,
 			Description: "path to certificate chain of the target NATS server",
@@ -954,6 +954,10 @@ var (
 			Key:   target.NATSStreamingClusterID,
 			Value: "",
 		},
+		config.KV{
+			Key:   target.NATSJetStream,
+			Value: config.EnableOff,
+		},
 		config.KV{
 			Key:   target.NATSQueueDir,
 			Value: "",
@@ -1110,6 +1114,12 @@ func GetNotifyNATS(natsKVS map[string]config.KVS, rootCAs *x509.CertPool) (map[s
 			natsArgs.Streaming.MaxPubAcksInflight = maxPubAcksInflight
 		}
 
+		jetStreamEnableEnv := target.EnvNATSJetStream
+		if k != config.Default {
+			jetStreamEnableEnv = jetStreamEnableEnv + config.Default + k
+		}
+		natsArgs.JetStream.Enable = env.Get(jetStreamEnableEnv, kv.Get(target.NATSJetStream)) == config.EnableOn
+
 		if err = natsArgs.Validate(); err != nil {
 			return nil, err
 		}
@@ -39,6 +39,12 @@ var (
 			Optional:    true,
 			Type:        "string",
 		},
+		config.HelpKV{
+			Key:         ClassDMAReadThreshold,
+			Description: `read object data of at least this size with O_DIRECT if enabled for reads, "0" only reads from the start of the file with O_DIRECT, defaults to "0" e.g. "1MiB"`,
+			Optional:    true,
+			Type:        "string",
+		},
 		config.HelpKV{
 			Key:         config.Comment,
 			Description: config.DefaultComment,
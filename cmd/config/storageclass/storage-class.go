@@ -23,6 +23,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/dustin/go-humanize"
 	"github.com/minio/minio/cmd/config"
 	"github.com/minio/minio/pkg/env"
 )
@@ -47,12 +48,16 @@ const (
 	ClassRRS      = "rrs"
 	ClassDMA      = "dma"
 
+	ClassDMAReadThreshold = "dma_read_threshold"
+
 	// Reduced redundancy storage class environment variable
 	RRSEnv = "MINIO_STORAGE_CLASS_RRS"
 	// Standard storage class environment variable
 	StandardEnv = "MINIO_STORAGE_CLASS_STANDARD"
 	// DMA storage class environment variable
 	DMAEnv = "MINIO_STORAGE_CLASS_DMA"
+	// DMA read threshold environment variable
+	DMAReadThresholdEnv = "MINIO_STORAGE_CLASS_DMA_READ_THRESHOLD"
 
 	// Supported storage class scheme is EC
 	schemePrefix = "EC"
@@ -65,6 +70,10 @@ const (
 
 	// Default DMA value
 	defaultDMA = DMAReadWrite
+
+	// Default size from which reads bypass the page cache, zero
+	// keeps bypassing it for reads from the start of the file only.
+	defaultDMAReadThreshold = "0"
 )
 
 // DefaultKVS - default storage class config
@@ -82,6 +91,10 @@ var (
 			Key:   ClassDMA,
 			Value: defaultDMA,
 		},
+		config.KV{
+			Key:   ClassDMAReadThreshold,
+			Value: defaultDMAReadThreshold,
+		},
 	}
 )
 
@@ -95,6 +108,8 @@ type Config struct {
 	Standard StorageClass `json:"standard"`
 	RRS      StorageClass `json:"rrs"`
 	DMA      string       `json:"dma"`
+
+	DMAReadThreshold int64 `json:"dmaReadThreshold"`
 }
 
 // UnmarshalJSON - Validate SS and RRS parity when unmarshalling JSON.
@@ -249,6 +264,12 @@ func (sCfg Config) GetDMA() string {
 	return sCfg.DMA
 }
 
+// GetDMAReadThreshold - returns the size from which reads of
+// object data bypass the page cache, if DMA is enabled for reads.
+func (sCfg Config) GetDMAReadThreshold() int64 {
+	return sCfg.DMAReadThreshold
+}
+
 // Enabled returns if etcd is enabled.
 func Enabled(kvs config.KVS) bool {
 	ssc := kvs.Get(ClassStandard)
@@ -267,6 +288,7 @@ func LookupConfig(kvs config.KVS, setDriveCount int) (cfg Config, err error) {
 	ssc := env.Get(StandardEnv, kvs.Get(ClassStandard))
 	rrsc := env.Get(RRSEnv, kvs.Get(ClassRRS))
 	dma := env.Get(DMAEnv, kvs.Get(ClassDMA))
+	dmaReadThreshold := env.Get(DMAReadThresholdEnv, kvs.Get(ClassDMAReadThreshold))
 	// Check for environment variables and parse into storageClass struct
 	if ssc != "" {
 		cfg.Standard, err = parseStorageClass(ssc)
@@ -293,6 +315,15 @@ func LookupConfig(kvs config.KVS, setDriveCount int) (cfg Config, err error) {
 	}
 	cfg.DMA = dma
 
+	if dmaReadThreshold == "" {
+		dmaReadThreshold = defaultDMAReadThreshold
+	}
+	threshold, err := humanize.ParseBytes(dmaReadThreshold)
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid dma read threshold %q: %w", dmaReadThreshold, err)
+	}
+	cfg.DMAReadThreshold = int64(threshold)
+
 	// Validation is done after parsing both the storage classes. This is needed because we need one
 	// storage class value to deduce the correct value of the other storage class.
 	if err = validateParity(cfg.Standard.Parity, cfg.RRS.Parity, setDriveCount); err != nil {
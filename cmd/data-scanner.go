@@ -83,6 +83,9 @@ func runDataScanner(ctx context.Context, objAPI ObjectLayer) {
 		// No unlock for "leader" lock.
 	}
 
+	// Keep the bucket listing caches fresh.
+	go runListCacheRefresh(ctx, objAPI)
+
 	// Load current bloom cycle
 	nextBloomCycle := intDataUpdateTracker.current() + 1
 
@@ -115,6 +118,9 @@ func runDataScanner(ctx context.Context, objAPI ObjectLayer) {
 				console.Debugln("starting scanner cycle")
 			}
 
+			// Do not start a new cycle while in maintenance mode.
+			globalMaintenance.waitIfPaused(ctx, madmin.MaintenanceScanner)
+
 			// Wait before starting next cycle and wait on startup.
 			results := make(chan DataUsageInfo, 1)
 			go storeDataUsageInBackend(ctx, objAPI, results)
@@ -395,6 +401,7 @@ func (f *folderScanner) scanQueuedLevels(ctx context.Context, folders []cachedFo
 				}
 			}
 		}
+		globalMaintenance.waitIfPaused(ctx, madmin.MaintenanceScanner)
 		scannerSleeper.Sleep(ctx, dataScannerSleepPerFolder)
 
 		cache := dataUsageEntry{}
@@ -486,6 +493,7 @@ func (f *folderScanner) scanQueuedLevels(ctx context.Context, folders []cachedFo
 			cache.addSizes(sizeSummary)
 			cache.Objects++
 			cache.ObjSizes.add(sizeSummary.totalSize)
+			cache.StorageClasses.add(sizeSummary.storageClass)
 
 			wait() // wait to proceed to next entry.
 
@@ -739,6 +747,7 @@ func (f *folderScanner) deepScanFolder(ctx context.Context, folder cachedFolder,
 		cache.addSizes(sizeSummary)
 		cache.Objects++
 		cache.ObjSizes.add(sizeSummary.totalSize)
+		cache.StorageClasses.add(sizeSummary.storageClass)
 
 		// Wait to throttle IO
 		wait()
@@ -771,6 +780,7 @@ type sizeSummary struct {
 	pendingSize    int64
 	failedSize     int64
 	replicaSize    int64
+	storageClass   string
 }
 
 type getSizeFn func(item scannerItem) (sizeSummary, error)
@@ -812,19 +822,13 @@ func (i *scannerItem) applyActions(ctx context.Context, o ObjectLayer, meta acti
 				console.Debugf(applyActionsLogPrefix+" heal checking: %v/%v\n", i.bucket, i.objectPath())
 			}
 		}
-		healOpts := madmin.HealOpts{Remove: healDeleteDangling}
+		scanMode := madmin.HealNormalScan
 		if meta.bitRotScan {
-			healOpts.ScanMode = madmin.HealDeepScan
-		}
-		res, err := o.HealObject(ctx, i.bucket, i.objectPath(), meta.oi.VersionID, healOpts)
-		if isErrObjectNotFound(err) || isErrVersionNotFound(err) {
-			return 0
-		}
-		if err != nil && !errors.Is(err, NotImplemented{}) {
-			logger.LogIf(ctx, err)
-			return 0
+			scanMode = madmin.HealDeepScan
 		}
-		size = res.ObjectSize
+		// The heal check is queued to background healing, which
+		// shares the throttling, filters and retries of all heals.
+		queueScannerHeal(ctx, i.bucket, i.objectPath(), meta.oi.VersionID, scanMode)
 	}
 	if i.lifeCycle == nil {
 		if i.debug {
@@ -29,6 +29,7 @@ import (
 
 	"github.com/cespare/xxhash/v2"
 	"github.com/klauspost/compress/zstd"
+	"github.com/minio/minio/cmd/config/storageclass"
 	"github.com/minio/minio/cmd/logger"
 	"github.com/minio/minio/pkg/bucket/lifecycle"
 	"github.com/minio/minio/pkg/hash"
@@ -43,8 +44,25 @@ type dataUsageHash string
 // sizeHistogram is a size histogram.
 type sizeHistogram [dataUsageBucketLen]uint64
 
+// storageClassCount is the number of objects per storage class.
+type storageClassCount [dataUsageStorageClassLen]uint64
+
 //msgp:tuple dataUsageEntry
 type dataUsageEntry struct {
+	// These fields do no include any children.
+	Size                   int64
+	ReplicatedSize         uint64
+	ReplicationPendingSize uint64
+	ReplicationFailedSize  uint64
+	ReplicaSize            uint64
+	Objects                uint64
+	ObjSizes               sizeHistogram
+	StorageClasses         storageClassCount
+	Children               dataUsageHashMap
+}
+
+//msgp:tuple dataUsageEntryV3
+type dataUsageEntryV3 struct {
 	// These fields do no include any children.
 	Size                   int64
 	ReplicatedSize         uint64
@@ -65,13 +83,20 @@ type dataUsageEntryV2 struct {
 	Children dataUsageHashMap
 }
 
-// dataUsageCache contains a cache of data usage entries latest version 3.
+// dataUsageCache contains a cache of data usage entries latest version 4.
 type dataUsageCache struct {
 	Info  dataUsageCacheInfo
 	Disks []string
 	Cache map[string]dataUsageEntry
 }
 
+// dataUsageCache contains a cache of data usage entries version 3.
+type dataUsageCacheV3 struct {
+	Info  dataUsageCacheInfo
+	Disks []string
+	Cache map[string]dataUsageEntryV3
+}
+
 // dataUsageCache contains a cache of data usage entries version 2.
 type dataUsageCacheV2 struct {
 	Info  dataUsageCacheInfo
@@ -118,6 +143,10 @@ func (e *dataUsageEntry) merge(other dataUsageEntry) {
 	for i, v := range other.ObjSizes[:] {
 		e.ObjSizes[i] += v
 	}
+
+	for i, v := range other.StorageClasses[:] {
+		e.StorageClasses[i] += v
+	}
 }
 
 // mod returns true if the hash mod cycles == cycle.
@@ -371,6 +400,29 @@ func (h *sizeHistogram) toMap() map[string]uint64 {
 	return res
 }
 
+// add an object of the given storage class to the count.
+func (c *storageClassCount) add(sc string) {
+	if sc == "" {
+		// Objects without storage class are stored as STANDARD.
+		sc = storageclass.STANDARD
+	}
+	for i, class := range dataUsageStorageClasses {
+		if sc == class {
+			c[i]++
+			break
+		}
+	}
+}
+
+// toMap returns the count as a map[string]uint64.
+func (c *storageClassCount) toMap() map[string]uint64 {
+	res := make(map[string]uint64, dataUsageStorageClassLen)
+	for i, count := range c {
+		res[dataUsageStorageClasses[i]] = count
+	}
+	return res
+}
+
 // bucketsUsageInfo returns the buckets usage info as a map, with
 // key as bucket name
 func (d *dataUsageCache) bucketsUsageInfo(buckets []BucketInfo) map[string]BucketUsageInfo {
@@ -382,13 +434,14 @@ func (d *dataUsageCache) bucketsUsageInfo(buckets []BucketInfo) map[string]Bucke
 		}
 		flat := d.flatten(*e)
 		dst[bucket.Name] = BucketUsageInfo{
-			Size:                   uint64(flat.Size),
-			ObjectsCount:           flat.Objects,
-			ReplicationPendingSize: flat.ReplicationPendingSize,
-			ReplicatedSize:         flat.ReplicatedSize,
-			ReplicationFailedSize:  flat.ReplicationFailedSize,
-			ReplicaSize:            flat.ReplicaSize,
-			ObjectSizesHistogram:   flat.ObjSizes.toMap(),
+			Size:                       uint64(flat.Size),
+			ObjectsCount:               flat.Objects,
+			ReplicationPendingSize:     flat.ReplicationPendingSize,
+			ReplicatedSize:             flat.ReplicatedSize,
+			ReplicationFailedSize:      flat.ReplicationFailedSize,
+			ReplicaSize:                flat.ReplicaSize,
+			ObjectSizesHistogram:       flat.ObjSizes.toMap(),
+			ObjectsCountByStorageClass: flat.StorageClasses.toMap(),
 		}
 	}
 	return dst
@@ -403,13 +456,14 @@ func (d *dataUsageCache) bucketUsageInfo(bucket string) BucketUsageInfo {
 	}
 	flat := d.flatten(*e)
 	return BucketUsageInfo{
-		Size:                   uint64(flat.Size),
-		ObjectsCount:           flat.Objects,
-		ReplicationPendingSize: flat.ReplicationPendingSize,
-		ReplicatedSize:         flat.ReplicatedSize,
-		ReplicationFailedSize:  flat.ReplicationFailedSize,
-		ReplicaSize:            flat.ReplicaSize,
-		ObjectSizesHistogram:   flat.ObjSizes.toMap(),
+		Size:                       uint64(flat.Size),
+		ObjectsCount:               flat.Objects,
+		ReplicationPendingSize:     flat.ReplicationPendingSize,
+		ReplicatedSize:             flat.ReplicatedSize,
+		ReplicationFailedSize:      flat.ReplicationFailedSize,
+		ReplicaSize:                flat.ReplicaSize,
+		ObjectSizesHistogram:       flat.ObjSizes.toMap(),
+		ObjectsCountByStorageClass: flat.StorageClasses.toMap(),
 	}
 }
 
@@ -533,6 +587,7 @@ func (d *dataUsageCache) save(ctx context.Context, store objectIO, name string)
 // Bumping the cache version will drop data from previous versions
 // and write new data with the new version.
 const (
+	dataUsageCacheVerV4 = 4
 	dataUsageCacheVerV3 = 3
 	dataUsageCacheVerV2 = 2
 	dataUsageCacheVerV1 = 1
@@ -541,7 +596,7 @@ const (
 // serialize the contents of the cache.
 func (d *dataUsageCache) serializeTo(dst io.Writer) error {
 	// Add version and compress.
-	_, err := dst.Write([]byte{dataUsageCacheVerV3})
+	_, err := dst.Write([]byte{dataUsageCacheVerV4})
 	if err != nil {
 		return err
 	}
@@ -610,6 +665,34 @@ func (d *dataUsageCache) deserialize(r io.Reader) error {
 		}
 		defer dec.Close()
 
+		dold := &dataUsageCacheV3{}
+		if err = dold.DecodeMsg(msgp.NewReader(dec)); err != nil {
+			return err
+		}
+		d.Info = dold.Info
+		d.Disks = dold.Disks
+		d.Cache = make(map[string]dataUsageEntry, len(dold.Cache))
+		for k, v := range dold.Cache {
+			d.Cache[k] = dataUsageEntry{
+				Size:                   v.Size,
+				ReplicatedSize:         v.ReplicatedSize,
+				ReplicationPendingSize: v.ReplicationPendingSize,
+				ReplicationFailedSize:  v.ReplicationFailedSize,
+				ReplicaSize:            v.ReplicaSize,
+				Objects:                v.Objects,
+				ObjSizes:               v.ObjSizes,
+				Children:               v.Children,
+			}
+		}
+		return nil
+	case dataUsageCacheVerV4:
+		// Zstd compressed.
+		dec, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(2))
+		if err != nil {
+			return err
+		}
+		defer dec.Close()
+
 		return d.DecodeMsg(msgp.NewReader(dec))
 	}
 	return fmt.Errorf("dataUsageCache: unknown version: %d", int(b[0]))
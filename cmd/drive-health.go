@@ -0,0 +1,258 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/madmin"
+	"github.com/minio/minio/pkg/smart"
+)
+
+const (
+	// Interval at which the health of the local drives is checked.
+	driveHealthInterval = 5 * time.Minute
+
+	// Number of IO errors within a check interval after which a
+	// drive is considered to be failing soon.
+	driveIOErrThreshold = 10
+
+	// 99th percentile IO latency above which a drive is considered
+	// to be failing soon.
+	driveLatencyThreshold = 2 * time.Second
+
+	// Number of recent IO calls the latency percentiles are
+	// computed from.
+	driveLatencySamples = 1024
+)
+
+// diskIOStats accumulates the IO errors and latencies observed on a
+// drive, they are reported by the drive health monitor.
+type diskIOStats struct {
+	// errors are first to be 64-bit aligned for atomic access.
+	readErrs  uint64
+	writeErrs uint64
+
+	mu        sync.Mutex
+	latencies [driveLatencySamples]time.Duration
+	next      int
+	count     int
+}
+
+// observe records the latency and the outcome of an IO call.
+func (s *diskIOStats) observe(write bool, d time.Duration, err error) {
+	if errors.Is(err, errFaultyDisk) || errors.Is(err, errFileCorrupt) {
+		if write {
+			atomic.AddUint64(&s.writeErrs, 1)
+		} else {
+			atomic.AddUint64(&s.readErrs, 1)
+		}
+	}
+
+	s.mu.Lock()
+	s.latencies[s.next] = d
+	s.next = (s.next + 1) % driveLatencySamples
+	if s.count < driveLatencySamples {
+		s.count++
+	}
+	s.mu.Unlock()
+}
+
+// errCounts returns the number of read and write errors observed so far.
+func (s *diskIOStats) errCounts() (readErrs, writeErrs uint64) {
+	return atomic.LoadUint64(&s.readErrs), atomic.LoadUint64(&s.writeErrs)
+}
+
+// latency returns the latency percentiles of the recent IO calls.
+func (s *diskIOStats) latency() (l madmin.DriveLatency) {
+	s.mu.Lock()
+	samples := make([]time.Duration, s.count)
+	copy(samples, s.latencies[:s.count])
+	s.mu.Unlock()
+
+	if len(samples) == 0 {
+		return l
+	}
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+	percentile := func(p int) time.Duration {
+		return samples[(len(samples)-1)*p/100]
+	}
+	l.P50, l.P90, l.P99 = percentile(50), percentile(90), percentile(99)
+	return l
+}
+
+// smartHealthReasons returns the reasons for which the SMART data of
+// a drive indicates that the drive is failing.
+func smartHealthReasons(info smart.Info) (reasons []string) {
+	nvme := info.Nvme
+	if nvme == nil {
+		return nil
+	}
+	if nvme.CriticalWarning != "" && nvme.CriticalWarning != "0" {
+		reasons = append(reasons, fmt.Sprintf("smart: critical warning 0x%s", nvme.CriticalWarning))
+	}
+	var spare, threshold int
+	if _, err := fmt.Sscanf(nvme.SpareAvailable, "%d%%", &spare); err == nil {
+		if _, err = fmt.Sscanf(nvme.SpareThreshold, "%d%%", &threshold); err == nil && spare < threshold {
+			reasons = append(reasons, fmt.Sprintf("smart: available spare %d%% below threshold %d%%", spare, threshold))
+		}
+	}
+	if nvme.MediaAndDataIntegrityErrors != nil && nvme.MediaAndDataIntegrityErrors.Sign() > 0 {
+		reasons = append(reasons, fmt.Sprintf("smart: %s media and data integrity errors", nvme.MediaAndDataIntegrityErrors))
+	}
+	return reasons
+}
+
+// evalDriveHealth sets the state of the drive from its SMART data,
+// the IO errors since the previous check and its IO latency.
+func evalDriveHealth(h *madmin.DriveHealth, prevErrs uint64) {
+	h.Reasons = smartHealthReasons(h.SmartInfo)
+	errs := h.ReadErrors + h.WriteErrors
+	if errs >= prevErrs {
+		// The counters restart when the drive is reconnected.
+		errs -= prevErrs
+	}
+	if errs >= driveIOErrThreshold {
+		h.Reasons = append(h.Reasons, fmt.Sprintf("%d IO errors since the last check", errs))
+	}
+	if h.Latency.P99 >= driveLatencyThreshold {
+		h.Reasons = append(h.Reasons, fmt.Sprintf("p99 IO latency %s above %s", h.Latency.P99, driveLatencyThreshold))
+	}
+	h.State = madmin.DriveHealthOK
+	if len(h.Reasons) > 0 {
+		h.State = madmin.DriveHealthFailingSoon
+	}
+}
+
+// driveHealthMonitor periodically checks the health of the local
+// drives and flags drives which are failing soon, such that they can
+// be healed or decommissioned before they fail.
+type driveHealthMonitor struct {
+	mu     sync.RWMutex
+	drives map[string]madmin.DriveHealth
+}
+
+var globalDriveHealthMonitor = &driveHealthMonitor{
+	drives: make(map[string]madmin.DriveHealth),
+}
+
+// initDriveHealthMonitor starts checking the health of the local drives.
+func initDriveHealthMonitor(ctx context.Context, objAPI ObjectLayer) {
+	z, ok := objAPI.(*erasureServerPools)
+	if !ok {
+		return
+	}
+	go globalDriveHealthMonitor.run(ctx, z)
+}
+
+func (m *driveHealthMonitor) run(ctx context.Context, z *erasureServerPools) {
+	ticker := time.NewTicker(driveHealthInterval)
+	defer ticker.Stop()
+
+	for {
+		m.check(ctx, z)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// check updates the health of all local drives.
+func (m *driveHealthMonitor) check(ctx context.Context, z *erasureServerPools) {
+	for poolIdx, pool := range z.serverPools {
+		for setIdx, set := range pool.sets {
+			for _, disk := range set.getDisks() {
+				if disk == nil || !disk.IsLocal() {
+					continue
+				}
+				h := madmin.DriveHealth{
+					Endpoint:  disk.String(),
+					Path:      disk.Endpoint().Path,
+					LastCheck: UTCNow(),
+				}
+				if d, ok := disk.(*xlStorageDiskIDCheck); ok {
+					h.ReadErrors, h.WriteErrors = d.ioStats.errCounts()
+					h.Latency = d.ioStats.latency()
+				}
+				if !disk.IsOnline() {
+					h.State = madmin.DriveHealthOffline
+					m.update(ctx, h, poolIdx, setIdx)
+					continue
+				}
+				h.Device, h.SmartInfo = getDriveSmartInfo(ctx, h.Path)
+				m.mu.RLock()
+				prev := m.drives[h.Endpoint]
+				m.mu.RUnlock()
+				evalDriveHealth(&h, prev.ReadErrors+prev.WriteErrors)
+				m.update(ctx, h, poolIdx, setIdx)
+			}
+		}
+	}
+}
+
+// update stores the health of a drive, the drive is reported once it
+// starts failing.
+func (m *driveHealthMonitor) update(ctx context.Context, h madmin.DriveHealth, poolIdx, setIdx int) {
+	m.mu.Lock()
+	prev, ok := m.drives[h.Endpoint]
+	m.drives[h.Endpoint] = h
+	m.mu.Unlock()
+
+	if h.State != madmin.DriveHealthFailingSoon || (ok && prev.State == madmin.DriveHealthFailingSoon) {
+		return
+	}
+	reason := strings.Join(h.Reasons, ", ")
+	logger.LogIf(ctx, fmt.Errorf("drive %s is failing soon, consider replacing it: %s", h.Endpoint, reason))
+	globalHealNotifier.send(healEvent{
+		Type:      healEventDriveFailingSoon,
+		PoolIndex: poolIdx,
+		SetIndex:  setIdx,
+		Drive:     h.Endpoint,
+		Reason:    reason,
+	})
+}
+
+// report returns the health of the local drives as of the last check.
+func (m *driveHealthMonitor) report() []madmin.DriveHealth {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	drives := make([]madmin.DriveHealth, 0, len(m.drives))
+	for _, h := range m.drives {
+		drives = append(drives, h)
+	}
+	sort.Slice(drives, func(i, j int) bool { return drives[i].Endpoint < drives[j].Endpoint })
+	return drives
+}
+
+// getLocalDrivesHealth returns the health of the drives of this server.
+func getLocalDrivesHealth() madmin.ServerDrivesHealth {
+	return madmin.ServerDrivesHealth{
+		Addr:   GetLocalPeer(globalEndpoints),
+		Drives: globalDriveHealthMonitor.report(),
+	}
+}
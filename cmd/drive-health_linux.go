@@ -0,0 +1,59 @@
+// +build linux
+
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/minio/minio/pkg/smart"
+	diskhw "github.com/shirou/gopsutil/disk"
+)
+
+// getDriveSmartInfo returns the device the drive path is mounted
+// from along with its SMART data.
+func getDriveSmartInfo(ctx context.Context, drivePath string) (string, smart.Info) {
+	parts, err := diskhw.PartitionsWithContext(ctx, true)
+	if err != nil {
+		return "", smart.Info{Error: fmt.Sprintf("partitions: %v", err)}
+	}
+
+	// Pick the partition with the longest mount point containing
+	// the drive path.
+	var device, mountpoint string
+	for _, part := range parts {
+		if !strings.HasPrefix(part.Device, "/dev/") || len(part.Mountpoint) <= len(mountpoint) {
+			continue
+		}
+		if drivePath == part.Mountpoint || strings.HasPrefix(drivePath, strings.TrimSuffix(part.Mountpoint, "/")+"/") {
+			device, mountpoint = part.Device, part.Mountpoint
+		}
+	}
+	if device == "" {
+		return "", smart.Info{Error: fmt.Sprintf("smart: no device found for %s", drivePath)}
+	}
+
+	info, err := smart.GetInfo(device)
+	if err != nil {
+		info.Error = fmt.Sprintf("smart: %v", err)
+	}
+	return device, info
+}
@@ -0,0 +1,90 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package cmd
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/minio/minio/pkg/madmin"
+	"github.com/minio/minio/pkg/smart"
+)
+
+func TestDiskIOStats(t *testing.T) {
+	var s diskIOStats
+	if l := s.latency(); l != (madmin.DriveLatency{}) {
+		t.Fatalf("expected no latency without samples, got %v", l)
+	}
+
+	for i := 1; i <= 100; i++ {
+		s.observe(false, time.Duration(i)*time.Millisecond, nil)
+	}
+	l := s.latency()
+	if l.P50 != 50*time.Millisecond || l.P90 != 90*time.Millisecond || l.P99 != 99*time.Millisecond {
+		t.Fatalf("unexpected latency percentiles %v", l)
+	}
+
+	// Only the most recent samples are kept.
+	for i := 0; i < driveLatencySamples; i++ {
+		s.observe(true, time.Second, nil)
+	}
+	if l = s.latency(); l.P50 != time.Second {
+		t.Fatalf("expected older samples to be dropped, got %v", l)
+	}
+
+	s.observe(false, 0, errFaultyDisk)
+	s.observe(false, 0, errFileNotFound)
+	s.observe(true, 0, errFaultyDisk)
+	s.observe(true, 0, errFileCorrupt)
+	if readErrs, writeErrs := s.errCounts(); readErrs != 1 || writeErrs != 2 {
+		t.Fatalf("expected 1 read and 2 write errors, got %d and %d", readErrs, writeErrs)
+	}
+}
+
+func TestEvalDriveHealth(t *testing.T) {
+	testCases := []struct {
+		health   madmin.DriveHealth
+		prevErrs uint64
+		expected string
+	}{
+		{madmin.DriveHealth{}, 0, madmin.DriveHealthOK},
+		{madmin.DriveHealth{ReadErrors: 5, WriteErrors: 4}, 0, madmin.DriveHealthOK},
+		{madmin.DriveHealth{ReadErrors: 6, WriteErrors: 4}, 0, madmin.DriveHealthFailingSoon},
+		{madmin.DriveHealth{ReadErrors: 20}, 15, madmin.DriveHealthOK},
+		// Counters restarted after the drive was reconnected.
+		{madmin.DriveHealth{ReadErrors: 3}, 15, madmin.DriveHealthOK},
+		{madmin.DriveHealth{Latency: madmin.DriveLatency{P99: driveLatencyThreshold}}, 0, madmin.DriveHealthFailingSoon},
+		{madmin.DriveHealth{SmartInfo: smart.Info{Nvme: &smart.NvmeInfo{CriticalWarning: "0"}}}, 0, madmin.DriveHealthOK},
+		{madmin.DriveHealth{SmartInfo: smart.Info{Nvme: &smart.NvmeInfo{CriticalWarning: "4"}}}, 0, madmin.DriveHealthFailingSoon},
+		{madmin.DriveHealth{SmartInfo: smart.Info{Nvme: &smart.NvmeInfo{SpareAvailable: "5%", SpareThreshold: "10%"}}}, 0, madmin.DriveHealthFailingSoon},
+		{madmin.DriveHealth{SmartInfo: smart.Info{Nvme: &smart.NvmeInfo{SpareAvailable: "100%", SpareThreshold: "10%"}}}, 0, madmin.DriveHealthOK},
+		{madmin.DriveHealth{SmartInfo: smart.Info{Nvme: &smart.NvmeInfo{MediaAndDataIntegrityErrors: big.NewInt(1)}}}, 0, madmin.DriveHealthFailingSoon},
+	}
+
+	for i, testCase := range testCases {
+		h := testCase.health
+		evalDriveHealth(&h, testCase.prevErrs)
+		if h.State != testCase.expected {
+			t.Errorf("Test %d: expected %s, got %s (%v)", i+1, testCase.expected, h.State, h.Reasons)
+		}
+		if (h.State == madmin.DriveHealthFailingSoon) != (len(h.Reasons) > 0) {
+			t.Errorf("Test %d: unexpected reasons %v for state %s", i+1, h.Reasons, h.State)
+		}
+	}
+}
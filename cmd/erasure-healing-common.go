@@ -229,6 +229,8 @@ func disksWithAllParts(ctx context.Context, onlineDisks []StorageAPI, partsMetad
 			dataErrs[i] = onlineDisk.VerifyFile(ctx, bucket, object, partsMetadata[i])
 		case madmin.HealNormalScan:
 			dataErrs[i] = onlineDisk.CheckParts(ctx, bucket, object, partsMetadata[i])
+		case madmin.HealMetadataScan:
+			// Only metadata is reconciled, data parts are not checked.
 		}
 
 		if dataErrs[i] == nil {
@@ -0,0 +1,133 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	miniogo "github.com/minio/minio-go/v7"
+	"github.com/minio/minio/cmd/crypto"
+	xhttp "github.com/minio/minio/cmd/http"
+	"github.com/minio/minio/pkg/bucket/replication"
+	"github.com/minio/minio/pkg/hash"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+var (
+	errHealRemoteOffline   = errors.New("replication target is offline or not configured")
+	errHealRemoteEncrypted = errors.New("encrypted objects cannot be healed from the replication target")
+	errHealRemoteMismatch  = errors.New("replication target holds a different copy of the object")
+)
+
+// healObjectFromRemote rewrites an object version which lost read
+// quorum locally with the copy held by the bucket replication target.
+// lfi holds the local metadata of the version, if any was found, errs
+// the errors of reading it from each drive.
+func (er erasureObjects) healObjectFromRemote(ctx context.Context, bucket, object, versionID string,
+	lfi FileInfo, errs []error) (madmin.HealResultItem, error) {
+	if _, ok := crypto.IsEncrypted(lfi.Metadata); ok {
+		return madmin.HealResultItem{}, errHealRemoteEncrypted
+	}
+
+	cfg, err := getReplicationConfig(ctx, bucket)
+	if err != nil {
+		return madmin.HealResultItem{}, err
+	}
+	if !cfg.Replicate(replication.ObjectOpts{Name: object}) {
+		return madmin.HealResultItem{}, errHealRemoteOffline
+	}
+	tgt := globalBucketTargetSys.GetRemoteTargetClient(ctx, cfg.RoleArn)
+	if tgt == nil || tgt.isOffline() {
+		return madmin.HealResultItem{}, errHealRemoteOffline
+	}
+
+	gopts := miniogo.GetObjectOptions{
+		VersionID: versionID,
+		Internal: miniogo.AdvancedGetOptions{
+			// Never let an active-active target proxy the request back.
+			ReplicationProxyRequest: "true",
+		},
+	}
+	c := miniogo.Core{Client: tgt.Client}
+	reader, oi, _, err := c.GetObject(ctx, cfg.GetDestination().Bucket, object, gopts)
+	if err != nil {
+		return madmin.HealResultItem{}, err
+	}
+	defer reader.Close()
+
+	if etag := lfi.Metadata["etag"]; etag != "" && etag != oi.ETag {
+		return madmin.HealResultItem{}, errHealRemoteMismatch
+	}
+
+	metadata := healRemoteMetadata(lfi, oi)
+	hr, err := hash.NewReader(reader, oi.Size, "", "", oi.Size)
+	if err != nil {
+		return madmin.HealResultItem{}, err
+	}
+
+	modTime := lfi.ModTime
+	if modTime.IsZero() {
+		modTime = oi.LastModified
+	}
+	opts := ObjectOptions{
+		VersionID:   versionID,
+		Versioned:   versionID != "" && versionID != nullVersionID,
+		UserDefined: metadata,
+		MTime:       modTime,
+	}
+	if _, err = er.putObject(ctx, bucket, object, NewPutObjReader(hr), opts); err != nil {
+		return madmin.HealResultItem{}, err
+	}
+
+	result := defaultHealResult(lfi, er.getDisks(), er.getEndpoints(), errs, bucket, object, versionID, er.defaultParityCount)
+	result.ObjectSize = oi.Size
+	for i := range result.After.Drives {
+		if result.After.Drives[i].State != madmin.DriveStateOffline {
+			result.After.Drives[i].State = madmin.DriveStateOk
+		}
+	}
+	return result, nil
+}
+
+// healRemoteMetadata returns the metadata of an object version rewritten
+// from the replication target, the user metadata and standard headers of
+// the target copy along with the tags and replication status known locally.
+func healRemoteMetadata(lfi FileInfo, oi miniogo.ObjectInfo) map[string]string {
+	metadata := make(map[string]string, len(oi.Metadata))
+	for k, v := range oi.Metadata {
+		if len(v) == 0 {
+			continue
+		}
+		if isStandardHeader(k) || strings.HasPrefix(strings.ToLower(k), "x-amz-meta-") {
+			metadata[k] = v[0]
+		}
+	}
+	// The target's own encryption and replica status do not apply locally.
+	delete(metadata, xhttp.AmzServerSideEncryption)
+	delete(metadata, xhttp.AmzTagCount)
+
+	metadata[xhttp.AmzBucketReplicationStatus] = replication.Completed.String()
+	for _, k := range []string{xhttp.AmzObjectTagging, xhttp.AmzBucketReplicationStatus} {
+		if v, ok := lfi.Metadata[k]; ok {
+			metadata[k] = v
+		}
+	}
+	metadata["etag"] = oi.ETag
+	return metadata
+}
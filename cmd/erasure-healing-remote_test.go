@@ -0,0 +1,61 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"net/http"
+	"testing"
+
+	miniogo "github.com/minio/minio-go/v7"
+	xhttp "github.com/minio/minio/cmd/http"
+)
+
+func TestHealRemoteMetadata(t *testing.T) {
+	oi := miniogo.ObjectInfo{
+		ETag: "d41d8cd98f00b204e9800998ecf8427e",
+		Metadata: http.Header{
+			xhttp.ContentType:                {"text/plain"},
+			"X-Amz-Meta-Owner":               {"finance"},
+			xhttp.AmzBucketReplicationStatus: {"REPLICA"},
+			xhttp.AmzServerSideEncryption:    {"AES256"},
+			xhttp.AmzTagCount:                {"1"},
+			xhttp.Date:                       {"Mon, 01 Mar 2021 00:00:00 GMT"},
+		},
+	}
+	lfi := FileInfo{
+		Metadata: map[string]string{
+			xhttp.AmzObjectTagging: "team=finance",
+		},
+	}
+
+	metadata := healRemoteMetadata(lfi, oi)
+	expected := map[string]string{
+		xhttp.ContentType:                "text/plain",
+		"X-Amz-Meta-Owner":               "finance",
+		xhttp.AmzBucketReplicationStatus: "COMPLETED",
+		xhttp.AmzObjectTagging:           "team=finance",
+		"etag":                           oi.ETag,
+	}
+	if len(metadata) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, metadata)
+	}
+	for k, v := range expected {
+		if metadata[k] != v {
+			t.Fatalf("expected %s=%s, got %v", k, v, metadata)
+		}
+	}
+}
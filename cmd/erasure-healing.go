@@ -251,6 +251,7 @@ func (er erasureObjects) healObject(ctx context.Context, bucket string, object s
 		Type:         madmin.HealItemObject,
 		Bucket:       bucket,
 		Object:       object,
+		VersionID:    versionID,
 		DiskCount:    len(storageDisks),
 		ParityBlocks: er.defaultParityCount,
 		DataBlocks:   len(storageDisks) - er.defaultParityCount,
@@ -334,8 +335,9 @@ func (er erasureObjects) healObject(ctx context.Context, bucket string, object s
 	}
 
 	// After this point, only have to repair data on disk - so
-	// return if it is a dry-run
-	if dryRun {
+	// return if it is a dry-run or only dangling objects are
+	// looked for.
+	if dryRun || opts.Dangling {
 		return result, nil
 	}
 
@@ -347,6 +349,11 @@ func (er erasureObjects) healObject(ctx context.Context, bucket string, object s
 	}
 	defer ObjectPathUpdated(pathJoin(bucket, object))
 
+	if scanMode == madmin.HealMetadataScan {
+		er.healObjectMetadata(ctx, bucket, object, latestMeta, outDatedDisks, &result)
+		return result, nil
+	}
+
 	cleanFileInfo := func(fi FileInfo) FileInfo {
 		// Returns a copy of the 'fi' with checksums and parts nil'ed.
 		nfi := fi
@@ -489,6 +496,50 @@ func (er erasureObjects) healObject(ctx context.Context, bucket string, object s
 	return result, nil
 }
 
+// healObjectMetadata rewrites the latest metadata of the object on the
+// outdated disks which already hold all the data parts, data parts are
+// never rewritten. Disks missing data parts are left for a regular heal.
+func (er erasureObjects) healObjectMetadata(ctx context.Context, bucket, object string,
+	latestMeta FileInfo, outDatedDisks []StorageAPI, result *madmin.HealResultItem) {
+	// Legacy objects need their data migrated, and whole file
+	// bitrot checksums are specific to each disk.
+	if latestMeta.XLV1 {
+		return
+	}
+	for _, checksum := range latestMeta.Erasure.Checksums {
+		if checksum.Algorithm != HighwayHash256S {
+			return
+		}
+	}
+
+	for i, disk := range outDatedDisks {
+		if disk == nil {
+			continue
+		}
+		fi := latestMeta
+		if len(fi.Erasure.Distribution) == len(outDatedDisks) {
+			fi.Erasure.Index = fi.Erasure.Distribution[i]
+		}
+		if !fi.Deleted && len(fi.Parts) > 0 {
+			if err := disk.CheckParts(ctx, bucket, object, fi); err != nil {
+				continue
+			}
+		}
+		if err := disk.WriteMetadata(ctx, bucket, object, fi); err != nil {
+			logger.LogIf(ctx, err)
+			continue
+		}
+		for j, v := range result.Before.Drives {
+			if v.Endpoint == disk.String() {
+				result.After.Drives[j].State = madmin.DriveStateOk
+			}
+		}
+	}
+
+	// Set the size of the object in the heal result
+	result.ObjectSize = latestMeta.Size
+}
+
 // healObjectDir - heals object directory specifically, this special call
 // is needed since we do not have a special backend format for directories.
 func (er erasureObjects) healObjectDir(ctx context.Context, bucket, object string, dryRun bool, remove bool) (hr madmin.HealResultItem, err error) {
@@ -689,6 +740,10 @@ func isObjectDirDangling(errs []error) (ok bool) {
 	return found < notFound && found > 0
 }
 
+// healDetailDanglingPurged is the detail of the heal result of a
+// dangling object which was purged.
+const healDetailDanglingPurged = "dangling object purged"
+
 func (er erasureObjects) purgeObjectDangling(ctx context.Context, bucket, object, versionID string,
 	metaArr []FileInfo, errs []error, dataErrs []error, opts madmin.HealOpts) (madmin.HealResultItem, error) {
 
@@ -698,14 +753,26 @@ func (er erasureObjects) purgeObjectDangling(ctx context.Context, bucket, object
 	// Check if the object is dangling, if yes and user requested
 	// remove we simply delete it from namespace.
 	m, ok := isObjectDangling(metaArr, errs, dataErrs)
+	if ok && opts.Remote && !opts.DryRun {
+		// Rewrite the object from the replication target, only
+		// purge it if the target holds no copy either.
+		result, err := er.healObjectFromRemote(ctx, bucket, object, versionID, m, errs)
+		if err == nil {
+			return result, nil
+		}
+		if _, notFound := err.(BucketReplicationConfigNotFound); !notFound {
+			logger.LogIf(ctx, err)
+		}
+	}
 	if ok {
+		kind := healDanglingKind(m, errs)
 		writeQuorum := m.Erasure.DataBlocks
 		if m.Erasure.DataBlocks == 0 || m.Erasure.DataBlocks == m.Erasure.ParityBlocks {
 			writeQuorum++
 		}
 		var err error
 		var returnNotFound bool
-		if !opts.DryRun && opts.Remove {
+		if !opts.DryRun && (opts.Remove || opts.PurgeDangling) {
 			if versionID == "" {
 				err = er.deleteObject(ctx, bucket, object, writeQuorum)
 			} else {
@@ -733,9 +800,14 @@ func (er erasureObjects) purgeObjectDangling(ctx context.Context, bucket, object
 			if versionID != "" {
 				err = toObjectErr(errFileVersionNotFound, bucket, object, versionID)
 			}
-			return defaultHealResult(m, storageDisks, storageEndpoints, errs, bucket, object, versionID, er.defaultParityCount), err
+			result := defaultHealResult(m, storageDisks, storageEndpoints, errs, bucket, object, versionID, er.defaultParityCount)
+			result.Detail = healDetailDanglingPurged
+			result.Dangling = kind
+			return result, err
 		}
-		return defaultHealResult(m, storageDisks, storageEndpoints, errs, bucket, object, versionID, er.defaultParityCount), toObjectErr(err, bucket, object, versionID)
+		result := defaultHealResult(m, storageDisks, storageEndpoints, errs, bucket, object, versionID, er.defaultParityCount)
+		result.Dangling = kind
+		return result, toObjectErr(err, bucket, object, versionID)
 	}
 
 	readQuorum := len(storageDisks) - er.defaultParityCount
@@ -814,7 +886,7 @@ func (er erasureObjects) HealObject(ctx context.Context, bucket, object, version
 
 	// Healing directories handle it separately.
 	if HasSuffix(object, SlashSeparator) {
-		return er.healObjectDir(healCtx, bucket, object, opts.DryRun, opts.Remove)
+		return er.healObjectDir(healCtx, bucket, object, opts.DryRun || opts.Dangling, opts.Remove || opts.PurgeDangling)
 	}
 
 	storageDisks := er.getDisks()
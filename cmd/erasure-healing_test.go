@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/rand"
+	"fmt"
 	"os"
 	"path"
 	"reflect"
@@ -297,6 +298,87 @@ func TestHealObjectCorrupted(t *testing.T) {
 }
 
 // Tests healing of object.
+// Tests healing of object metadata only.
+func TestHealObjectMetadataScan(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resetGlobalHealState()
+	defer resetGlobalHealState()
+
+	nDisks := 16
+	fsDirs, err := getRandomDisks(nDisks)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer removeRoots(fsDirs)
+
+	objLayer, _, err := initObjectLayer(ctx, mustGetPoolEndpoints(fsDirs...))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bucket := "bucket"
+	object := "object"
+	data := bytes.Repeat([]byte("a"), 1024*1024)
+
+	err = objLayer.MakeBucketWithLocation(ctx, bucket, BucketOptions{})
+	if err != nil {
+		t.Fatalf("Failed to make a bucket - %v", err)
+	}
+
+	_, err = objLayer.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{})
+	if err != nil {
+		t.Fatalf("Failed to put an object - %v", err)
+	}
+
+	z := objLayer.(*erasureServerPools)
+	er := z.serverPools[0].sets[0]
+	erasureDisks := er.getDisks()
+
+	fileInfos, errs := readAllFileInfo(ctx, erasureDisks, bucket, object, "", false)
+	fi, err := getLatestFileInfo(ctx, fileInfos, errs)
+	if err != nil {
+		t.Fatalf("Failed to getLatestFileInfo - %v", err)
+	}
+
+	// Remove only the metadata from the first disk, and
+	// both the metadata and the data from the second disk.
+	firstDisk, secondDisk := erasureDisks[0], erasureDisks[1]
+	if err = firstDisk.Delete(ctx, bucket, pathJoin(object, xlStorageFormatFile), false); err != nil {
+		t.Fatalf("Failed to delete a file - %v", err)
+	}
+	if err = secondDisk.Delete(ctx, bucket, pathJoin(object, xlStorageFormatFile), false); err != nil {
+		t.Fatalf("Failed to delete a file - %v", err)
+	}
+	if err = secondDisk.Delete(ctx, bucket, pathJoin(object, fi.DataDir, "part.1"), false); err != nil {
+		t.Fatalf("Failed to delete a file - %v", err)
+	}
+
+	_, err = objLayer.HealObject(ctx, bucket, object, "", madmin.HealOpts{ScanMode: madmin.HealMetadataScan})
+	if err != nil {
+		t.Fatalf("Failed to heal object - %v", err)
+	}
+
+	// Metadata is restored on the disk which holds the data.
+	nfi, err := firstDisk.ReadVersion(ctx, bucket, object, "", false)
+	if err != nil {
+		t.Fatalf("Expected xl.meta to be healed - %v", err)
+	}
+	if nfi.Erasure.Index != fileInfos[0].Erasure.Index {
+		t.Fatalf("Expected erasure index %d, got %d", fileInfos[0].Erasure.Index, nfi.Erasure.Index)
+	}
+
+	// Data is never rewritten.
+	if _, err = secondDisk.ReadVersion(ctx, bucket, object, "", false); err != errFileNotFound {
+		t.Fatalf("Expected xl.meta to be missing, got %v", err)
+	}
+	if err = secondDisk.CheckParts(ctx, bucket, object, fi); err == nil {
+		t.Fatal("Expected data parts to be missing")
+	}
+}
+
 func TestHealObjectErasure(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -467,3 +549,67 @@ func TestHealEmptyDirectoryErasure(t *testing.T) {
 		}
 	}
 }
+
+// Tests that healing objects only walks the targeted erasure set.
+func TestHealObjectsTargetSet(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	objLayer, fsDirs, err := prepareErasureSets32(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer removeRoots(fsDirs)
+
+	z := objLayer.(*erasureServerPools)
+	if len(z.serverPools[0].sets) < 2 {
+		t.Skip("expected at least 2 erasure sets")
+	}
+
+	bucket := "bucket"
+	if err = objLayer.MakeBucketWithLocation(ctx, bucket, BucketOptions{}); err != nil {
+		t.Fatalf("Failed to make a bucket - %v", err)
+	}
+	for i := 0; i < 10; i++ {
+		object := fmt.Sprintf("object-%d", i)
+		_, err = objLayer.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader([]byte("a")), 1, "", ""), ObjectOptions{})
+		if err != nil {
+			t.Fatalf("Failed to put an object - %v", err)
+		}
+	}
+
+	setIndex := 1
+	opts := madmin.HealOpts{ScanMode: madmin.HealDeepScan, Set: &setIndex}
+	var healed int
+	err = objLayer.HealObjects(ctx, bucket, "", opts, func(bucket, object, versionID string) error {
+		if idx := z.serverPools[0].getHashedSetIndex(object); idx != setIndex {
+			t.Errorf("Object %s of erasure set %d healed", object, idx)
+		}
+		healed++
+		return nil
+	})
+	if err != nil && !isErrObjectNotFound(err) {
+		t.Fatal(err)
+	}
+	var expected int
+	for i := 0; i < 10; i++ {
+		if z.serverPools[0].getHashedSetIndex(fmt.Sprintf("object-%d", i)) == setIndex {
+			expected++
+		}
+	}
+	if healed != expected {
+		t.Errorf("Expected %d objects healed, got %d", expected, healed)
+	}
+
+	// Targeting an erasure set which does not exist is rejected.
+	if errCode := validateHealTarget(objLayer, opts); errCode != ErrNone {
+		t.Errorf("Expected a valid heal target, got %v", errCode)
+	}
+	missing := len(z.serverPools[0].sets)
+	if errCode := validateHealTarget(objLayer, madmin.HealOpts{Set: &missing}); errCode != ErrInvalidRequest {
+		t.Errorf("Expected an invalid heal target, got %v", errCode)
+	}
+	if errCode := validateHealTarget(objLayer, madmin.HealOpts{Pool: &setIndex}); errCode != ErrInvalidRequest {
+		t.Errorf("Expected an invalid heal target, got %v", errCode)
+	}
+}
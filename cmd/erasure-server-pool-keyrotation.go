@@ -0,0 +1,407 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/minio/minio/cmd/crypto"
+	xhttp "github.com/minio/minio/cmd/http"
+	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+const keyRotationMetaName = "keyrotation.json"
+
+var (
+	errKeyRotationStarted      = persistedJobStartedError("KeyRotation", "key rotation of encrypted objects")
+	errKeyRotationNotStarted   = persistedJobNotStartedError("KeyRotation", "key rotation of encrypted objects")
+	errKeyRotationNotResumable = AdminError{
+		Code:       "XMinioKeyRotationNotResumable",
+		Message:    "There is no stopped or failed key rotation to resume",
+		StatusCode: http.StatusBadRequest,
+	}
+)
+
+// keyRotationMeta is the state of a key rotation, saved to the backend
+// so that its progress can be read and stopped from any server, and a
+// stopped key rotation resumed from the last saved listing position.
+type keyRotationMeta struct {
+	persistedJobMeta
+	Bucket          string `json:"bucket"`
+	Prefix          string `json:"prefix,omitempty"`
+	KeyID           string `json:"keyId"`
+	Status          string `json:"status"`
+	KeyMarker       string `json:"keyMarker,omitempty"`
+	VersionIDMarker string `json:"versionIdMarker,omitempty"`
+	Scanned         uint64 `json:"scanned"`
+	Rotated         uint64 `json:"rotated"`
+	Skipped         uint64 `json:"skipped"`
+	Failed          uint64 `json:"failed"`
+	Object          string `json:"object,omitempty"`
+	Error           string `json:"error,omitempty"`
+}
+
+// running returns true if the key rotation is still in progress.
+func (m keyRotationMeta) running(now time.Time) bool {
+	return m.Status == madmin.KeyRotationStarted && !m.stale(now)
+}
+
+func (z *erasureServerPools) loadKeyRotationMeta(ctx context.Context) (keyRotationMeta, error) {
+	var meta keyRotationMeta
+	err := loadPersistedJobMeta(ctx, z, keyRotationMetaName, &meta)
+	return meta, err
+}
+
+func (z *erasureServerPools) saveKeyRotationMeta(ctx context.Context, meta keyRotationMeta) error {
+	return savePersistedJobMeta(ctx, z, keyRotationMetaName, &meta)
+}
+
+// keyRotationJob returns the persisted job of the key rotation running
+// on this server.
+func (z *erasureServerPools) keyRotationJob() persistedJob {
+	return persistedJob{
+		objAPI:     z,
+		configFile: keyRotationMetaName,
+		status: func() (persistedJobState, bool) {
+			meta, ok := z.keyRotationStatus()
+			return &meta, ok
+		},
+	}
+}
+
+// keyRotationStatus returns a copy of the key rotation running on this
+// server, false if there is none.
+func (z *erasureServerPools) keyRotationStatus() (keyRotationMeta, bool) {
+	z.rotateMu.Lock()
+	defer z.rotateMu.Unlock()
+	if z.rotateMeta == nil {
+		return keyRotationMeta{}, false
+	}
+	return *z.rotateMeta, true
+}
+
+// startKeyRotation starts re-sealing the object keys of the SSE-S3
+// encrypted object versions of the bucket under prefix with the KMS
+// default master key, it returns the key rotation id.
+func (z *erasureServerPools) startKeyRotation(ctx context.Context, bucket, prefix string) (string, error) {
+	if GlobalKMS == nil {
+		return "", errKMSNotConfigured
+	}
+	if _, err := z.GetBucketInfo(ctx, bucket); err != nil {
+		return "", err
+	}
+
+	z.rotateMu.Lock()
+	defer z.rotateMu.Unlock()
+
+	if z.rotateCancel != nil {
+		return "", errKeyRotationStarted
+	}
+	var meta keyRotationMeta
+	err := startPersistedJob(ctx, z, keyRotationMetaName, func() error {
+		var err error
+		meta, err = z.loadKeyRotationMeta(ctx)
+		if err != nil && !errors.Is(err, errConfigNotFound) {
+			return err
+		}
+		if err == nil && meta.running(UTCNow()) {
+			return errKeyRotationStarted
+		}
+
+		meta = keyRotationMeta{
+			persistedJobMeta: newPersistedJobMeta(),
+			Bucket:           bucket,
+			Prefix:           prefix,
+			KeyID:            GlobalKMS.DefaultKeyID(),
+			Status:           madmin.KeyRotationStarted,
+		}
+		return z.saveKeyRotationMeta(ctx, meta)
+	})
+	if err != nil {
+		return "", err
+	}
+	z.runKeyRotation(meta)
+	return meta.ID, nil
+}
+
+// resumeKeyRotation resumes the last stopped or failed key rotation
+// from its last saved listing position, it returns the key rotation id.
+func (z *erasureServerPools) resumeKeyRotation(ctx context.Context) (string, error) {
+	if GlobalKMS == nil {
+		return "", errKMSNotConfigured
+	}
+
+	z.rotateMu.Lock()
+	defer z.rotateMu.Unlock()
+
+	if z.rotateCancel != nil {
+		return "", errKeyRotationStarted
+	}
+	var meta keyRotationMeta
+	err := startPersistedJob(ctx, z, keyRotationMetaName, func() error {
+		var err error
+		meta, err = z.loadKeyRotationMeta(ctx)
+		if err != nil {
+			if errors.Is(err, errConfigNotFound) {
+				return errKeyRotationNotResumable
+			}
+			return err
+		}
+		if meta.running(UTCNow()) {
+			return errKeyRotationStarted
+		}
+		if meta.Status == madmin.KeyRotationCompleted {
+			return errKeyRotationNotResumable
+		}
+
+		meta.KeyID = GlobalKMS.DefaultKeyID()
+		meta.Status = madmin.KeyRotationStarted
+		meta.StoppedAt = time.Time{}
+		meta.LastUpdate = UTCNow()
+		meta.Error = ""
+		return z.saveKeyRotationMeta(ctx, meta)
+	})
+	if err != nil {
+		return "", err
+	}
+	z.runKeyRotation(meta)
+	return meta.ID, nil
+}
+
+// runKeyRotation runs the key rotation in the background, the caller
+// must hold rotateMu.
+func (z *erasureServerPools) runKeyRotation(meta keyRotationMeta) {
+	ctx, cancel := context.WithCancel(GlobalContext)
+	z.rotateMeta = &meta
+	z.rotateCancel = cancel
+	z.keyRotationJob().run(ctx, func(ctx context.Context) error {
+		return z.rotateBucketKeys(ctx, meta)
+	}, func(err error) {
+		z.updateKeyRotation(func(m *keyRotationMeta) {
+			m.StoppedAt = UTCNow()
+			switch {
+			case err == nil:
+				m.Status = madmin.KeyRotationCompleted
+			case errors.Is(err, context.Canceled):
+				m.Status = madmin.KeyRotationStopped
+			default:
+				m.Status = madmin.KeyRotationFailed
+				m.Error = err.Error()
+			}
+		})
+	}, func() {
+		z.rotateMu.Lock()
+		z.rotateCancel()
+		z.rotateCancel = nil
+		z.rotateMeta = nil
+		z.rotateMu.Unlock()
+	})
+}
+
+// stopKeyRotation stops the key rotation running on this server.
+func (z *erasureServerPools) stopKeyRotation() {
+	z.rotateMu.Lock()
+	defer z.rotateMu.Unlock()
+	if z.rotateCancel != nil {
+		z.rotateCancel()
+	}
+}
+
+// markKeyRotationStopped records that the current key rotation was
+// stopped.
+func (z *erasureServerPools) markKeyRotationStopped(ctx context.Context) error {
+	meta, err := z.loadKeyRotationMeta(ctx)
+	if err != nil {
+		if errors.Is(err, errConfigNotFound) {
+			return errKeyRotationNotStarted
+		}
+		return err
+	}
+	switch meta.Status {
+	case madmin.KeyRotationStopped:
+		return nil
+	case madmin.KeyRotationStarted:
+	default:
+		return errKeyRotationNotStarted
+	}
+	meta.Status = madmin.KeyRotationStopped
+	meta.StoppedAt = UTCNow()
+	return z.saveKeyRotationMeta(ctx, meta)
+}
+
+// updateKeyRotation updates the progress of the key rotation running
+// on this server.
+func (z *erasureServerPools) updateKeyRotation(update func(*keyRotationMeta)) {
+	z.rotateMu.Lock()
+	defer z.rotateMu.Unlock()
+	if z.rotateMeta != nil {
+		update(z.rotateMeta)
+	}
+}
+
+// rotateBucketKeys lists the object versions of the key rotation from
+// its listing position and rotates their keys, the listing position
+// is advanced once all versions of a listed page are done.
+func (z *erasureServerPools) rotateBucketKeys(ctx context.Context, meta keyRotationMeta) error {
+	keyMarker, versionIDMarker := meta.KeyMarker, meta.VersionIDMarker
+	for {
+		res, err := z.ListObjectVersions(ctx, meta.Bucket, meta.Prefix, keyMarker, versionIDMarker, "", maxObjectList)
+		if err != nil {
+			return err
+		}
+		for _, obj := range res.Objects {
+			// Keys are rotated at the rate of background healing.
+			cfg := currentHealConfig()
+			waitForHealLoad(ctx, cfg)
+			if globalBackgroundHealState != nil {
+				if err = globalBackgroundHealState.throttle.wait(ctx, cfg.MaxIOPS); err != nil {
+					return err
+				}
+			}
+			if err = ctx.Err(); err != nil {
+				return err
+			}
+
+			rotated, err := z.rotateObjectKey(ctx, meta.Bucket, obj.Name, obj.VersionID)
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				if isErrObjectNotFound(err) || isErrVersionNotFound(err) {
+					err = nil
+				} else {
+					logger.LogIf(ctx, fmt.Errorf("unable to rotate the key of %s/%s (%s): %w", meta.Bucket, obj.Name, obj.VersionID, err))
+				}
+			}
+			z.updateKeyRotation(func(m *keyRotationMeta) {
+				m.Scanned++
+				m.Object = obj.Name
+				switch {
+				case err != nil:
+					m.Failed++
+				case rotated:
+					m.Rotated++
+				default:
+					m.Skipped++
+				}
+			})
+		}
+		if !res.IsTruncated {
+			return nil
+		}
+		keyMarker, versionIDMarker = res.NextMarker, res.NextVersionIDMarker
+		z.updateKeyRotation(func(m *keyRotationMeta) {
+			m.KeyMarker, m.VersionIDMarker = keyMarker, versionIDMarker
+		})
+	}
+}
+
+// rotateObjectKey re-seals the object key of an SSE-S3 encrypted object
+// version with a new data key of the KMS default master key, only the
+// object metadata is rewritten. It returns false if the version is not
+// SSE-S3 encrypted.
+func (z *erasureServerPools) rotateObjectKey(ctx context.Context, bucket, object, versionID string) (bool, error) {
+	encObject := encodeDirObject(object)
+	poolIdx, err := z.getPoolIdx(ctx, bucket, encObject, 0)
+	if err != nil {
+		return false, err
+	}
+	set := z.serverPools[poolIdx].getHashedSet(encObject)
+
+	// Hold the write lock taken by writers of the object until the
+	// metadata is rewritten, the new sealed key must not be applied
+	// to an object overwritten since its metadata was read.
+	lk := set.NewNSLock(bucket, encObject)
+	if err = lk.GetLock(ctx, globalOperationTimeout); err != nil {
+		return false, err
+	}
+	defer lk.Unlock()
+
+	opts := ObjectOptions{VersionID: versionID, NoLock: true}
+	oi, err := set.GetObjectInfo(ctx, bucket, encObject, opts)
+	if err != nil {
+		return false, err
+	}
+	if oi.DeleteMarker || !crypto.S3.IsEncrypted(oi.UserDefined) {
+		return false, nil
+	}
+
+	metadata := make(map[string]string, len(oi.UserDefined)+2)
+	for k, v := range oi.UserDefined {
+		metadata[k] = v
+	}
+	// Restore the entries removed from the user defined metadata.
+	if oi.UserTags != "" {
+		metadata[xhttp.AmzObjectTagging] = oi.UserTags
+	}
+	if !oi.Expires.IsZero() {
+		metadata["expires"] = oi.Expires.UTC().Format(http.TimeFormat)
+	}
+	if err = rotateKey(nil, nil, bucket, object, metadata); err != nil {
+		return false, err
+	}
+
+	oi.UserDefined = metadata
+	oi.metadataOnly = true
+	oi.keyRotation = true
+	if _, err = set.CopyObject(ctx, bucket, encObject, bucket, encObject, oi, opts, opts); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// getKeyRotationStatus returns the status of the current or last key
+// rotation.
+func (z *erasureServerPools) getKeyRotationStatus(ctx context.Context) (madmin.KeyRotationStatus, error) {
+	meta, ok := z.keyRotationStatus()
+	if !ok {
+		var err error
+		if meta, err = z.loadKeyRotationMeta(ctx); err != nil {
+			if errors.Is(err, errConfigNotFound) {
+				return madmin.KeyRotationStatus{}, errKeyRotationNotStarted
+			}
+			return madmin.KeyRotationStatus{}, err
+		}
+	}
+
+	status := madmin.KeyRotationStatus{
+		ID:         meta.ID,
+		Bucket:     meta.Bucket,
+		Prefix:     meta.Prefix,
+		KeyID:      meta.KeyID,
+		Status:     meta.Status,
+		StartedAt:  meta.StartedAt,
+		StoppedAt:  meta.StoppedAt,
+		LastUpdate: meta.LastUpdate,
+		Scanned:    meta.Scanned,
+		Rotated:    meta.Rotated,
+		Skipped:    meta.Skipped,
+		Failed:     meta.Failed,
+		Object:     meta.Object,
+		Error:      meta.Error,
+	}
+	// A key rotation whose server went away is reported as stopped.
+	if !ok && meta.Status == madmin.KeyRotationStarted && !meta.running(UTCNow()) {
+		status.Status = madmin.KeyRotationStopped
+	}
+	return status, nil
+}
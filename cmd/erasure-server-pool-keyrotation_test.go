@@ -0,0 +1,164 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/minio/minio/cmd/crypto"
+	xhttp "github.com/minio/minio/cmd/http"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestKeyRotationMetaRunning(t *testing.T) {
+	now := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	meta := keyRotationMeta{persistedJobMeta: persistedJobMeta{LastUpdate: now}, Status: madmin.KeyRotationStarted}
+	if !meta.running(now) {
+		t.Errorf("expected key rotation to be running")
+	}
+	if meta.running(now.Add(2 * persistedJobStaleAfter)) {
+		t.Errorf("expected a stale key rotation not to be running")
+	}
+	meta.Status = madmin.KeyRotationStopped
+	if meta.running(now) {
+		t.Errorf("expected a stopped key rotation not to be running")
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+	z := obj.(*erasureServerPools)
+
+	// Listing object versions requires the object layer to be set.
+	defer setObjectLayer(newObjectLayerFn())
+	setObjectLayer(obj)
+
+	if _, err = z.startKeyRotation(ctx, "bucket", ""); !errors.Is(err, errKMSNotConfigured) {
+		t.Fatalf("expected %v, got %v", errKMSNotConfigured, err)
+	}
+	GlobalKMS = crypto.NewMasterKey("my-minio-key", [32]byte{1})
+	defer func() { GlobalKMS = nil }()
+
+	bucket := "bucket"
+	if err = obj.MakeBucketWithLocation(ctx, bucket, BucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	data := bytes.Repeat([]byte("a"), 1024)
+	metadata := make(map[string]string)
+	encReader, objectKey, err := newEncryptReader(bytes.NewReader(data), nil, bucket, "encrypted", metadata, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encData, err := ioutil.ReadAll(encReader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	metadata[xhttp.AmzObjectTagging] = "key=value"
+	_, err = obj.PutObject(ctx, bucket, "encrypted", mustGetPutObjReader(t, bytes.NewReader(encData), int64(len(encData)), "", ""), ObjectOptions{UserDefined: metadata})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = obj.PutObject(ctx, bucket, "plain", mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = z.resumeKeyRotation(ctx); !errors.Is(err, errKeyRotationNotResumable) {
+		t.Fatalf("expected %v, got %v", errKeyRotationNotResumable, err)
+	}
+	id, err := z.startKeyRotation(ctx, bucket, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var status madmin.KeyRotationStatus
+	for deadline := time.Now().Add(30 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if _, running := z.keyRotationStatus(); running {
+			continue
+		}
+		if status, err = z.getKeyRotationStatus(ctx); err != nil {
+			t.Fatal(err)
+		}
+		break
+	}
+	if status.ID != id || status.Status != madmin.KeyRotationCompleted {
+		t.Fatalf("expected key rotation %s to be completed, got %#v", id, status)
+	}
+	if status.Scanned != 2 || status.Rotated != 1 || status.Skipped != 1 || status.Failed != 0 {
+		t.Fatalf("unexpected key rotation progress %#v", status)
+	}
+
+	oi, err := obj.GetObjectInfo(ctx, bucket, "encrypted", ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if oi.UserDefined[crypto.MetaSealedKeyS3] == metadata[crypto.MetaSealedKeyS3] {
+		t.Fatal("expected the object key to be sealed again")
+	}
+	if oi.UserTags != "key=value" {
+		t.Fatalf("expected the object tags to be kept, got %q", oi.UserTags)
+	}
+	rotatedKey, err := crypto.S3.UnsealObjectKey(GlobalKMS, oi.UserDefined, bucket, "encrypted")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rotatedKey != objectKey {
+		t.Fatal("expected the object key to be unchanged")
+	}
+
+	// Keys are not rotated while a writer holds the object lock.
+	lk := z.serverPools[0].getHashedSet("encrypted").NewNSLock(bucket, "encrypted")
+	if err = lk.GetLock(ctx, globalOperationTimeout); err != nil {
+		t.Fatal(err)
+	}
+	lockedCtx, lockedCancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	_, err = z.rotateObjectKey(lockedCtx, bucket, "encrypted", "")
+	lockedCancel()
+	lk.Unlock()
+	if err == nil {
+		t.Fatal("expected key rotation to wait for the object lock")
+	}
+	if oi2, err := obj.GetObjectInfo(ctx, bucket, "encrypted", ObjectOptions{}); err != nil {
+		t.Fatal(err)
+	} else if oi2.UserDefined[crypto.MetaSealedKeyS3] != oi.UserDefined[crypto.MetaSealedKeyS3] {
+		t.Fatal("expected the object key to be unchanged while locked")
+	}
+	if rotated, err := z.rotateObjectKey(ctx, bucket, "encrypted", ""); err != nil || !rotated {
+		t.Fatalf("expected the object key to be rotated, got %v, %v", rotated, err)
+	}
+
+	// A completed key rotation can be neither resumed nor stopped.
+	if _, err = z.resumeKeyRotation(ctx); !errors.Is(err, errKeyRotationNotResumable) {
+		t.Fatalf("expected %v, got %v", errKeyRotationNotResumable, err)
+	}
+	if err = z.markKeyRotationStopped(ctx); !errors.Is(err, errKeyRotationNotStarted) {
+		t.Fatalf("expected %v, got %v", errKeyRotationNotStarted, err)
+	}
+}
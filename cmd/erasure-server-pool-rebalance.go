@@ -0,0 +1,708 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/bucket/lifecycle"
+	"github.com/minio/minio/pkg/hash"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+const (
+	rebalanceMetaName = "rebalance.json"
+
+	// Pools using more than the goal plus this fraction of their
+	// capacity move objects away until they reach the goal.
+	rebalanceThreshold = 0.05
+
+	// Interval between checks of the capacity used by a pool.
+	rebalanceUsageInterval = 30 * time.Second
+)
+
+var (
+	errRebalanceStarted    = persistedJobStartedError("Rebalance", "rebalance of the pools")
+	errRebalanceNotStarted = persistedJobNotStartedError("Rebalance", "rebalance of the pools")
+	errRebalanceSinglePool = AdminError{
+		Code:       "XMinioRebalanceSinglePool",
+		Message:    "Rebalance requires more than one pool",
+		StatusCode: http.StatusBadRequest,
+	}
+	errRebalanceBalanced = AdminError{
+		Code:       "XMinioRebalanceBalanced",
+		Message:    "The capacity used by the pools is already balanced",
+		StatusCode: http.StatusBadRequest,
+	}
+)
+
+// rebalancePoolStats holds the rebalance progress of a pool.
+type rebalancePoolStats struct {
+	InitUsed      uint64    `json:"initUsed"`
+	Capacity      uint64    `json:"capacity"`
+	Participating bool      `json:"participating"`
+	Status        string    `json:"status,omitempty"`
+	NumObjects    uint64    `json:"objects"`
+	NumVersions   uint64    `json:"versions"`
+	Bytes         uint64    `json:"bytes"`
+	Bucket        string    `json:"bucket,omitempty"`
+	Object        string    `json:"object,omitempty"`
+	Error         string    `json:"error,omitempty"`
+	StartTime     time.Time `json:"startTime"`
+	EndTime       time.Time `json:"endTime,omitempty"`
+}
+
+// rebalanceMeta is the state of a rebalance, saved to the backend so
+// that its progress can be read and stopped from any server.
+type rebalanceMeta struct {
+	persistedJobMeta
+	Goal  float64              `json:"goal"`
+	Pools []rebalancePoolStats `json:"pools"`
+}
+
+// running returns true if pools are still moving objects.
+func (r rebalanceMeta) running(now time.Time) bool {
+	if !r.StoppedAt.IsZero() || r.stale(now) {
+		return false
+	}
+	for _, pool := range r.Pools {
+		if pool.Participating && pool.Status == madmin.RebalanceStarted {
+			return true
+		}
+	}
+	return false
+}
+
+// rebalanceGoal returns the fraction of the total capacity used by
+// all pools, which every pool is moved towards.
+func rebalanceGoal(used, capacity []uint64) float64 {
+	var totalUsed, totalCapacity uint64
+	for i := range used {
+		totalUsed += used[i]
+		totalCapacity += capacity[i]
+	}
+	if totalCapacity == 0 {
+		return 0
+	}
+	return float64(totalUsed) / float64(totalCapacity)
+}
+
+// rebalanceParticipating returns true if the pool uses more of its
+// capacity than the goal allows and has to move objects away.
+func rebalanceParticipating(used, capacity uint64, goal float64) bool {
+	return capacity > 0 && float64(used)/float64(capacity) > goal+rebalanceThreshold
+}
+
+// poolUsage returns the used and total capacity of every pool.
+func (z *erasureServerPools) poolUsage(ctx context.Context) (used, capacity []uint64) {
+	used = make([]uint64, len(z.serverPools))
+	capacity = make([]uint64, len(z.serverPools))
+	var wg sync.WaitGroup
+	for i := range z.serverPools {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			used[i], capacity[i] = z.poolIndexUsage(ctx, i)
+		}(i)
+	}
+	wg.Wait()
+	return used, capacity
+}
+
+// poolIndexUsage returns the used and total capacity of a pool.
+func (z *erasureServerPools) poolIndexUsage(ctx context.Context, poolIdx int) (used, capacity uint64) {
+	for _, disk := range z.serverPools[poolIdx].StorageUsageInfo(ctx).Disks {
+		used += disk.UsedSpace
+		capacity += disk.TotalSpace
+	}
+	return used, capacity
+}
+
+func (z *erasureServerPools) loadRebalanceMeta(ctx context.Context) (rebalanceMeta, error) {
+	var meta rebalanceMeta
+	err := loadPersistedJobMeta(ctx, z, rebalanceMetaName, &meta)
+	return meta, err
+}
+
+func (z *erasureServerPools) saveRebalanceMeta(ctx context.Context, meta rebalanceMeta) error {
+	return savePersistedJobMeta(ctx, z, rebalanceMetaName, &meta)
+}
+
+// rebalanceJob returns the persisted job of the rebalance running on
+// this server.
+func (z *erasureServerPools) rebalanceJob() persistedJob {
+	return persistedJob{
+		objAPI:     z,
+		configFile: rebalanceMetaName,
+		status: func() (persistedJobState, bool) {
+			meta, ok := z.rebalanceStatus()
+			return &meta, ok
+		},
+	}
+}
+
+// rebalanceStatus returns a copy of the rebalance running on this
+// server, false if there is none.
+func (z *erasureServerPools) rebalanceStatus() (rebalanceMeta, bool) {
+	z.rebalMu.Lock()
+	defer z.rebalMu.Unlock()
+	if z.rebalMeta == nil {
+		return rebalanceMeta{}, false
+	}
+	meta := *z.rebalMeta
+	meta.Pools = append([]rebalancePoolStats(nil), z.rebalMeta.Pools...)
+	return meta, true
+}
+
+// startRebalance starts moving objects away from the pools using more
+// of their capacity than the others, it returns the rebalance id.
+func (z *erasureServerPools) startRebalance(ctx context.Context) (string, error) {
+	if z.SinglePool() {
+		return "", errRebalanceSinglePool
+	}
+
+	z.rebalMu.Lock()
+	defer z.rebalMu.Unlock()
+
+	if z.rebalCancel != nil {
+		return "", errRebalanceStarted
+	}
+	var meta rebalanceMeta
+	err := startPersistedJob(ctx, z, rebalanceMetaName, func() error {
+		var err error
+		meta, err = z.loadRebalanceMeta(ctx)
+		if err != nil && !errors.Is(err, errConfigNotFound) {
+			return err
+		}
+		if err == nil && meta.running(UTCNow()) {
+			return errRebalanceStarted
+		}
+
+		used, capacity := z.poolUsage(ctx)
+		meta = rebalanceMeta{
+			persistedJobMeta: newPersistedJobMeta(),
+			Goal:             rebalanceGoal(used, capacity),
+			Pools:            make([]rebalancePoolStats, len(z.serverPools)),
+		}
+		participating := false
+		for i := range meta.Pools {
+			meta.Pools[i] = rebalancePoolStats{
+				InitUsed:      used[i],
+				Capacity:      capacity[i],
+				Participating: rebalanceParticipating(used[i], capacity[i], meta.Goal),
+				StartTime:     meta.StartedAt,
+			}
+			if meta.Pools[i].Participating {
+				meta.Pools[i].Status = madmin.RebalanceStarted
+				participating = true
+			}
+		}
+		if !participating {
+			return errRebalanceBalanced
+		}
+		return z.saveRebalanceMeta(ctx, meta)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	rctx, cancel := context.WithCancel(GlobalContext)
+	z.rebalMeta = &meta
+	z.rebalCancel = cancel
+	z.rebalanceJob().run(rctx, z.rebalancePools, func(err error) {
+		if err != nil {
+			z.rebalMu.Lock()
+			z.rebalMeta.StoppedAt = UTCNow()
+			z.rebalMu.Unlock()
+		}
+	}, func() {
+		z.rebalMu.Lock()
+		z.rebalCancel()
+		z.rebalCancel = nil
+		z.rebalMeta = nil
+		z.rebalMu.Unlock()
+	})
+	return meta.ID, nil
+}
+
+// stopRebalance stops the rebalance running on this server.
+func (z *erasureServerPools) stopRebalance() {
+	z.rebalMu.Lock()
+	defer z.rebalMu.Unlock()
+	if z.rebalCancel != nil {
+		z.rebalCancel()
+	}
+}
+
+// markRebalanceStopped records that the current rebalance was stopped.
+func (z *erasureServerPools) markRebalanceStopped(ctx context.Context) error {
+	meta, err := z.loadRebalanceMeta(ctx)
+	if err != nil {
+		if errors.Is(err, errConfigNotFound) {
+			return errRebalanceNotStarted
+		}
+		return err
+	}
+	if !meta.StoppedAt.IsZero() {
+		return nil
+	}
+	if !meta.running(UTCNow()) {
+		return errRebalanceNotStarted
+	}
+	now := UTCNow()
+	meta.StoppedAt = now
+	for i := range meta.Pools {
+		if meta.Pools[i].Status == madmin.RebalanceStarted {
+			meta.Pools[i].Status = madmin.RebalanceStopped
+			meta.Pools[i].EndTime = now
+		}
+	}
+	return z.saveRebalanceMeta(ctx, meta)
+}
+
+// updateRebalancePool updates the progress of a pool.
+func (z *erasureServerPools) updateRebalancePool(poolIdx int, update func(*rebalancePoolStats)) {
+	z.rebalMu.Lock()
+	defer z.rebalMu.Unlock()
+	if z.rebalMeta != nil {
+		update(&z.rebalMeta.Pools[poolIdx])
+	}
+}
+
+// rebalancePools moves objects away from all participating pools
+// concurrently until they are done, it returns an error if the
+// rebalance was stopped.
+func (z *erasureServerPools) rebalancePools(ctx context.Context) error {
+	meta, _ := z.rebalanceStatus()
+
+	var wg sync.WaitGroup
+	for poolIdx, pool := range meta.Pools {
+		if !pool.Participating {
+			continue
+		}
+		wg.Add(1)
+		go func(poolIdx int) {
+			defer wg.Done()
+			err := z.rebalancePool(ctx, poolIdx, meta.Goal)
+			z.updateRebalancePool(poolIdx, func(stats *rebalancePoolStats) {
+				stats.EndTime = UTCNow()
+				switch {
+				case err == nil:
+					stats.Status = madmin.RebalanceCompleted
+				case errors.Is(err, context.Canceled):
+					stats.Status = madmin.RebalanceStopped
+				default:
+					stats.Status = madmin.RebalanceFailed
+					stats.Error = err.Error()
+				}
+			})
+		}(poolIdx)
+	}
+
+	wg.Wait()
+	return ctx.Err()
+}
+
+// rebalancePool walks all buckets of a pool and moves its objects to
+// the other pools until the pool reaches the goal.
+func (z *erasureServerPools) rebalancePool(ctx context.Context, poolIdx int, goal float64) error {
+	var checked time.Time
+	balanced := func() bool {
+		if time.Since(checked) < rebalanceUsageInterval {
+			return false
+		}
+		checked = time.Now()
+		used, capacity := z.poolIndexUsage(ctx, poolIdx)
+		return capacity == 0 || float64(used)/float64(capacity) <= goal
+	}
+
+	buckets, err := z.ListBuckets(ctx)
+	if err != nil {
+		return err
+	}
+	for _, bucket := range buckets {
+		for _, set := range z.serverPools[poolIdx].sets {
+			if balanced() {
+				return nil
+			}
+			if err = z.rebalanceSetBucket(ctx, poolIdx, set, bucket.Name, balanced); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// rebalanceSetBucket moves the objects of a bucket stored on an
+// erasure set to the other pools, it returns early once balanced
+// returns true.
+func (z *erasureServerPools) rebalanceSetBucket(ctx context.Context, poolIdx int, set *erasureObjects, bucket string, balanced func() bool) error {
+	disks := set.getOnlineDisks()
+	readQuorum := getReadQuorum(len(set.getDisks()))
+	if len(disks) < readQuorum {
+		return fmt.Errorf("pool %d set %d: %w", poolIdx+1, set.setNumber+1, errErasureReadQuorum)
+	}
+	resolver := metadataResolutionParams{
+		dirQuorum: readQuorum,
+		objQuorum: readQuorum,
+		bucket:    bucket,
+	}
+
+	// Listing is canceled once the pool is balanced.
+	lctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	rebalanceEntry := func(entry metaCacheEntry) {
+		if entry.isDir() || lctx.Err() != nil {
+			return
+		}
+		if balanced() {
+			cancel()
+			return
+		}
+		fivs, err := entry.fileInfoVersions(bucket)
+		if err != nil {
+			return
+		}
+
+		// Objects are moved at the rate of background healing.
+		globalMaintenance.waitIfPaused(lctx, madmin.MaintenanceRebalance)
+		cfg := currentHealConfig()
+		waitForHealLoad(lctx, cfg)
+		if globalBackgroundHealState != nil {
+			if err = globalBackgroundHealState.throttle.wait(lctx, cfg.MaxIOPS); err != nil {
+				return
+			}
+		}
+
+		versions, bytes, err := z.rebalanceObject(lctx, poolIdx, set, bucket, fivs)
+		if err != nil {
+			logger.LogIf(lctx, fmt.Errorf("unable to move %s/%s from pool %d: %w", bucket, entry.name, poolIdx+1, err))
+			return
+		}
+		z.updateRebalancePool(poolIdx, func(stats *rebalancePoolStats) {
+			stats.Bucket = bucket
+			stats.Object = entry.name
+			if versions > 0 {
+				stats.NumObjects++
+				stats.NumVersions += uint64(versions)
+				stats.Bytes += uint64(bytes)
+			}
+		})
+	}
+
+	// Bound the disk walks started together with healing.
+	release, err := healWalks.acquire(ctx, healWorkers())
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	err = listPathRaw(lctx, listPathRawOptions{
+		disks:          disks,
+		bucket:         bucket,
+		recursive:      true,
+		minDisks:       readQuorum,
+		reportNotFound: false,
+		agreed:         rebalanceEntry,
+		partial: func(entries metaCacheEntries, nAgreed int, errs []error) {
+			// Objects without read quorum are left to healing.
+			if entry, ok := entries.resolve(&resolver); ok {
+				rebalanceEntry(*entry)
+			}
+		},
+		finished: nil,
+	})
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+	return nil
+}
+
+// rebalanceTargetPool returns the index of a pool which does not take
+// part in the rebalance and can hold size bytes, pools are picked
+// proportionally to their free space. -1 is returned if there is none.
+func (z *erasureServerPools) rebalanceTargetPool(ctx context.Context, size int64) int {
+	meta, ok := z.rebalanceStatus()
+	if !ok {
+		return -1
+	}
+	pools := z.getServerPoolsAvailableSpace(ctx, size)
+	for i := range pools {
+		if meta.Pools[i].Participating {
+			pools[i].Available = 0
+		}
+	}
+	total := pools.TotalAvailable()
+	if total == 0 {
+		return -1
+	}
+	choose := rand.Uint64() % total
+	atTotal := uint64(0)
+	for _, pool := range pools {
+		atTotal += pool.Available
+		if atTotal > choose && pool.Available > 0 {
+			return pool.Index
+		}
+	}
+	return -1
+}
+
+// rebalanceObject moves all versions of an object from the erasure set
+// of a pool to another pool, oldest version first, and removes them
+// from the set once all are copied. Objects which cannot be copied as
+// they are, such as transitioned or multipart objects whose parts are
+// not kept by the copy, are left in place and zero versions are
+// returned.
+func (z *erasureServerPools) rebalanceObject(ctx context.Context, poolIdx int, set *erasureObjects, bucket string, fivs FileInfoVersions) (versions int, bytes int64, err error) {
+	var size int64
+	for _, version := range fivs.Versions {
+		if version.TransitionStatus != "" {
+			return 0, 0, nil
+		}
+		if len(version.Parts) > 1 {
+			return 0, 0, nil
+		}
+		size += version.Size
+	}
+
+	// Take the lock of the set the object is stored on, which is
+	// the lock writes to the object in this pool take.
+	object := fivs.Name
+	lk := set.NewNSLock(bucket, object)
+	if err = lk.GetLock(ctx, globalOperationTimeout); err != nil {
+		return 0, 0, err
+	}
+	defer lk.Unlock()
+
+	// The versions were listed without the lock, leave the object
+	// for the next rebalance if it was changed since.
+	if err = set.checkObjectVersions(ctx, bucket, fivs); err != nil {
+		return 0, 0, err
+	}
+
+	targetIdx := z.rebalanceTargetPool(ctx, size)
+	if targetIdx < 0 {
+		return 0, 0, errDiskFull
+	}
+	target := z.serverPools[targetIdx].getHashedSet(object)
+
+	// Writes to the object in the target pool are held off until
+	// the versions are copied, see also erasureServerPools.PutObject.
+	tlk := target.NewNSLock(bucket, object)
+	if err = tlk.GetLock(ctx, globalOperationTimeout); err != nil {
+		return 0, 0, err
+	}
+	defer tlk.Unlock()
+
+	// An object only lives in one pool, never merge versions.
+	if _, err = target.getObjectInfo(ctx, bucket, object, ObjectOptions{}); !isErrObjectNotFound(err) {
+		if err == nil {
+			err = fmt.Errorf("object already present in pool %d", targetIdx+1)
+		}
+		return 0, 0, err
+	}
+
+	writeQuorum := getWriteQuorum(len(target.getDisks()))
+	for i := len(fivs.Versions) - 1; i >= 0; i-- {
+		version := fivs.Versions[i]
+		if err = z.rebalanceVersion(ctx, set, target, bucket, object, version, writeQuorum); err != nil {
+			// Leave the object in its pool, without partial copies.
+			for _, copied := range fivs.Versions[i+1:] {
+				target.deleteObjectVersion(context.Background(), bucket, object, writeQuorum, FileInfo{
+					Volume:    bucket,
+					Name:      object,
+					VersionID: copied.VersionID,
+				}, false)
+			}
+			return 0, 0, err
+		}
+		bytes += version.Size
+	}
+
+	// Remove the copied versions only, by version id.
+	sourceWriteQuorum := getWriteQuorum(len(set.getDisks()))
+	for _, version := range fivs.Versions {
+		if err = set.deleteObjectVersion(ctx, bucket, object, sourceWriteQuorum, FileInfo{
+			Volume:    bucket,
+			Name:      object,
+			VersionID: version.VersionID,
+		}, false); err != nil {
+			return 0, 0, err
+		}
+	}
+	return len(fivs.Versions), bytes, nil
+}
+
+// checkObjectVersions returns an error unless the versions of the
+// object stored on a read quorum of disks are the ones in fivs.
+func (er erasureObjects) checkObjectVersions(ctx context.Context, bucket string, fivs FileInfoVersions) error {
+	disks := er.getDisks()
+	matches := make([]bool, len(disks))
+	var wg sync.WaitGroup
+	for i, disk := range disks {
+		if disk == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, disk StorageAPI) {
+			defer wg.Done()
+			buf, err := disk.ReadAll(ctx, bucket, pathJoin(fivs.Name, xlStorageFormatFile))
+			if err != nil {
+				return
+			}
+			current, err := getFileInfoVersions(buf, bucket, fivs.Name)
+			matches[i] = err == nil && sameObjectVersions(current, fivs)
+		}(i, disk)
+	}
+	wg.Wait()
+
+	var n int
+	for _, match := range matches {
+		if match {
+			n++
+		}
+	}
+	if n < getReadQuorum(len(disks)) {
+		return fmt.Errorf("versions of %s/%s changed while listing", bucket, fivs.Name)
+	}
+	return nil
+}
+
+// sameObjectVersions returns true if a and b hold the same versions.
+func sameObjectVersions(a, b FileInfoVersions) bool {
+	if len(a.Versions) != len(b.Versions) {
+		return false
+	}
+	for i := range a.Versions {
+		va, vb := a.Versions[i], b.Versions[i]
+		if va.VersionID != vb.VersionID || va.Deleted != vb.Deleted ||
+			va.DataDir != vb.DataDir || !va.ModTime.Equal(vb.ModTime) {
+			return false
+		}
+	}
+	return true
+}
+
+// rebalanceVersion copies one version of an object from source to the
+// target erasure set as stored, without decrypting or decompressing it.
+func (z *erasureServerPools) rebalanceVersion(ctx context.Context, source, target *erasureObjects, bucket, object string, version FileInfo, writeQuorum int) error {
+	if version.Deleted {
+		return target.deleteObjectVersion(ctx, bucket, object, writeQuorum, FileInfo{
+			Volume:    bucket,
+			Name:      object,
+			VersionID: version.VersionID,
+			ModTime:   version.ModTime,
+			Deleted:   true,
+		}, true)
+	}
+
+	gr, err := source.GetObjectNInfo(ctx, bucket, object, nil, nil, noLock, ObjectOptions{
+		VersionID:        version.VersionID,
+		TransitionStatus: lifecycle.TransitionPending,
+	})
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+
+	actualSize, err := gr.ObjInfo.GetActualSize()
+	if err != nil {
+		return err
+	}
+	hr, err := hash.NewReader(gr, version.Size, "", "", actualSize)
+	if err != nil {
+		return err
+	}
+	metadata := make(map[string]string, len(version.Metadata))
+	for k, v := range version.Metadata {
+		metadata[k] = v
+	}
+	_, err = target.putObject(ctx, bucket, object, NewPutObjReader(hr), ObjectOptions{
+		UserDefined: metadata,
+		VersionID:   version.VersionID,
+		Versioned:   version.VersionID != "",
+		MTime:       version.ModTime,
+		NoLock:      true,
+	})
+	return err
+}
+
+// getRebalanceStatus returns the status of the rebalance running on
+// this server, or else of the last rebalance saved by any server.
+func (z *erasureServerPools) getRebalanceStatus(ctx context.Context) (madmin.RebalanceStatus, error) {
+	meta, ok := z.rebalanceStatus()
+	if !ok {
+		var err error
+		if meta, err = z.loadRebalanceMeta(ctx); err != nil {
+			if errors.Is(err, errConfigNotFound) {
+				return madmin.RebalanceStatus{}, errRebalanceNotStarted
+			}
+			return madmin.RebalanceStatus{}, err
+		}
+	}
+
+	status := madmin.RebalanceStatus{
+		ID:        meta.ID,
+		StartedAt: meta.StartedAt,
+		StoppedAt: meta.StoppedAt,
+		Goal:      meta.Goal,
+		Pools:     make([]madmin.RebalancePoolStatus, len(meta.Pools)),
+	}
+	// A rebalance whose server went away is reported as stopped.
+	running := meta.running(UTCNow())
+	now := UTCNow()
+	used, capacity := z.poolUsage(ctx)
+	for i, pool := range meta.Pools {
+		ps := madmin.RebalancePoolStatus{
+			ID:     i,
+			Status: pool.Status,
+			Error:  pool.Error,
+		}
+		if i < len(capacity) && capacity[i] > 0 {
+			ps.Used = float64(used[i]) / float64(capacity[i])
+		}
+		if pool.Status == madmin.RebalanceStarted && !running {
+			ps.Status = madmin.RebalanceStopped
+		}
+		if pool.Participating {
+			ps.Progress = madmin.RebalancePoolProgress{
+				NumObjects:  pool.NumObjects,
+				NumVersions: pool.NumVersions,
+				Bytes:       pool.Bytes,
+				Bucket:      pool.Bucket,
+				Object:      pool.Object,
+			}
+			end := pool.EndTime
+			if end.IsZero() {
+				end = now
+			}
+			ps.Progress.Elapsed = end.Sub(pool.StartTime)
+		}
+		status.Pools[i] = ps
+	}
+	return status, nil
+}
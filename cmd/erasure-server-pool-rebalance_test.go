@@ -0,0 +1,231 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestRebalanceGoal(t *testing.T) {
+	used := []uint64{90, 10}
+	capacity := []uint64{100, 100}
+	goal := rebalanceGoal(used, capacity)
+	if goal != 0.5 {
+		t.Fatalf("expected goal 0.5, got %v", goal)
+	}
+	if !rebalanceParticipating(used[0], capacity[0], goal) {
+		t.Errorf("expected the full pool to participate")
+	}
+	if rebalanceParticipating(used[1], capacity[1], goal) {
+		t.Errorf("expected the empty pool not to participate")
+	}
+	// Pools within the threshold of the goal are balanced.
+	if rebalanceParticipating(54, 100, goal) {
+		t.Errorf("expected a pool within the threshold not to participate")
+	}
+	if rebalanceGoal([]uint64{0}, []uint64{0}) != 0 {
+		t.Errorf("expected no goal without capacity")
+	}
+}
+
+func TestRebalanceMetaRunning(t *testing.T) {
+	now := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	meta := rebalanceMeta{
+		persistedJobMeta: persistedJobMeta{LastUpdate: now},
+		Pools: []rebalancePoolStats{
+			{Participating: true, Status: madmin.RebalanceStarted},
+			{},
+		},
+	}
+	if !meta.running(now) {
+		t.Errorf("expected rebalance to be running")
+	}
+	if meta.running(now.Add(2 * persistedJobStaleAfter)) {
+		t.Errorf("expected a stale rebalance not to be running")
+	}
+	meta.Pools[0].Status = madmin.RebalanceCompleted
+	if meta.running(now) {
+		t.Errorf("expected a completed rebalance not to be running")
+	}
+	meta.Pools[0].Status = madmin.RebalanceStarted
+	meta.StoppedAt = now
+	if meta.running(now) {
+		t.Errorf("expected a stopped rebalance not to be running")
+	}
+}
+
+func TestRebalanceObject(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nDisks := 4
+	fsDirs, err := getRandomDisks(2 * nDisks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer removeRoots(fsDirs)
+
+	endpoints := append(mustGetPoolEndpoints(fsDirs[:nDisks]...), mustGetPoolEndpoints(fsDirs[nDisks:]...)...)
+	objLayer, _, err := initObjectLayer(ctx, endpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z := objLayer.(*erasureServerPools)
+
+	bucket := "bucket"
+	object := "object"
+	if err = objLayer.MakeBucketWithLocation(ctx, bucket, BucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	data := bytes.Repeat([]byte("a"), 1024)
+	source := z.serverPools[0].getHashedSet(object)
+	oi, err := source.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	z.rebalMeta = &rebalanceMeta{
+		Pools: []rebalancePoolStats{
+			{Participating: true, Status: madmin.RebalanceStarted},
+			{},
+		},
+	}
+	defer func() { z.rebalMeta = nil }()
+
+	fi, _, _, err := source.getObjectFileInfo(ctx, bucket, object, ObjectOptions{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	versions, n, err := z.rebalanceObject(ctx, 0, source, bucket, FileInfoVersions{
+		Volume:   bucket,
+		Name:     object,
+		Versions: []FileInfo{fi},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if versions != 1 || n != int64(len(data)) {
+		t.Fatalf("expected 1 version of %d bytes moved, got %d of %d bytes", len(data), versions, n)
+	}
+
+	if _, err = source.GetObjectInfo(ctx, bucket, object, ObjectOptions{}); !isErrObjectNotFound(err) {
+		t.Fatalf("expected object to be removed from the first pool, got %v", err)
+	}
+	moved, err := z.serverPools[1].GetObjectInfo(ctx, bucket, object, ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if moved.ETag != oi.ETag || !moved.ModTime.Equal(oi.ModTime) || moved.Size != oi.Size {
+		t.Fatalf("expected %s %s %d, got %s %s %d", oi.ETag, oi.ModTime, oi.Size, moved.ETag, moved.ModTime, moved.Size)
+	}
+
+	gr, err := objLayer.GetObjectNInfo(ctx, bucket, object, nil, nil, readLock, ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gr.Close()
+	got, err := ioutil.ReadAll(gr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Fatalf("moved object content differs")
+	}
+
+	// Overwrites go to the pool the object was moved to.
+	if _, err = objLayer.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = source.GetObjectInfo(ctx, bucket, object, ObjectOptions{}); !isErrObjectNotFound(err) {
+		t.Fatalf("expected overwrite to skip the first pool, got %v", err)
+	}
+
+	// A version written after the listing must neither be lost nor
+	// left behind, the object is not moved.
+	object = "changed"
+	source = z.serverPools[0].getHashedSet(object)
+	if _, err = source.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	fi, _, _, err = source.getObjectFileInfo(ctx, bucket, object, ObjectOptions{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newData := bytes.Repeat([]byte("b"), 2048)
+	if _, err = source.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(newData), int64(len(newData)), "", ""), ObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = z.rebalanceObject(ctx, 0, source, bucket, FileInfoVersions{
+		Volume:   bucket,
+		Name:     object,
+		Versions: []FileInfo{fi},
+	}); err == nil {
+		t.Fatal("expected changed object not to be moved")
+	}
+	if _, err = z.serverPools[1].GetObjectInfo(ctx, bucket, object, ObjectOptions{}); !isErrObjectNotFound(err) {
+		t.Fatalf("expected changed object not to be copied, got %v", err)
+	}
+	kept, err := source.GetObjectInfo(ctx, bucket, object, ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kept.Size != int64(len(newData)) {
+		t.Fatalf("expected the latest version of %d bytes to be kept, got %d bytes", len(newData), kept.Size)
+	}
+
+	// Multipart objects are left in place, their parts are not kept
+	// by the copy.
+	object = "multipart"
+	source = z.serverPools[0].getHashedSet(object)
+	uploadID, err := source.NewMultipartUpload(ctx, bucket, object, ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	partData := bytes.Repeat([]byte("c"), 5*1024*1024)
+	var parts []CompletePart
+	for _, partID := range []int{1, 2} {
+		pi, err := source.PutObjectPart(ctx, bucket, object, uploadID, partID, mustGetPutObjReader(t, bytes.NewReader(partData), int64(len(partData)), "", ""), ObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		parts = append(parts, CompletePart{PartNumber: pi.PartNumber, ETag: pi.ETag})
+	}
+	if _, err = source.CompleteMultipartUpload(ctx, bucket, object, uploadID, parts, ObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	fi, _, _, err = source.getObjectFileInfo(ctx, bucket, object, ObjectOptions{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	versions, _, err = z.rebalanceObject(ctx, 0, source, bucket, FileInfoVersions{
+		Volume:   bucket,
+		Name:     object,
+		Versions: []FileInfo{fi},
+	})
+	if err != nil || versions != 0 {
+		t.Fatalf("expected multipart object to be skipped, got %d versions, %v", versions, err)
+	}
+	if _, err = source.GetObjectInfo(ctx, bucket, object, ObjectOptions{}); err != nil {
+		t.Fatalf("expected multipart object to be kept, got %v", err)
+	}
+}
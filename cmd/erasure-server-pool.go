@@ -44,6 +44,16 @@ type erasureServerPools struct {
 
 	// Shut down async operations
 	shutdown context.CancelFunc
+
+	// Rebalance of the pools running on this server, if any.
+	rebalMu     sync.Mutex
+	rebalMeta   *rebalanceMeta
+	rebalCancel context.CancelFunc
+
+	// Key rotation of encrypted objects running on this server, if any.
+	rotateMu     sync.Mutex
+	rotateMeta   *keyRotationMeta
+	rotateCancel context.CancelFunc
 }
 
 func (z *erasureServerPools) SinglePool() bool {
@@ -106,11 +116,10 @@ func newErasureServerPools(ctx context.Context, endpointServerPools EndpointServ
 			return nil, fmt.Errorf("All serverPools should have same deployment ID expected %s, got %s", deploymentID, formats[i].ID)
 		}
 
-		z.serverPools[i], err = newErasureSets(ctx, ep.Endpoints, storageDisks[i], formats[i], commonParityDrives)
+		z.serverPools[i], err = newErasureSets(ctx, ep.Endpoints, storageDisks[i], formats[i], commonParityDrives, i)
 		if err != nil {
 			return nil, err
 		}
-		z.serverPools[i].poolNumber = i
 	}
 	ctx, z.shutdown = context.WithCancel(ctx)
 	go intDataUpdateTracker.start(ctx, localDrives...)
@@ -247,8 +256,29 @@ func (z *erasureServerPools) getServerPoolsAvailableSpace(ctx context.Context, s
 // getPoolIdx returns the found previous object and its corresponding pool idx,
 // if none are found falls back to most available space pool.
 func (z *erasureServerPools) getPoolIdx(ctx context.Context, bucket, object string, size int64) (idx int, err error) {
+	idx, _, err = z.getPoolIdxExisting(ctx, bucket, object, size)
+	return idx, err
+}
+
+// objectFound returns true if the result of GetObjectInfo is an
+// existing object or a delete marker.
+func objectFound(objInfo ObjectInfo, err error) (bool, error) {
+	if err != nil && !isErrObjectNotFound(err) {
+		return false, err
+	}
+	if isErrObjectNotFound(err) {
+		// No object exists or its a delete marker,
+		// check objInfo to confirm.
+		return objInfo.DeleteMarker && objInfo.Name != "", nil
+	}
+	return true, nil
+}
+
+// getPoolIdxExisting is getPoolIdx which also returns whether the
+// object was found in the returned pool.
+func (z *erasureServerPools) getPoolIdxExisting(ctx context.Context, bucket, object string, size int64) (idx int, existing bool, err error) {
 	if z.SinglePool() {
-		return 0, nil
+		return 0, false, nil
 	}
 
 	errs := make([]error, len(z.serverPools))
@@ -265,29 +295,22 @@ func (z *erasureServerPools) getPoolIdx(ctx context.Context, bucket, object stri
 	wg.Wait()
 
 	for i, err := range errs {
-		if err != nil && !isErrObjectNotFound(err) {
-			return -1, err
-		}
-		if isErrObjectNotFound(err) {
-			// No object exists or its a delete marker,
-			// check objInfo to confirm.
-			if objInfos[i].DeleteMarker && objInfos[i].Name != "" {
-				return i, nil
-			}
-			// objInfo is not valid, truly the object doesn't
-			// exist proceed to next pool.
-			continue
+		found, err := objectFound(objInfos[i], err)
+		if err != nil {
+			return -1, false, err
+		}
+		if found {
+			// object exists at this pool.
+			return i, true, nil
 		}
-		// object exists at this pool.
-		return i, nil
 	}
 
 	// We multiply the size by 2 to account for erasure coding.
 	idx = z.getAvailablePoolIdx(ctx, size*2)
 	if idx < 0 {
-		return -1, toObjectErr(errDiskFull)
+		return -1, false, toObjectErr(errDiskFull)
 	}
-	return idx, nil
+	return idx, false, nil
 }
 
 func (z *erasureServerPools) Shutdown(ctx context.Context) error {
@@ -660,17 +683,44 @@ func (z *erasureServerPools) PutObject(ctx context.Context, bucket string, objec
 
 	object = encodeDirObject(object)
 
+	applyBucketStorageClass(bucket, &opts)
+
 	if z.SinglePool() {
 		return z.serverPools[0].PutObject(ctx, bucket, object, data, opts)
 	}
 
-	idx, err := z.getPoolIdx(ctx, bucket, object, data.Size())
-	if err != nil {
-		return ObjectInfo{}, err
-	}
+	for {
+		idx, existing, err := z.getPoolIdxExisting(ctx, bucket, object, data.Size())
+		if err != nil {
+			return ObjectInfo{}, err
+		}
+		if !existing {
+			return z.serverPools[idx].PutObject(ctx, bucket, object, data, opts)
+		}
 
-	// Overwrite the object at the right pool
-	return z.serverPools[idx].PutObject(ctx, bucket, object, data, opts)
+		// A rebalance may move the object to another pool while
+		// waiting for the lock, overwrite it where it is found.
+		set := z.serverPools[idx].getHashedSet(object)
+		lk := set.NewNSLock(bucket, object)
+		if err = lk.GetLock(ctx, globalOperationTimeout); err != nil {
+			return ObjectInfo{}, err
+		}
+		found, err := objectFound(set.getObjectInfo(ctx, bucket, object, ObjectOptions{}))
+		if err != nil || !found {
+			lk.Unlock()
+			if err != nil {
+				return ObjectInfo{}, err
+			}
+			continue
+		}
+
+		// Overwrite the object at the right pool
+		popts := opts
+		popts.NoLock = true
+		objInfo, err := z.serverPools[idx].PutObject(ctx, bucket, object, data, popts)
+		lk.Unlock()
+		return objInfo, err
+	}
 }
 
 func (z *erasureServerPools) DeleteObject(ctx context.Context, bucket string, object string, opts ObjectOptions) (objInfo ObjectInfo, err error) {
@@ -933,6 +983,8 @@ func (z *erasureServerPools) NewMultipartUpload(ctx context.Context, bucket, obj
 		return "", err
 	}
 
+	applyBucketStorageClass(bucket, &opts)
+
 	if z.SinglePool() {
 		return z.serverPools[0].NewMultipartUpload(ctx, bucket, object, opts)
 	}
@@ -1402,8 +1454,12 @@ func (z *erasureServerPools) HealObjects(ctx context.Context, bucket, prefix str
 	// return `ObjectNotFound`, to indicate the caller for any
 	// actions they may want to take as if `prefix` is missing.
 	err := toObjectErr(errFileNotFound, bucket, prefix)
-	for _, erasureSet := range z.serverPools {
-		for _, set := range erasureSet.sets {
+	for poolIndex, erasureSet := range z.serverPools {
+		for setIndex, set := range erasureSet.sets {
+			// Only heal the targeted pool and erasure set.
+			if !opts.MatchesSet(poolIndex, setIndex) {
+				continue
+			}
 			var entryChs []FileInfoVersionsCh
 			var mu sync.Mutex
 			var wg sync.WaitGroup
@@ -1447,11 +1503,14 @@ func (z *erasureServerPools) HealObjects(ctx context.Context, bucket, prefix str
 				// knows that its not our first attempt at 'prefix'
 				err = nil
 
-				if quorumCount == set.setDriveCount && opts.ScanMode == madmin.HealNormalScan {
+				if quorumCount == set.setDriveCount && opts.ScanMode != madmin.HealDeepScan {
 					continue
 				}
 
 				for _, version := range entry.Versions {
+					if !opts.MatchesModTime(version.ModTime) {
+						continue
+					}
 					if err := healObject(bucket, version.Name, version.VersionID); err != nil {
 						return toObjectErr(err, bucket, version.Name)
 					}
@@ -95,6 +95,8 @@ type erasureSets struct {
 
 	mrfMU         sync.Mutex
 	mrfOperations map[healSource]int
+	mrfHealing    map[healSource]struct{} // mrfOperations queued for heal
+	mrfDirty      bool                    // mrfOperations changed since last saved
 }
 
 func isEndpointConnected(diskMap map[string]StorageAPI, endpoint string) bool {
@@ -210,16 +212,18 @@ func (s *erasureSets) connectDisks() {
 			disk, format, err := connectEndpoint(endpoint)
 			if err != nil {
 				if endpoint.IsLocal && errors.Is(err, errUnformattedDisk) {
-					globalBackgroundHealState.pushHealLocalDisks(endpoint)
-					logger.Info(fmt.Sprintf("Found unformatted drive %s, attempting to heal...", endpoint))
+					if globalBackgroundHealState.pushHealLocalDisks(endpoint) {
+						logger.Info(fmt.Sprintf("Found unformatted drive %s, attempting to heal...", endpoint))
+					}
 				} else {
 					printEndpointError(endpoint, err, true)
 				}
 				return
 			}
 			if disk.IsLocal() && disk.Healing() {
-				globalBackgroundHealState.pushHealLocalDisks(disk.Endpoint())
-				logger.Info(fmt.Sprintf("Found the drive %s that needs healing, attempting to heal...", disk))
+				if globalBackgroundHealState.pushHealLocalDisks(disk.Endpoint()) {
+					logger.Info(fmt.Sprintf("Found the drive %s that needs healing, attempting to heal...", disk))
+				}
 			}
 			s.erasureDisksMu.RLock()
 			setIndex, diskIndex, err := findDiskIndex(s.format, format)
@@ -331,7 +335,7 @@ func (s *erasureSets) GetDisks(setIndex int) func() []StorageAPI {
 const defaultMonitorConnectEndpointInterval = defaultMonitorNewDiskInterval + time.Second*5
 
 // Initialize new set of erasure coded sets.
-func newErasureSets(ctx context.Context, endpoints Endpoints, storageDisks []StorageAPI, format *formatErasureV3, defaultParityCount int) (*erasureSets, error) {
+func newErasureSets(ctx context.Context, endpoints Endpoints, storageDisks []StorageAPI, format *formatErasureV3, defaultParityCount, poolIdx int) (*erasureSets, error) {
 	setCount := len(format.Erasure.Sets)
 	setDriveCount := len(format.Erasure.Sets[0])
 
@@ -340,6 +344,7 @@ func newErasureSets(ctx context.Context, endpoints Endpoints, storageDisks []Sto
 	// Initialize the erasure sets instance.
 	s := &erasureSets{
 		sets:               make([]*erasureObjects, setCount),
+		poolNumber:         poolIdx,
 		erasureDisks:       make([][]StorageAPI, setCount),
 		erasureLockers:     make([][]dsync.NetLocker, setCount),
 		erasureLockOwner:   GetLocalPeer(globalEndpoints),
@@ -405,6 +410,7 @@ func newErasureSets(ctx context.Context, endpoints Endpoints, storageDisks []Sto
 		// Initialize erasure objects for a given set.
 		s.sets[i] = &erasureObjects{
 			setNumber:             i,
+			poolIndex:             poolIdx,
 			setDriveCount:         setDriveCount,
 			defaultParityCount:    defaultParityCount,
 			getDisks:              s.GetDisks(i),
@@ -413,7 +419,7 @@ func newErasureSets(ctx context.Context, endpoints Endpoints, storageDisks []Sto
 			deletedCleanupSleeper: newDynamicSleeper(10, 10*time.Second),
 			nsMutex:               mutex,
 			bp:                    bp,
-			mrfOpCh:               make(chan partialOperation, 10000),
+			mrfOpCh:               make(chan partialOperation, mrfOperationsMax),
 		}
 	}
 
@@ -604,6 +610,9 @@ func (s *erasureSets) StorageInfo(ctx context.Context) (StorageInfo, []error) {
 // Shutdown shutsdown all erasure coded sets in parallel
 // returns error upon first error.
 func (s *erasureSets) Shutdown(ctx context.Context) error {
+	// Keep the objects not yet healed for the next start.
+	s.saveMRFList(ctx)
+
 	g := errgroup.WithNErrs(len(s.sets))
 
 	for index := range s.sets {
@@ -1432,9 +1441,9 @@ func (s *erasureSets) GetObjectTags(ctx context.Context, bucket, object string,
 
 // maintainMRFList gathers the list of successful partial uploads
 // from all underlying er.sets and puts them in a global map which
-// should not have more than 10000 entries.
+// should not have more than mrfOperationsMax entries.
 func (s *erasureSets) maintainMRFList() {
-	var agg = make(chan partialOperation, 10000)
+	var agg = make(chan partialOperation, mrfOperationsMax)
 	for i, er := range s.sets {
 		go func(c <-chan partialOperation, setIndex int) {
 			for msg := range c {
@@ -1448,21 +1457,17 @@ func (s *erasureSets) maintainMRFList() {
 	}
 
 	for fOp := range agg {
-		s.mrfMU.Lock()
-		if len(s.mrfOperations) > 10000 {
-			s.mrfMU.Unlock()
-			continue
-		}
-		s.mrfOperations[healSource{
+		s.addMRFOperation(healSource{
 			bucket:    fOp.bucket,
 			object:    fOp.object,
 			versionID: fOp.versionID,
-		}] = fOp.failedSet
-		s.mrfMU.Unlock()
+		}, fOp.failedSet)
 	}
 }
 
-func toSourceChTimed(t *time.Timer, sourceCh chan healSource, u healSource) {
+// toSourceChTimed sends u to sourceCh, returns false if
+// sourceCh could not accept u before the timeout.
+func toSourceChTimed(t *time.Timer, sourceCh chan healSource, u healSource) bool {
 	t.Reset(100 * time.Millisecond)
 
 	// No defer, as we don't know which
@@ -1471,7 +1476,7 @@ func toSourceChTimed(t *time.Timer, sourceCh chan healSource, u healSource) {
 	select {
 	case sourceCh <- u:
 	case <-t.C:
-		return
+		return false
 	}
 
 	// We still need to check the return value
@@ -1480,37 +1485,41 @@ func toSourceChTimed(t *time.Timer, sourceCh chan healSource, u healSource) {
 	if !t.Stop() {
 		<-t.C
 	}
+	return true
 }
 
-// healMRFRoutine monitors new disks connection, sweep the MRF list
-// to find objects related to the new disk that needs to be healed.
+// healMRFRoutine heals the objects of the MRF list, objects of an
+// erasure set are healed when one of its disks reconnects and
+// periodically once all its disks are online.
 func (s *erasureSets) healMRFRoutine() {
 	// Wait until background heal state is initialized
 	bgSeq := mustGetHealSequence(GlobalContext)
 
+	// Restore the objects not healed before the last restart.
+	s.loadMRFList(GlobalContext)
+
 	idler := time.NewTimer(100 * time.Millisecond)
 	defer idler.Stop()
 
-	for e := range s.disksConnectEvent {
-		// Get the list of objects related the er.set
-		// to which the connected disk belongs.
-		var mrfOperations []healSource
-		s.mrfMU.Lock()
-		for k, v := range s.mrfOperations {
-			if v == e.setIndex {
-				mrfOperations = append(mrfOperations, k)
-			}
-		}
-		s.mrfMU.Unlock()
+	sweeper := time.NewTicker(mrfSweepInterval)
+	defer sweeper.Stop()
 
-		// Heal objects
-		for _, u := range mrfOperations {
-			// Send an object to background heal
-			toSourceChTimed(idler, bgSeq.sourceCh, u)
-
-			s.mrfMU.Lock()
-			delete(s.mrfOperations, u)
-			s.mrfMU.Unlock()
+	for {
+		select {
+		case e, ok := <-s.disksConnectEvent:
+			if !ok {
+				return
+			}
+			// Heal the objects related to the er.set
+			// to which the connected disk belongs.
+			s.healMRFOperations(bgSeq, idler, func(setIndex int) bool {
+				return setIndex == e.setIndex
+			})
+		case <-sweeper.C:
+			// Objects which missed a slow but online drive
+			// do not wait for a reconnect to be healed.
+			s.healMRFOperations(bgSeq, idler, s.isSetOnline)
+			s.saveMRFList(GlobalContext)
 		}
 	}
 }
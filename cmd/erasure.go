@@ -54,6 +54,7 @@ type erasureObjects struct {
 	defaultParityCount int
 
 	setNumber int
+	poolIndex int
 
 	// getDisks returns list of storageAPIs.
 	getDisks func() []StorageAPI
@@ -344,13 +344,7 @@ func saveHealingTracker(disk StorageAPI, diskID string) error {
 	htracker := healingTracker{
 		ID: diskID,
 	}
-	htrackerBytes, err := htracker.MarshalMsg(nil)
-	if err != nil {
-		return err
-	}
-	return disk.WriteAll(context.TODO(), minioMetaBucket,
-		pathJoin(bucketMetaPrefix, slashSeparator, healingTrackerFilename),
-		htrackerBytes)
+	return htracker.save(context.TODO(), disk)
 }
 
 func saveFormatErasure(disk StorageAPI, format *formatErasureV3, heal bool) error {
@@ -17,10 +17,18 @@
 package cmd
 
 import (
+	"container/heap"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/minio/minio/cmd/config"
+	"github.com/minio/minio/cmd/config/heal"
 	"github.com/minio/minio/cmd/logger"
 	"github.com/minio/minio/pkg/color"
 	"github.com/minio/minio/pkg/console"
@@ -29,6 +37,22 @@ import (
 
 const (
 	bgHealingUUID = "0000-0000-0000-0000"
+
+	// Maximum number of heal failures reported by background heal status.
+	healFailedItemsMax = 1000
+
+	// Heal checkpoints are saved under this prefix in minioMetaBucket.
+	healCheckpointPrefix = "heal"
+	// Interval between checkpoint writes while healing a bucket.
+	healCheckpointInterval = 30 * time.Second
+
+	// Maximum time the data scanner waits for an object selected
+	// for a heal check to be queued.
+	healScannerQueueTimeout = time.Second
+
+	// Maximum number of objects remembered as queued for a heal on
+	// read errors, see diskErrHeals.
+	diskErrHealsMax = 1000
 )
 
 // NewBgHealSequence creates a background healing sequence
@@ -44,7 +68,8 @@ func newBgHealSequence() *healSequence {
 	}
 
 	return &healSequence{
-		sourceCh:    make(chan healSource),
+		sourceCh:    make(chan healSource, healWorkers()),
+		mrfCh:       make(chan healSource, healWorkers()),
 		respCh:      make(chan healResult),
 		startTime:   UTCNow(),
 		clientToken: bgHealingUUID,
@@ -61,6 +86,8 @@ func newBgHealSequence() *healSequence {
 		scannedItemsMap:    make(map[madmin.HealItemType]int64),
 		healedItemsMap:     make(map[madmin.HealItemType]int64),
 		healFailedItemsMap: make(map[string]int64),
+		wouldHealItemsMap:  make(map[madmin.HealItemType]int64),
+		healFailedItems:    make(map[string]madmin.HealFailedItem),
 	}
 }
 
@@ -90,14 +117,196 @@ func getLocalBackgroundHealStatus() (madmin.BgHealState, bool) {
 		healDisks = append(healDisks, disk)
 	}
 
+	globalHealConfigMu.Lock()
+	healCfg := globalHealConfig
+	globalHealConfigMu.Unlock()
+
 	return madmin.BgHealState{
-		ScannedItemsCount: bgSeq.getScannedItemsCount(),
-		LastHealActivity:  bgSeq.lastHealActivity,
-		HealDisks:         healDisks,
-		NextHealRound:     UTCNow(),
+		ScannedItemsCount:     bgSeq.getScannedItemsCount(),
+		ScannedItemsByType:    bgSeq.getScannedItemsMap(),
+		HealedItemsByType:     bgSeq.getHealedItemsMap(),
+		DryRun:                bgSeq.healDryRun(healCfg),
+		WouldHealItemsByType:  bgSeq.getWouldHealItemsMap(),
+		DiskErrorHealCount:    bgSeq.getDiskErrHealCount(),
+		SampledItemsCount:     bgSeq.getSampledItemsCount(),
+		ExpiringItemsCount:    bgSeq.getExpiringItemsCount(),
+		HealFailedItems:       bgSeq.getHealFailedItems(),
+		InterruptedItemsCount: bgSeq.getInterruptedItemsCount(),
+		Summary:               bgSeq.getHealSummary(),
+		Paused:                bgSeq.isPaused() || globalMaintenance.isPaused(madmin.MaintenanceHeal),
+		LastHealActivity:      bgSeq.getLastHealActivity(),
+		HealDisks:             healDisks,
+		DiskHealProgress:      globalBackgroundHealState.getDiskHealProgress(),
+		SetHealProgress:       globalBackgroundHealState.getSetHealProgress(),
+		NextHealRound:         nextHealRound(bgSeq),
+		Node:                  GetLocalPeer(globalEndpoints),
 	}, true
 }
 
+// backgroundHealAction pauses or resumes background healing on this
+// server, queued heal tasks are kept while healing is paused.
+func backgroundHealAction(action madmin.BgHealAction) error {
+	if globalBackgroundHealState == nil {
+		return errServerNotInitialized
+	}
+	var ok bool
+	switch action {
+	case madmin.BgHealActionPause:
+		ok = globalBackgroundHealState.pauseHealSequence(bgHealingUUID)
+	case madmin.BgHealActionResume:
+		ok = globalBackgroundHealState.resumeHealSequence(bgHealingUUID)
+	default:
+		return fmt.Errorf("unsupported background heal action %s", action)
+	}
+	if !ok {
+		return errServerNotInitialized
+	}
+	return nil
+}
+
+// healScheduleCheckInterval is how often healing held back by the
+// heal schedule checks whether it may proceed.
+const healScheduleCheckInterval = time.Minute
+
+// healScheduleBypass caches whether local drives are being healed,
+// in which case the heal schedule is ignored.
+var healScheduleBypass struct {
+	sync.Mutex
+	checked time.Time
+	bypass  bool
+}
+
+// healScheduleBypassed returns true if local drives need healing.
+func healScheduleBypassed() bool {
+	healScheduleBypass.Lock()
+	defer healScheduleBypass.Unlock()
+
+	if time.Since(healScheduleBypass.checked) >= healScheduleCheckInterval {
+		healScheduleBypass.bypass = len(getLocalDisksToHeal()) > 0
+		healScheduleBypass.checked = time.Now()
+	}
+	return healScheduleBypass.bypass
+}
+
+// waitForHealSchedule blocks until the current time is within the
+// configured heal schedule. Unless the schedule also applies to
+// drives, fresh drives are healed regardless of the schedule,
+// leaving them unpopulated is a durability risk.
+func waitForHealSchedule(ctx context.Context) {
+	for {
+		globalHealConfigMu.Lock()
+		cfg := globalHealConfig
+		globalHealConfigMu.Unlock()
+
+		if cfg.Schedule.Contains(time.Now()) || (!cfg.ScheduleDrives && healScheduleBypassed()) {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(healScheduleCheckInterval):
+		}
+	}
+}
+
+// nextHealRound returns the start of the next heal round,
+// deferred to the opening of the heal schedule window.
+func nextHealRound(bgSeq *healSequence) time.Time {
+	globalHealConfigMu.Lock()
+	cfg := globalHealConfig
+	globalHealConfigMu.Unlock()
+
+	next := bgSeq.getNextHealRound(cfg.GetInterval())
+	if cfg.Schedule.IsZero() || (!cfg.ScheduleDrives && healScheduleBypassed()) {
+		return next
+	}
+	return cfg.Schedule.Next(next.Local()).UTC()
+}
+
+// currentHealConfig returns the heal settings currently in effect,
+// they change when the heal config is updated at runtime.
+func currentHealConfig() heal.Config {
+	globalHealConfigMu.Lock()
+	defer globalHealConfigMu.Unlock()
+	return globalHealConfig
+}
+
+// healThrottleSettings returns the throttle settings of the heal config.
+func healThrottleSettings(cfg heal.Config) madmin.HealThrottle {
+	return madmin.HealThrottle{
+		Sleep:   cfg.Sleep,
+		IOCount: cfg.IOCount,
+		Workers: cfg.Workers,
+		MaxIOPS: cfg.MaxIOPS,
+
+		BucketWorkers: cfg.BucketWorkers,
+	}
+}
+
+// setHealThrottleSettings updates the heal config keys which hold
+// the given throttle settings.
+func setHealThrottleSettings(kvs *config.KVS, throttle madmin.HealThrottle) {
+	kvs.Set(heal.Sleep, throttle.Sleep.String())
+	kvs.Set(heal.IOCount, strconv.Itoa(throttle.IOCount))
+	kvs.Set(heal.Workers, strconv.Itoa(throttle.Workers))
+	kvs.Set(heal.MaxIOPS, strconv.Itoa(throttle.MaxIOPS))
+	kvs.Set(heal.BucketWorkers, strconv.Itoa(throttle.BucketWorkers))
+}
+
+// healWorkers returns the configured number of background heal workers.
+func healWorkers() int {
+	globalHealConfigMu.Lock()
+	defer globalHealConfigMu.Unlock()
+	return globalHealConfig.GetWorkers()
+}
+
+// healBucketWorkers returns the configured number of buckets healed
+// concurrently on an erasure set of setDriveCount drives.
+func healBucketWorkers(setDriveCount int) int {
+	return currentHealConfig().GetBucketWorkers(setDriveCount)
+}
+
+// healWalks bounds the number of bucket listings of all erasure
+// sets on this server walking their disks concurrently.
+var healWalks healWalkLimiter
+
+// healWalkLimiter is a semaphore of listing slots, all disk walks
+// of a listing are started together once it holds a slot, so a
+// slow disk can never leave another listing partially started.
+type healWalkLimiter struct {
+	mu  sync.Mutex
+	sem chan struct{}
+}
+
+// acquire waits for one of n listing slots to be free, the returned
+// function frees the slot. Changing n only applies to new slots.
+func (l *healWalkLimiter) acquire(ctx context.Context, n int) (func(), error) {
+	l.mu.Lock()
+	if l.sem == nil || cap(l.sem) != n {
+		l.sem = make(chan struct{}, n)
+	}
+	sem := l.sem
+	l.mu.Unlock()
+
+	select {
+	case sem <- struct{}{}:
+		return func() { <-sem }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// healSkipObject returns true if the object is filtered out by the
+// include and exclude prefixes of the heal options, objects in the
+// reserved buckets such as config and bucket metadata are never skipped.
+func healSkipObject(opts madmin.HealOpts, bucket, object string) bool {
+	if isMinioMetaBucketName(bucket) || strings.HasPrefix(bucket, minioMetaBucket+SlashSeparator) {
+		return false
+	}
+	return !opts.MatchesPrefixes(object)
+}
+
 func mustGetHealSequence(ctx context.Context) *healSequence {
 	// Get background heal sequence to send elements to heal
 	for {
@@ -119,9 +328,110 @@ func mustGetHealSequence(ctx context.Context) *healSequence {
 	}
 }
 
-// healErasureSet lists and heals all objects in a specific erasure set
-func (er *erasureObjects) healErasureSet(ctx context.Context, buckets []BucketInfo) error {
+// healCheckpoint holds the last object queued for heal in a bucket of
+// an erasure set, it allows healing to resume after a restart.
+type healCheckpoint struct {
+	Object    string    `json:"object"`
+	VersionID string    `json:"versionId,omitempty"`
+	Updated   time.Time `json:"updated"`
+}
+
+func (er *erasureObjects) healCheckpointPath(bucket string) string {
+	return pathJoin(healCheckpointPrefix, fmt.Sprintf("pool-%d", er.poolIndex),
+		fmt.Sprintf("set-%d", er.setNumber), bucket, "checkpoint.json")
+}
+
+// loadHealCheckpoint returns the heal checkpoint of the bucket,
+// an empty checkpoint is returned if none was saved.
+func (er *erasureObjects) loadHealCheckpoint(ctx context.Context, bucket string) healCheckpoint {
+	var cp healCheckpoint
+	data, err := readConfig(ctx, er, er.healCheckpointPath(bucket))
+	if err != nil {
+		if !errors.Is(err, errConfigNotFound) {
+			logger.LogIf(ctx, err)
+		}
+		return cp
+	}
+	if err = json.Unmarshal(data, &cp); err != nil {
+		logger.LogIf(ctx, err)
+		return healCheckpoint{}
+	}
+	return cp
+}
+
+func (er *erasureObjects) saveHealCheckpoint(ctx context.Context, bucket string, cp healCheckpoint) {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		logger.LogIf(ctx, err)
+		return
+	}
+	logger.LogIf(ctx, saveConfig(ctx, er, er.healCheckpointPath(bucket), data))
+}
+
+func (er *erasureObjects) deleteHealCheckpoint(ctx context.Context, bucket string) {
+	if err := deleteConfig(ctx, er, er.healCheckpointPath(bucket)); err != nil && !errors.Is(err, errConfigNotFound) {
+		logger.LogIf(ctx, err)
+	}
+}
+
+// healRoundCheckpoint holds the buckets completely healed by the
+// current heal round of an erasure set, it allows a round interrupted
+// by a restart to resume without healing these buckets again.
+type healRoundCheckpoint struct {
+	Disk    string    `json:"disk,omitempty"`
+	Buckets []string  `json:"buckets"`
+	Started time.Time `json:"started"`
+	Updated time.Time `json:"updated"`
+}
+
+func (er *erasureObjects) healRoundCheckpointPath() string {
+	return pathJoin(healCheckpointPrefix, fmt.Sprintf("pool-%d", er.poolIndex),
+		fmt.Sprintf("set-%d.json", er.setNumber))
+}
+
+// loadHealRoundCheckpoint returns the heal round checkpoint of the
+// erasure set, an empty checkpoint is returned if none was saved.
+func (er *erasureObjects) loadHealRoundCheckpoint(ctx context.Context) healRoundCheckpoint {
+	var cp healRoundCheckpoint
+	data, err := readConfig(ctx, er, er.healRoundCheckpointPath())
+	if err != nil {
+		if !errors.Is(err, errConfigNotFound) {
+			logger.LogIf(ctx, err)
+		}
+		return cp
+	}
+	if err = json.Unmarshal(data, &cp); err != nil {
+		logger.LogIf(ctx, err)
+		return healRoundCheckpoint{}
+	}
+	return cp
+}
+
+func (er *erasureObjects) saveHealRoundCheckpoint(ctx context.Context, cp healRoundCheckpoint) {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		logger.LogIf(ctx, err)
+		return
+	}
+	logger.LogIf(ctx, saveConfig(ctx, er, er.healRoundCheckpointPath(), data))
+}
+
+func (er *erasureObjects) deleteHealRoundCheckpoint(ctx context.Context) {
+	if err := deleteConfig(ctx, er, er.healRoundCheckpointPath()); err != nil && !errors.Is(err, errConfigNotFound) {
+		logger.LogIf(ctx, err)
+	}
+}
+
+// healErasureSet lists and heals all objects in a specific erasure set,
+// buckets already healed by an interrupted round of the same disk are
+// skipped and healing of each bucket resumes from its last saved
+// checkpoint. The progress is attributed to healDisk when it is set.
+func (er *erasureObjects) healErasureSet(ctx context.Context, buckets []BucketInfo, healDisk string) error {
 	bgSeq := mustGetHealSequence(ctx)
+	bgSeq.startHealRound()
+	defer bgSeq.endHealRound()
+	roundStarted := UTCNow()
+
 	buckets = append(buckets, BucketInfo{
 		Name: pathJoin(minioMetaBucket, minioConfigPrefix),
 	})
@@ -133,66 +443,475 @@ func (er *erasureObjects) healErasureSet(ctx context.Context, buckets []BucketIn
 		}
 	}
 
+	globalHealConfigMu.Lock()
+	healCfg := globalHealConfig
+	globalHealConfigMu.Unlock()
+
+	var (
+		wg      sync.WaitGroup
+		errMu   sync.Mutex
+		healErr error
+
+		// Totals of the round, protected by errMu.
+		roundScanned, roundHealed, roundFailed uint64
+	)
+	// Bound the number of buckets healed concurrently, the bound
+	// follows the heal bucket workers setting while the round is running.
+	var healSem healWalkLimiter
+
+	// Resume the round interrupted by a restart, if any,
+	// round is protected by errMu.
+	round := er.loadHealRoundCheckpoint(ctx)
+	if round.Started.IsZero() || round.Disk != healDisk {
+		round = healRoundCheckpoint{Disk: healDisk, Started: roundStarted}
+	}
+	healedBuckets := make(map[string]struct{}, len(round.Buckets))
+	for _, bucket := range round.Buckets {
+		healedBuckets[bucket] = struct{}{}
+	}
+
+	globalBackgroundHealState.startSetHealProgress(er.poolIndex, er.setNumber, er.healObjectsEstimate(ctx))
+	defer globalBackgroundHealState.stopSetHealProgress(er.poolIndex, er.setNumber)
+
+	healOpts := madmin.HealOpts{
+		ScanMode: madmin.HealNormalScan,
+		Remove:   healDeleteDangling,
+	}
+	if bgSeq.settings.ScanMode == madmin.HealMetadataScan {
+		healOpts.ScanMode = madmin.HealMetadataScan
+	}
+	healOpts.SampleRate = bgSeq.settings.SampleRate
+	if healCfg.SampleRate > 0 {
+		healOpts.SampleRate = healCfg.SampleRate
+	}
+	if bgSeq.healDryRun(healCfg) {
+		// Only evaluate the objects, never modify them.
+		healOpts.DryRun = true
+		healOpts.Remove = false
+	}
+	healFilter := bgSeq.healFilter(healCfg)
+
+	auditSource := healAuditSourceRound
+	if healDisk != "" {
+		auditSource = healAuditSourceDrive
+	}
+
+	globalHealNotifier.send(healEvent{
+		Type:      healEventRoundStarted,
+		PoolIndex: er.poolIndex,
+		SetIndex:  er.setNumber,
+		Drive:     healDisk,
+		Started:   roundStarted,
+	})
+
 	// Heal all buckets with all objects
+healBuckets:
 	for _, bucket := range buckets {
-		// Heal current bucket
-		if _, err := er.HealBucket(ctx, bucket.Name, madmin.HealOpts{}); err != nil {
-			if !isErrObjectNotFound(err) && !isErrVersionNotFound(err) {
-				logger.LogIf(ctx, err)
+		if _, ok := healedBuckets[bucket.Name]; ok {
+			if serverDebugLog {
+				console.Debugf(color.Green("healDisk:")+" bucket %s already healed on erasure set %d\n", bucket.Name, er.setNumber+1)
 			}
+			continue
 		}
 
-		if serverDebugLog {
-			console.Debugf(color.Green("healDisk:")+" healing bucket %s content on erasure set %d\n", bucket.Name, er.setNumber+1)
+		release, err := healSem.acquire(ctx, healBucketWorkers(er.setDriveCount))
+		if err != nil {
+			errMu.Lock()
+			healErr = err
+			errMu.Unlock()
+			break healBuckets
 		}
 
-		disks, _ := er.getOnlineDisksWithHealing()
-		if len(disks) == 0 {
-			return errors.New("healErasureSet: No non-healing disks found")
-		}
-		// Limit listing to 3 drives.
-		if len(disks) > 3 {
-			disks = disks[:3]
-		}
-		healEntry := func(entry metaCacheEntry) {
-			if entry.isDir() {
+		wg.Add(1)
+		go func(bucket BucketInfo) {
+			defer func() {
+				release()
+				wg.Done()
+			}()
+
+			// Heal current bucket
+			if _, err := er.HealBucket(ctx, bucket.Name, madmin.HealOpts{}); err != nil {
+				if !isErrObjectNotFound(err) && !isErrVersionNotFound(err) {
+					logger.LogIf(ctx, err)
+				}
+			}
+
+			if serverDebugLog {
+				console.Debugf(color.Green("healDisk:")+" healing bucket %s content on erasure set %d\n", bucket.Name, er.setNumber+1)
+			}
+
+			// When all drives of the set can be listed, entries on which
+			// every drive agrees on all versions are already at full
+			// quorum and can be skipped without calling HealObject.
+			// Deep scans always verify every object.
+			disks, fastSkip := er.getHealListingDisks(healOpts.ScanMode)
+			if len(disks) == 0 {
+				errMu.Lock()
+				healErr = errors.New("healErasureSet: No non-healing disks found")
+				errMu.Unlock()
 				return
 			}
-			fivs, err := entry.fileInfoVersions(bucket.Name)
+
+			// Resume from the last checkpoint, if any.
+			cp := er.loadHealCheckpoint(ctx, bucket.Name)
+			if cp.Object != "" && serverDebugLog {
+				console.Debugf(color.Green("healDisk:")+" resuming bucket %s on erasure set %d from %s\n", bucket.Name, er.setNumber+1, cp.Object)
+			}
+			lastCheckpoint := UTCNow()
+			bucketStarted := lastCheckpoint
+
+			// Versions expiring within the horizon are not healed.
+			lc := healLifecycle(bucket.Name, healCfg.ExpiryHorizon)
+			var scanned, healed, failed uint64
+
+			// Number of upcoming versions deep verified after
+			// bitrot was found on a sampled version.
+			var deepNeighbors int
+
+			// In priority mode entries are buffered and the ones
+			// available on the fewest drives are healed first. The
+			// drives holding an entry are only known when all drives
+			// of the set are listed, otherwise entries are healed in
+			// listing order.
+			var queue *healPriorityQueue
+			if healCfg.Priority && fastSkip {
+				queue = newHealPriorityQueue(healPriorityWindow)
+			}
+
+			healEntry := func(entry metaCacheEntry, skip bool) {
+				if entry.isDir() {
+					return
+				}
+				defer func() {
+					if time.Since(lastCheckpoint) < healCheckpointInterval {
+						return
+					}
+					cp.Object = entry.name
+					// Never checkpoint past entries still waiting to be healed.
+					if name := queue.minName(); name != "" && name < cp.Object {
+						cp.Object = name
+					}
+					cp.Updated = UTCNow()
+					er.saveHealCheckpoint(ctx, bucket.Name, cp)
+					lastCheckpoint = cp.Updated
+				}()
+				// Skip filtered out prefixes before decoding versions.
+				if healSkipObject(healFilter, bucket.Name, entry.name) {
+					return
+				}
+				fivs, err := entry.fileInfoVersions(bucket.Name)
+				if err != nil {
+					logger.LogIf(ctx, err)
+					return
+				}
+				if !skip {
+					// Throttle settings changed at runtime apply immediately.
+					waitForHealLoad(ctx, currentHealConfig())
+				}
+				for _, version := range fivs.Versions {
+					cp.VersionID = version.VersionID
+					if healSkipVersion(healFilter, bucket.Name, version) {
+						continue
+					}
+					opts := healOpts
+					sampled := opts.ScanMode != madmin.HealDeepScan && opts.Sampled(version.Name, version.VersionID)
+					if sampled || deepNeighbors > 0 {
+						opts.ScanMode = madmin.HealDeepScan
+					}
+					if skip && opts.ScanMode != madmin.HealDeepScan {
+						bgSeq.logHeal(madmin.HealItemObject)
+						scanned++
+						globalBackgroundHealState.updateSetHealProgress(er.poolIndex, er.setNumber, 1, 0)
+						if healDisk != "" {
+							globalBackgroundHealState.updateDiskHealProgress(healDisk, 1, 0, 0)
+						}
+						continue
+					}
+					if healSkipExpiring(lc, version, healCfg.ExpiryHorizon, UTCNow()) {
+						bgSeq.logHealExpiring()
+						continue
+					}
+					bgSeq.waitIfPaused(ctx)
+					waitForHealSchedule(ctx)
+					if err := globalBackgroundHealState.throttle.wait(ctx, currentHealConfig().MaxIOPS); err != nil {
+						return
+					}
+					res, err := er.HealObject(ctx, bucket.Name, version.Name, version.VersionID, opts)
+					if healCfg.Audit {
+						auditHealObject(auditSource, er.poolIndex, er.setNumber, bucket.Name, version.Name, version.VersionID, opts, res, err)
+					}
+					if sampled {
+						bgSeq.logHealSampled()
+						// Corruption tends to cluster, deep verify the
+						// versions which follow a corrupted sample.
+						if err == nil && healResultCorrupt(res) {
+							deepNeighbors = healSampleNeighbors
+						}
+					} else if deepNeighbors > 0 {
+						deepNeighbors--
+					}
+					if err != nil {
+						if !isErrObjectNotFound(err) && !isErrVersionNotFound(err) {
+							logger.LogIf(ctx, err)
+							failed++
+							bgSeq.logHealFailed(madmin.HealItemObject)
+							// Transient failures are retried within the round.
+							retryOpts := opts
+							bgSeq.retryHeal(healSource{
+								bucket:    bucket.Name,
+								object:    version.Name,
+								versionID: version.VersionID,
+								opts:      &retryOpts,
+							}, err, er.poolIndex, er.setNumber)
+						}
+					} else if opts.DryRun {
+						bgSeq.logWouldHeal(res)
+					} else {
+						// Forget earlier failures, such as dead letters.
+						bgSeq.forgetHealFailure(bucket.Name, version.Name, version.VersionID)
+						if healResultNeedsHeal(res) {
+							healed++
+							bgSeq.logHealed(madmin.HealItemObject)
+							globalBackgroundHealState.updateSetHealProgress(er.poolIndex, er.setNumber, 0, 1)
+							if healDisk != "" {
+								bytes := version.Erasure.ShardFileSize(version.Size)
+								if bytes < 0 {
+									bytes = 0
+								}
+								globalBackgroundHealState.updateDiskHealProgress(healDisk, 0, 1, uint64(bytes))
+							}
+						}
+					}
+					bgSeq.logHeal(madmin.HealItemObject)
+					scanned++
+					globalBackgroundHealState.updateSetHealProgress(er.poolIndex, er.setNumber, 1, 0)
+					if healDisk != "" {
+						globalBackgroundHealState.updateDiskHealProgress(healDisk, 1, 0, 0)
+					}
+				}
+			}
+			// Objects below read quorum are healed immediately.
+			readQuorum := er.setDriveCount - er.defaultParityCount
+			queueEntry := func(entry metaCacheEntry, available int) {
+				if queue == nil || available < readQuorum {
+					healEntry(entry, false)
+					return
+				}
+				if item, ok := queue.push(healPriorityItem{entry: entry, available: available}); ok {
+					healEntry(item.entry, false)
+				}
+			}
+
+			// Bound the disk walks started across all erasure sets.
+			release, err := healWalks.acquire(ctx, healWorkers())
 			if err != nil {
-				logger.LogIf(ctx, err)
 				return
 			}
-			waitForLowHTTPReq(globalHealConfig.IOCount, globalHealConfig.Sleep)
-			for _, version := range fivs.Versions {
-				if _, err := er.HealObject(ctx, bucket.Name, version.Name, version.VersionID, madmin.HealOpts{ScanMode: madmin.HealNormalScan, Remove: healDeleteDangling}); err != nil {
-					if !isErrObjectNotFound(err) && !isErrVersionNotFound(err) {
-						logger.LogIf(ctx, err)
+			err = listPathRaw(ctx, listPathRawOptions{
+				disks:          disks,
+				bucket:         bucket.Name,
+				recursive:      true,
+				forwardTo:      cp.Object,
+				minDisks:       1,
+				reportNotFound: false,
+				strict:         fastSkip,
+				agreed: func(entry metaCacheEntry) {
+					healEntry(entry, fastSkip)
+				},
+				partial: func(entries metaCacheEntries, nAgreed int, errs []error) {
+					entry, _ := entries.firstFound()
+					if entry == nil || entry.isDir() {
+						return
 					}
-				}
-				bgSeq.logHeal(madmin.HealItemObject)
+					available := 0
+					for _, e := range entries {
+						if e.name == entry.name {
+							available++
+						}
+					}
+					queueEntry(*entry, available)
+				},
+				finished: nil,
+			})
+			release()
+			if err != nil {
+				logger.LogIf(ctx, err)
+				return
 			}
+			for item, ok := queue.pop(); ok; item, ok = queue.pop() {
+				healEntry(item.entry, false)
+			}
+			// Bucket is completely healed, start afresh next time.
+			er.deleteHealCheckpoint(ctx, bucket.Name)
+
+			globalHealNotifier.send(healEvent{
+				Type:      healEventBucketFinished,
+				PoolIndex: er.poolIndex,
+				SetIndex:  er.setNumber,
+				Bucket:    bucket.Name,
+				Scanned:   scanned,
+				Healed:    healed,
+				Failed:    failed,
+				Started:   bucketStarted,
+			})
+
+			errMu.Lock()
+			roundScanned += scanned
+			roundHealed += healed
+			roundFailed += failed
+			round.Buckets = append(round.Buckets, bucket.Name)
+			round.Updated = UTCNow()
+			er.saveHealRoundCheckpoint(ctx, round)
+			errMu.Unlock()
+
+			if healDisk != "" {
+				globalBackgroundHealState.diskHealBucketDone(healDisk)
+			}
+		}(bucket)
+	}
+	wg.Wait()
+
+	if healErr == nil {
+		// Round is complete, start afresh next time.
+		er.deleteHealRoundCheckpoint(ctx)
+	}
+
+	ev := healEvent{
+		Type:      healEventRoundFinished,
+		PoolIndex: er.poolIndex,
+		SetIndex:  er.setNumber,
+		Drive:     healDisk,
+		Scanned:   roundScanned,
+		Healed:    roundHealed,
+		Failed:    roundFailed,
+		Started:   roundStarted,
+	}
+	if healErr != nil {
+		ev.Type = healEventRoundFailed
+		ev.Reason = healErr.Error()
+	}
+	globalHealNotifier.send(ev)
+
+	return healErr
+}
+
+// healSampleNeighbors is the number of versions deep verified
+// after bitrot was found on a sampled version.
+const healSampleNeighbors = 100
+
+// healResultCorrupt returns true if any drive was found corrupt.
+func healResultCorrupt(res madmin.HealResultItem) bool {
+	for _, drive := range res.Before.Drives {
+		if drive.State == madmin.DriveStateCorrupt {
+			return true
 		}
-		err := listPathRaw(ctx, listPathRawOptions{
-			disks:          disks,
-			bucket:         bucket.Name,
-			recursive:      true,
-			forwardTo:      "", //TODO(klauspost): Set this to last known offset when resuming.
-			minDisks:       1,
-			reportNotFound: false,
-			agreed:         healEntry,
-			partial: func(entries metaCacheEntries, nAgreed int, errs []error) {
-				entry, _ := entries.firstFound()
-				if entry != nil && !entry.isDir() {
-					healEntry(*entry)
-				}
-			},
-			finished: nil,
-		})
-		logger.LogIf(ctx, err)
 	}
+	return false
+}
 
-	return nil
+// healPriorityWindow is the maximum number of entries buffered
+// per bucket when healing in priority mode.
+const healPriorityWindow = 1000
+
+// healPriorityItem is an entry waiting to be healed in priority mode.
+type healPriorityItem struct {
+	entry metaCacheEntry
+	// number of listed drives the entry was found on.
+	available int
+}
+
+// healPriorityQueue is a bounded min-heap of entries ordered by the
+// number of drives they are available on, ties are broken by name.
+// A nil queue is always empty.
+type healPriorityQueue struct {
+	items []healPriorityItem
+	max   int
+}
+
+func newHealPriorityQueue(max int) *healPriorityQueue {
+	return &healPriorityQueue{
+		items: make([]healPriorityItem, 0, max+1),
+		max:   max,
+	}
+}
+
+func (q *healPriorityQueue) Len() int { return len(q.items) }
+
+func (q *healPriorityQueue) Less(i, j int) bool {
+	if q.items[i].available != q.items[j].available {
+		return q.items[i].available < q.items[j].available
+	}
+	return q.items[i].entry.name < q.items[j].entry.name
+}
+
+func (q *healPriorityQueue) Swap(i, j int) { q.items[i], q.items[j] = q.items[j], q.items[i] }
+
+func (q *healPriorityQueue) Push(x interface{}) { q.items = append(q.items, x.(healPriorityItem)) }
+
+func (q *healPriorityQueue) Pop() interface{} {
+	n := len(q.items)
+	item := q.items[n-1]
+	q.items[n-1] = healPriorityItem{}
+	q.items = q.items[:n-1]
+	return item
+}
+
+// push adds the item to the queue, when the queue is full the
+// item with the highest priority is removed and returned.
+func (q *healPriorityQueue) push(item healPriorityItem) (healPriorityItem, bool) {
+	heap.Push(q, item)
+	if q.Len() <= q.max {
+		return healPriorityItem{}, false
+	}
+	return heap.Pop(q).(healPriorityItem), true
+}
+
+// pop removes and returns the item with the highest priority.
+func (q *healPriorityQueue) pop() (healPriorityItem, bool) {
+	if q == nil || q.Len() == 0 {
+		return healPriorityItem{}, false
+	}
+	return heap.Pop(q).(healPriorityItem), true
+}
+
+// minName returns the lexically smallest name in the queue.
+func (q *healPriorityQueue) minName() (name string) {
+	if q == nil {
+		return ""
+	}
+	for _, item := range q.items {
+		if name == "" || item.entry.name < name {
+			name = item.entry.name
+		}
+	}
+	return name
+}
+
+// getHealListingDisks returns the disks to list when healing the set.
+// If all drives of the set are online, including healing drives, all of
+// them are returned so that entries agreed upon by every drive can be
+// skipped. Otherwise up to 3 online non-healing drives are returned and
+// every listed entry must be healed.
+func (er erasureObjects) getHealListingDisks(scanMode madmin.HealScanMode) (disks []StorageAPI, fastSkip bool) {
+	if scanMode != madmin.HealDeepScan {
+		all := er.getDisks()
+		online := 0
+		for _, disk := range all {
+			if disk != nil && disk.IsOnline() {
+				online++
+			}
+		}
+		if online > 0 && online == len(all) {
+			return all, true
+		}
+	}
+
+	disks, _ = er.getOnlineDisksWithHealing()
+	// Limit listing to 3 drives.
+	if len(disks) > 3 {
+		disks = disks[:3]
+	}
+	return disks, false
 }
 
 // healObject heals given object path in deep to fix bitrot.
@@ -200,7 +919,7 @@ func healObject(bucket, object, versionID string, scan madmin.HealScanMode) {
 	// Get background heal sequence to send elements to heal
 	bgSeq, ok := globalBackgroundHealState.getHealSequenceByToken(bgHealingUUID)
 	if ok {
-		bgSeq.sourceCh <- healSource{
+		bgSeq.sourceCh <- bgSeq.queueSource(healSource{
 			bucket:    bucket,
 			object:    object,
 			versionID: versionID,
@@ -208,6 +927,117 @@ func healObject(bucket, object, versionID string, scan madmin.HealScanMode) {
 				Remove:   true, // if found dangling purge it.
 				ScanMode: scan,
 			},
+		}, healQueueSourceRead)
+	}
+}
+
+// queueScannerHeal queues an object selected by the data scanner for a
+// heal check, so the scanner walk also feeds background healing. The
+// object is skipped if the queue stays full, it is selected again in a
+// later scanner cycle.
+func queueScannerHeal(ctx context.Context, bucket, object, versionID string, scan madmin.HealScanMode) {
+	if globalBackgroundHealState == nil {
+		return
+	}
+	bgSeq, ok := globalBackgroundHealState.getHealSequenceByToken(bgHealingUUID)
+	if !ok {
+		return
+	}
+	queued := bgSeq.queueSource(healSource{
+		bucket:    bucket,
+		object:    object,
+		versionID: versionID,
+		opts: &madmin.HealOpts{
+			Remove:   healDeleteDangling,
+			ScanMode: scan,
+		},
+	}, healQueueSourceScanner)
+
+	timer := time.NewTimer(healScannerQueueTimeout)
+	defer timer.Stop()
+	select {
+	case bgSeq.sourceCh <- queued:
+	case <-timer.C:
+		bgSeq.unqueueSource(queued)
+	case <-ctx.Done():
+		bgSeq.unqueueSource(queued)
+	}
+}
+
+// diskErrHeals holds the objects queued for a heal on read errors
+// within diskReadErrWindow, so that an object failing to be read over
+// and over again is queued once.
+type diskErrHeals struct {
+	mu     sync.Mutex
+	queued map[string]time.Time
+}
+
+var globalDiskErrHeals = &diskErrHeals{queued: make(map[string]time.Time)}
+
+// add returns true if the object was not queued within
+// diskReadErrWindow, it is then remembered as queued.
+func (d *diskErrHeals) add(bucket, object string, now time.Time) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	key := pathJoin(bucket, object)
+	if queued, ok := d.queued[key]; ok && now.Sub(queued) <= diskReadErrWindow {
+		return false
+	}
+	if len(d.queued) >= diskErrHealsMax {
+		for k, queued := range d.queued {
+			if now.Sub(queued) > diskReadErrWindow {
+				delete(d.queued, k)
+			}
+		}
+		if len(d.queued) >= diskErrHealsMax {
+			return false
 		}
 	}
+	d.queued[key] = now
+	return true
+}
+
+// remove forgets the object, it is queued again on its next read error.
+func (d *diskErrHeals) remove(bucket, object string) {
+	d.mu.Lock()
+	delete(d.queued, pathJoin(bucket, object))
+	d.mu.Unlock()
+}
+
+// healObjectOnDiskErr is invoked by the storage layer when a disk with
+// a rising rate of read errors failed to serve the given object, the
+// object is deep healed before the disk fails entirely. It does not
+// block the read, the object is skipped if the heal queue is full.
+//
+// Reads of part files do not know the version of the object, an
+// empty versionID heals the latest version only.
+func healObjectOnDiskErr(bucket, object, versionID string) {
+	if globalBackgroundHealState == nil {
+		return
+	}
+	bgSeq, ok := globalBackgroundHealState.getHealSequenceByToken(bgHealingUUID)
+	if !ok {
+		return
+	}
+	if !globalDiskErrHeals.add(bucket, object, UTCNow()) {
+		return
+	}
+	queued := bgSeq.queueSource(healSource{
+		bucket:    bucket,
+		object:    object,
+		versionID: versionID,
+		opts: &madmin.HealOpts{
+			Remove:   true, // if found dangling purge it.
+			ScanMode: madmin.HealDeepScan,
+		},
+	}, healQueueSourceRead)
+
+	select {
+	case bgSeq.sourceCh <- queued:
+		bgSeq.logDiskErrHeal()
+	default:
+		bgSeq.unqueueSource(queued)
+		globalDiskErrHeals.remove(bucket, object)
+	}
 }
@@ -0,0 +1,409 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/minio/minio/cmd/config"
+	"github.com/minio/minio/cmd/config/heal"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// Tests saving, loading and clearing heal checkpoints.
+func TestHealCheckpoint(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	z := obj.(*erasureServerPools)
+	er := z.serverPools[0].sets[0]
+
+	if cp := er.loadHealCheckpoint(ctx, "bucket"); cp.Object != "" {
+		t.Fatalf("expected empty checkpoint, got %#v", cp)
+	}
+
+	er.saveHealCheckpoint(ctx, "bucket", healCheckpoint{Object: "prefix/object", VersionID: "v1", Updated: UTCNow()})
+	cp := er.loadHealCheckpoint(ctx, "bucket")
+	if cp.Object != "prefix/object" || cp.VersionID != "v1" {
+		t.Fatalf("unexpected checkpoint %#v", cp)
+	}
+
+	// Checkpoints are kept per bucket.
+	if cp := er.loadHealCheckpoint(ctx, "other-bucket"); cp.Object != "" {
+		t.Fatalf("expected empty checkpoint, got %#v", cp)
+	}
+
+	er.deleteHealCheckpoint(ctx, "bucket")
+	if cp := er.loadHealCheckpoint(ctx, "bucket"); cp.Object != "" {
+		t.Fatalf("expected checkpoint to be cleared, got %#v", cp)
+	}
+}
+
+// Tests saving, loading and clearing heal round checkpoints.
+func TestHealRoundCheckpoint(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	z := obj.(*erasureServerPools)
+	er := z.serverPools[0].sets[0]
+
+	if cp := er.loadHealRoundCheckpoint(ctx); !cp.Started.IsZero() {
+		t.Fatalf("expected empty round checkpoint, got %#v", cp)
+	}
+
+	// A bucket named like the round checkpoint must not clash with it.
+	er.saveHealCheckpoint(ctx, "set-0.json", healCheckpoint{Object: "object", Updated: UTCNow()})
+
+	er.saveHealRoundCheckpoint(ctx, healRoundCheckpoint{
+		Disk:    "/data1",
+		Buckets: []string{"bucket1", "bucket2"},
+		Started: UTCNow(),
+		Updated: UTCNow(),
+	})
+	cp := er.loadHealRoundCheckpoint(ctx)
+	if cp.Disk != "/data1" || !reflect.DeepEqual(cp.Buckets, []string{"bucket1", "bucket2"}) {
+		t.Fatalf("unexpected round checkpoint %#v", cp)
+	}
+
+	er.deleteHealRoundCheckpoint(ctx)
+	if cp := er.loadHealRoundCheckpoint(ctx); !cp.Started.IsZero() {
+		t.Fatalf("expected round checkpoint to be cleared, got %#v", cp)
+	}
+	if cp := er.loadHealCheckpoint(ctx, "set-0.json"); cp.Object != "object" {
+		t.Fatalf("unexpected checkpoint %#v", cp)
+	}
+}
+
+// Tests the scanned and healed items per type after a heal round.
+func TestHealErasureSetItemsByType(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	saved := globalBackgroundHealState
+	defer func() {
+		globalBackgroundHealState = saved
+	}()
+	globalBackgroundHealState = newHealState(false)
+	h := newBgHealSequence()
+	defer h.cancelCtx()
+	globalBackgroundHealState.healSeqMap[SlashSeparator] = h
+
+	bucket := "bucket"
+	if err = obj.MakeBucketWithLocation(ctx, bucket, BucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		object  string
+		missing bool
+	}{
+		{"healthy1", false},
+		{"healthy2", false},
+		{"missing1", true},
+		{"missing2", true},
+	}
+	data := bytes.Repeat([]byte("a"), 1024)
+	var wantHealed int64
+	for _, testCase := range testCases {
+		if _, err = obj.PutObject(ctx, bucket, testCase.object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		if testCase.missing {
+			if err = os.Remove(pathJoin(fsDirs[0], bucket, testCase.object, xlStorageFormatFile)); err != nil {
+				t.Fatal(err)
+			}
+			wantHealed++
+		}
+	}
+
+	er := obj.(*erasureServerPools).serverPools[0].sets[0]
+	if err = er.healErasureSet(ctx, []BucketInfo{{Name: bucket}}, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	// Objects of the config prefix are scanned along with the bucket.
+	scanned := h.getScannedItemsMap()
+	if scanned[madmin.HealItemObject] < int64(len(testCases)) {
+		t.Errorf("expected at least %d scanned objects, got %v", len(testCases), scanned)
+	}
+	if n := h.getScannedItemsCount(); n != scanned[madmin.HealItemObject] {
+		t.Errorf("expected %d scanned items, got %d", scanned[madmin.HealItemObject], n)
+	}
+	if healed := h.getHealedItemsMap(); healed[madmin.HealItemObject] != wantHealed || len(healed) != 1 {
+		t.Errorf("expected %d healed objects, got %v", wantHealed, healed)
+	}
+	for _, testCase := range testCases {
+		if _, err = os.Stat(pathJoin(fsDirs[0], bucket, testCase.object, xlStorageFormatFile)); err != nil {
+			t.Errorf("%s: expected object to be healed, got %v", testCase.object, err)
+		}
+	}
+}
+
+// Tests that healing of fresh drives only waits for the heal schedule
+// when the schedule applies to drives.
+func TestWaitForHealScheduleDrives(t *testing.T) {
+	globalHealConfigMu.Lock()
+	restoreHealConfig := globalHealConfig
+	globalHealConfigMu.Unlock()
+	defer func() {
+		globalHealConfigMu.Lock()
+		globalHealConfig = restoreHealConfig
+		globalHealConfigMu.Unlock()
+		healScheduleBypass.Lock()
+		healScheduleBypass.checked = time.Time{}
+		healScheduleBypass.Unlock()
+	}()
+
+	// A schedule window starting in an hour.
+	now := time.Now()
+	y, m, d := now.Date()
+	offset := now.Sub(time.Date(y, m, d, 0, 0, 0, 0, now.Location()))
+	outside := heal.Window{
+		Start: (offset + time.Hour) % (24 * time.Hour),
+		End:   (offset + 2*time.Hour) % (24 * time.Hour),
+	}
+	inside := heal.Window{
+		Start: (offset + 23*time.Hour) % (24 * time.Hour),
+		End:   (offset + time.Hour) % (24 * time.Hour),
+	}
+
+	testCases := []struct {
+		schedule       heal.Window
+		scheduleDrives bool
+		freshDrives    bool
+		wait           bool
+	}{
+		{outside, false, true, false},
+		{outside, true, true, true},
+		{outside, false, false, true},
+		{inside, true, true, false},
+		{heal.Window{}, true, true, false},
+	}
+	for i, testCase := range testCases {
+		globalHealConfigMu.Lock()
+		globalHealConfig.Schedule = testCase.schedule
+		globalHealConfig.ScheduleDrives = testCase.scheduleDrives
+		globalHealConfigMu.Unlock()
+		healScheduleBypass.Lock()
+		healScheduleBypass.checked = time.Now()
+		healScheduleBypass.bypass = testCase.freshDrives
+		healScheduleBypass.Unlock()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		waitForHealSchedule(ctx)
+		waited := ctx.Err() != nil
+		cancel()
+		if waited != testCase.wait {
+			t.Errorf("Test %d: expected wait %t, got %t", i+1, testCase.wait, waited)
+		}
+	}
+}
+
+func TestHealPriorityQueue(t *testing.T) {
+	q := newHealPriorityQueue(3)
+	push := func(name string, available int) (string, bool) {
+		item, ok := q.push(healPriorityItem{entry: metaCacheEntry{name: name}, available: available})
+		return item.entry.name, ok
+	}
+	for _, item := range []struct {
+		name      string
+		available int
+	}{{"a", 4}, {"b", 2}, {"c", 3}} {
+		if name, ok := push(item.name, item.available); ok {
+			t.Fatalf("unexpected item %s returned before the queue is full", name)
+		}
+	}
+	if name := q.minName(); name != "a" {
+		t.Fatalf("expected min name a, got %s", name)
+	}
+	// Queue is full, the least available entry is returned.
+	if name, ok := push("d", 1); !ok || name != "d" {
+		t.Fatalf("expected d, got %s", name)
+	}
+	if name, ok := push("e", 3); !ok || name != "b" {
+		t.Fatalf("expected b, got %s", name)
+	}
+
+	var got []string
+	for item, ok := q.pop(); ok; item, ok = q.pop() {
+		got = append(got, item.entry.name)
+	}
+	if want := []string{"c", "e", "a"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	var nilQueue *healPriorityQueue
+	if _, ok := nilQueue.pop(); ok || nilQueue.minName() != "" {
+		t.Fatal("expected nil queue to be empty")
+	}
+}
+
+func TestHealWalkLimiter(t *testing.T) {
+	var l healWalkLimiter
+	ctx := context.Background()
+
+	release1, err := l.acquire(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	release2, err := l.acquire(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// All slots are held, new listings must wait.
+	timedCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if _, err = l.acquire(timedCtx, 2); err != context.DeadlineExceeded {
+		t.Fatalf("expected listing to wait for a free slot, got %v", err)
+	}
+
+	release1()
+	release3, err := l.acquire(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	release2()
+	release3()
+
+	// Resizing only applies to new slots.
+	release4, err := l.acquire(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	timedCtx, cancel = context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if _, err = l.acquire(timedCtx, 1); err != context.DeadlineExceeded {
+		t.Fatalf("expected listing to wait for a free slot, got %v", err)
+	}
+	release4()
+}
+
+// Tests parsing of the number of heal workers and its bounds.
+func TestHealWorkersConfig(t *testing.T) {
+	testCases := []struct {
+		workers string
+		want    int
+		success bool
+	}{
+		{"", runtime.GOMAXPROCS(0), true},
+		{"0", runtime.GOMAXPROCS(0), true},
+		{"1", 1, true},
+		{"16", 16, true},
+		{"-1", 0, false},
+		{"four", 0, false},
+	}
+	for i, testCase := range testCases {
+		kvs := config.KVS{}
+		for _, kv := range heal.DefaultKVS {
+			kvs.Set(kv.Key, kv.Value)
+		}
+		kvs.Set(heal.Workers, testCase.workers)
+
+		cfg, err := heal.LookupConfig(kvs)
+		if testCase.success != (err == nil) {
+			t.Fatalf("Test %d: expected success %t, got %v", i+1, testCase.success, err)
+		}
+		if err != nil {
+			continue
+		}
+		if got := cfg.GetWorkers(); got != testCase.want {
+			t.Errorf("Test %d: expected %d workers, got %d", i+1, testCase.want, got)
+		}
+	}
+}
+
+// Tests throttle settings round trip through the heal config.
+func TestHealThrottleSettings(t *testing.T) {
+	kvs := config.KVS{}
+	for _, kv := range heal.DefaultKVS {
+		kvs.Set(kv.Key, kv.Value)
+	}
+	throttle := madmin.HealThrottle{
+		Sleep:   250 * time.Millisecond,
+		IOCount: 20,
+		Workers: 2,
+		MaxIOPS: 500,
+
+		BucketWorkers: 3,
+	}
+	setHealThrottleSettings(&kvs, throttle)
+
+	cfg, err := heal.LookupConfig(kvs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := healThrottleSettings(cfg); got != throttle {
+		t.Fatalf("expected %+v, got %+v", throttle, got)
+	}
+}
+
+func TestDiskErrHeals(t *testing.T) {
+	d := &diskErrHeals{queued: make(map[string]time.Time)}
+	now := time.Now()
+	if !d.add("bucket", "object", now) {
+		t.Fatal("expected object to be queued")
+	}
+	if d.add("bucket", "object", now.Add(time.Second)) {
+		t.Fatal("expected object not to be queued twice within the window")
+	}
+	if !d.add("bucket", "object", now.Add(2*diskReadErrWindow)) {
+		t.Fatal("expected object to be queued again after the window")
+	}
+	d.remove("bucket", "object")
+	if !d.add("bucket", "object", now.Add(2*diskReadErrWindow)) {
+		t.Fatal("expected a forgotten object to be queued again")
+	}
+
+	// The number of remembered objects is bounded.
+	for i := 0; i < diskErrHealsMax; i++ {
+		d.add("bucket", fmt.Sprintf("object-%d", i), now)
+	}
+	if len(d.queued) > diskErrHealsMax {
+		t.Fatalf("expected at most %d objects, got %d", diskErrHealsMax, len(d.queued))
+	}
+	if !d.add("bucket", "new", now.Add(2*diskReadErrWindow)) {
+		t.Fatal("expected expired objects to be forgotten once full")
+	}
+}
@@ -161,9 +161,12 @@ var (
 	globalLifecycleSys       *LifecycleSys
 	globalBucketSSEConfigSys *BucketSSEConfigSys
 	globalBucketTargetSys    *BucketTargetSys
+	// globalTierConfigMgr holds the remote tiers objects
+	// can be transitioned to by lifecycle rules.
+	globalTierConfigMgr *tierConfigMgr
 	// globalAPIConfig controls S3 API requests throttling,
 	// healthcheck readiness deadlines and cors settings.
-	globalAPIConfig = apiConfig{listQuorum: 3}
+	globalAPIConfig = apiConfig{listQuorum: 3, bucketMetrics: true}
 
 	globalStorageClass storageclass.Config
 	globalLDAPConfig   xldap.Config
@@ -185,6 +188,11 @@ var (
 	// registered listeners
 	globalHTTPTrace = pubsub.New()
 
+	// global Trace systems to send the calls of the storage layer and
+	// the OS calls on local drives to registered listeners
+	globalStorageTrace = pubsub.New()
+	globalOSTrace      = pubsub.New()
+
 	// global Listen system to send S3 API events to registered listeners
 	globalHTTPListen = pubsub.New()
 
@@ -202,6 +210,9 @@ var (
 	// Global HTTP request statisitics
 	globalHTTPStats = newHTTPStats()
 
+	// Global per bucket HTTP request statistics
+	globalBucketHTTPStats = newBucketHTTPStats()
+
 	// Time when the server is started
 	globalBootTime = UTCNow()
 
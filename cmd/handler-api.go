@@ -38,6 +38,7 @@ type apiConfig struct {
 	// total drives per erasure set across pools.
 	totalDriveCount    int
 	replicationWorkers int
+	bucketMetrics      bool
 }
 
 func (t *apiConfig) init(cfg api.Config, setDriveCounts []int) {
@@ -80,6 +81,7 @@ func (t *apiConfig) init(cfg api.Config, setDriveCounts []int) {
 	t.listQuorum = cfg.GetListQuorum()
 	t.extendListLife = cfg.ExtendListLife
 	t.replicationWorkers = cfg.ReplicationWorkers
+	t.bucketMetrics = cfg.BucketMetrics
 }
 
 func (t *apiConfig) getListQuorum() int {
@@ -166,3 +168,10 @@ func (t *apiConfig) getReplicationWorkers() int {
 
 	return t.replicationWorkers
 }
+
+func (t *apiConfig) bucketMetricsEnabled() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.bucketMetrics
+}
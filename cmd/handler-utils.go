@@ -31,6 +31,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/gorilla/mux"
 	xhttp "github.com/minio/minio/cmd/http"
 	"github.com/minio/minio/cmd/logger"
 	"github.com/minio/minio/pkg/auth"
@@ -376,6 +377,17 @@ func httpTraceHdrs(f http.HandlerFunc) http.HandlerFunc {
 	}
 }
 
+// bucketStatsTracked returns true if requests to the bucket are
+// recorded by bucket, requests to buckets which do not exist are not,
+// the bucket names are chosen by clients.
+func bucketStatsTracked(bucket string) bool {
+	if globalBucketMetadataSys == nil {
+		return false
+	}
+	_, err := globalBucketMetadataSys.Get(bucket)
+	return err == nil
+}
+
 func collectAPIStats(api string, f http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		globalHTTPStats.currentS3Requests.Inc(api)
@@ -386,6 +398,10 @@ func collectAPIStats(api string, f http.HandlerFunc) http.HandlerFunc {
 		f.ServeHTTP(statsWriter, r)
 
 		globalHTTPStats.updateStats(api, r, statsWriter)
+
+		if bucket := mux.Vars(r)["bucket"]; bucket != "" && globalAPIConfig.bucketMetricsEnabled() && bucketStatsTracked(bucket) {
+			globalBucketHTTPStats.updateStats(bucket, api, statsWriter)
+		}
 	}
 }
 
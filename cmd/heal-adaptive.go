@@ -0,0 +1,133 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/minio/minio/cmd/config/heal"
+)
+
+const (
+	// Interval between samples of the server load.
+	healAdaptiveInterval = time.Second
+
+	// Smallest delay between heal operations once backing off.
+	healAdaptiveMinDelay = 10 * time.Millisecond
+)
+
+// healAdaptiveThrottle delays heal operations according to the load
+// of the server, the delay doubles while clients are busy or local
+// drives are slow and is halved once the load drops.
+type healAdaptiveThrottle struct {
+	mu      sync.Mutex
+	sampled time.Time
+	delay   time.Duration
+}
+
+// update samples the load with busy at most once per
+// healAdaptiveInterval and returns the adjusted delay,
+// which is never above maxDelay.
+func (t *healAdaptiveThrottle) update(now time.Time, busy func() bool, maxDelay time.Duration) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if now.Sub(t.sampled) >= healAdaptiveInterval {
+		t.sampled = now
+		if busy() {
+			t.delay *= 2
+			if t.delay < healAdaptiveMinDelay {
+				t.delay = healAdaptiveMinDelay
+			}
+		} else {
+			t.delay /= 2
+			if t.delay < healAdaptiveMinDelay {
+				t.delay = 0
+			}
+		}
+	}
+	if t.delay > maxDelay {
+		t.delay = maxDelay
+	}
+	return t.delay
+}
+
+// wait blocks for the current delay between heal operations.
+func (t *healAdaptiveThrottle) wait(ctx context.Context, cfg heal.Config) {
+	delay := t.update(time.Now(), func() bool {
+		return healServerBusy(cfg.IOCount, cfg.MaxLatency)
+	}, cfg.Sleep)
+	if delay <= 0 {
+		return
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
+
+// healServerBusy returns true when this server serves at least maxIO
+// requests or when the read latency of a local drive reaches maxLatency.
+func healServerBusy(maxIO int, maxLatency time.Duration) bool {
+	if maxIO > 0 {
+		if httpServer := newHTTPServerFn(); httpServer != nil {
+			// Bucket notification and http trace are not costly,
+			// ignore them while counting the number of requests.
+			maxIO += int(globalHTTPListen.NumSubscribers()) + int(globalHTTPTrace.NumSubscribers())
+			if httpServer.GetRequestCount() >= maxIO {
+				return true
+			}
+		}
+	}
+	return maxLatency > 0 && localDrivesLatency() >= maxLatency
+}
+
+// localDrivesLatency returns the highest read latency of the local drives.
+func localDrivesLatency() (latency time.Duration) {
+	z, ok := newObjectLayerFn().(*erasureServerPools)
+	if !ok {
+		return 0
+	}
+	for _, pool := range z.serverPools {
+		for _, set := range pool.sets {
+			for _, disk := range set.getDisks() {
+				if disk == nil || !disk.IsLocal() {
+					continue
+				}
+				if d, ok := disk.(*xlStorageDiskIDCheck); ok && d.latency.value() > latency {
+					latency = d.latency.value()
+				}
+			}
+		}
+	}
+	return latency
+}
+
+// waitForHealLoad slows down heal operations according to the heal
+// settings, either adaptively or by waiting for requests to drop.
+func waitForHealLoad(ctx context.Context, cfg heal.Config) {
+	if cfg.Adaptive && globalBackgroundHealState != nil {
+		globalBackgroundHealState.adaptive.wait(ctx, cfg)
+		return
+	}
+	waitForLowHTTPReq(cfg.IOCount, cfg.Sleep)
+}
@@ -0,0 +1,73 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHealAdaptiveThrottle(t *testing.T) {
+	var th healAdaptiveThrottle
+	busy := func() bool { return true }
+	idle := func() bool { return false }
+	maxDelay := 100 * time.Millisecond
+
+	now := time.Now()
+	expected := []time.Duration{10, 20, 40, 80, 100}
+	for i, delay := range expected {
+		now = now.Add(healAdaptiveInterval)
+		if got := th.update(now, busy, maxDelay); got != delay*time.Millisecond {
+			t.Fatalf("Test %d: expected delay %s while busy, got %s", i+1, delay*time.Millisecond, got)
+		}
+	}
+
+	// Load is sampled at most once per interval.
+	if got := th.update(now, idle, maxDelay); got != maxDelay {
+		t.Fatalf("expected delay %s before the next sample, got %s", maxDelay, got)
+	}
+
+	// Lowering the maximum delay applies immediately.
+	if got := th.update(now, idle, 30*time.Millisecond); got != 30*time.Millisecond {
+		t.Fatalf("expected delay capped to 30ms, got %s", got)
+	}
+
+	expected = []time.Duration{15, 0, 0}
+	for i, delay := range expected {
+		now = now.Add(healAdaptiveInterval)
+		if got := th.update(now, idle, maxDelay); got != delay*time.Millisecond {
+			t.Fatalf("Test %d: expected delay %s while idle, got %s", i+1, delay*time.Millisecond, got)
+		}
+	}
+}
+
+func TestDiskLatencyTracker(t *testing.T) {
+	var tracker diskLatencyTracker
+	if tracker.value() != 0 {
+		t.Fatalf("expected no latency, got %s", tracker.value())
+	}
+	for i := 0; i < 100; i++ {
+		tracker.observe(80 * time.Millisecond)
+	}
+	if latency := tracker.value(); latency < 79*time.Millisecond || latency > 80*time.Millisecond {
+		t.Fatalf("expected latency close to 80ms, got %s", latency)
+	}
+	tracker.observe(0)
+	if latency := tracker.value(); latency >= 80*time.Millisecond {
+		t.Fatalf("expected latency to decrease, got %s", latency)
+	}
+}
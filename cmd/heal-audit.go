@@ -0,0 +1,159 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/cmd/logger/message/audit"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// Heal audit outcomes.
+const (
+	healAuditHealed = "healed"
+	healAuditOk     = "ok"
+	healAuditDryRun = "dry-run"
+	healAuditFailed = "failed"
+	healAuditPurged = "purged"
+)
+
+// Triggers of a heal recorded in heal audit entries, items queued
+// for healing are recorded with the origin of the heal queue item.
+const (
+	healAuditSourceRound  = "round"
+	healAuditSourceDrive  = "drive"
+	healAuditSourceRetry  = "retry"
+	healAuditSourceAdmin  = "admin"
+	healAuditSourceQueued = "background"
+)
+
+// healAuditShards holds the number of drives per drive state.
+type healAuditShards struct {
+	Ok      int `json:"ok"`
+	Offline int `json:"offline,omitempty"`
+	Missing int `json:"missing,omitempty"`
+	Corrupt int `json:"corrupt,omitempty"`
+}
+
+func newHealAuditShards(drives []madmin.HealDriveInfo) (shards healAuditShards) {
+	for _, drive := range drives {
+		switch drive.State {
+		case madmin.DriveStateOk:
+			shards.Ok++
+		case madmin.DriveStateOffline:
+			shards.Offline++
+		case madmin.DriveStateMissing:
+			shards.Missing++
+		case madmin.DriveStateCorrupt:
+			shards.Corrupt++
+		}
+	}
+	return shards
+}
+
+func healScanModeString(scanMode madmin.HealScanMode) string {
+	switch scanMode {
+	case madmin.HealNormalScan:
+		return "normal"
+	case madmin.HealDeepScan:
+		return "deep"
+	case madmin.HealMetadataScan:
+		return "metadata"
+	}
+	return "unknown"
+}
+
+// healAuditRepairedDrives returns the endpoints of the drives
+// which were repaired by the heal.
+func healAuditRepairedDrives(res madmin.HealResultItem) (drives []string) {
+	for i, before := range res.Before.Drives {
+		if i >= len(res.After.Drives) {
+			break
+		}
+		switch before.State {
+		case madmin.DriveStateMissing, madmin.DriveStateCorrupt:
+			if res.After.Drives[i].State == madmin.DriveStateOk {
+				drives = append(drives, res.After.Drives[i].Endpoint)
+			}
+		}
+	}
+	return drives
+}
+
+// newHealAuditEntry returns the audit entry of a completed object heal,
+// source is what triggered the heal. A negative pool or set index
+// leaves it out of the entry.
+func newHealAuditEntry(source string, poolIndex, setIndex int, bucket, object, versionID string,
+	opts madmin.HealOpts, res madmin.HealResultItem, err error) audit.Entry {
+	entry := audit.Entry{
+		Version:      audit.Version,
+		DeploymentID: globalDeploymentID,
+		Time:         time.Now().UTC().Format(time.RFC3339Nano),
+	}
+	entry.API.Name = "Heal"
+	entry.API.Bucket = bucket
+	entry.API.Object = object
+
+	result := healAuditOk
+	switch {
+	case res.Detail == healDetailDanglingPurged:
+		result = healAuditPurged
+	case err != nil:
+		result = healAuditFailed
+		entry.API.Status = err.Error()
+	case opts.DryRun:
+		result = healAuditDryRun
+	case healResultNeedsHeal(res):
+		result = healAuditHealed
+	}
+
+	entry.Tags = map[string]interface{}{
+		"versionId": versionID,
+		"source":    source,
+		"scanMode":  healScanModeString(opts.ScanMode),
+		"result":    result,
+		"before":    newHealAuditShards(res.Before.Drives),
+		"after":     newHealAuditShards(res.After.Drives),
+	}
+	if poolIndex >= 0 && setIndex >= 0 {
+		entry.Tags["poolId"] = poolIndex + 1
+		entry.Tags["setId"] = setIndex + 1
+	}
+	if result == healAuditHealed {
+		entry.Tags["drives"] = healAuditRepairedDrives(res)
+	}
+	return entry
+}
+
+// auditHealObject sends the audit entry of a completed object heal to
+// the audit targets, targets buffer entries so healing never blocks.
+// Objects which were deleted before they could be healed are skipped.
+func auditHealObject(source string, poolIndex, setIndex int, bucket, object, versionID string,
+	opts madmin.HealOpts, res madmin.HealResultItem, err error) {
+	if len(logger.AuditTargets) == 0 {
+		return
+	}
+	if (isErrObjectNotFound(err) || isErrVersionNotFound(err)) && res.Detail != healDetailDanglingPurged {
+		return
+	}
+	entry := newHealAuditEntry(source, poolIndex, setIndex, bucket, object, versionID, opts, res, err)
+	for _, t := range logger.AuditTargets {
+		_ = t.Send(entry, string(logger.All))
+	}
+}
@@ -0,0 +1,96 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestNewHealAuditEntry(t *testing.T) {
+	res := madmin.HealResultItem{
+		Before: struct {
+			Drives []madmin.HealDriveInfo `json:"drives"`
+		}{Drives: []madmin.HealDriveInfo{
+			{Endpoint: "/disk1", State: madmin.DriveStateOk},
+			{Endpoint: "/disk2", State: madmin.DriveStateMissing},
+			{Endpoint: "/disk3", State: madmin.DriveStateCorrupt},
+			{Endpoint: "/disk4", State: madmin.DriveStateOffline},
+		}},
+		After: struct {
+			Drives []madmin.HealDriveInfo `json:"drives"`
+		}{Drives: []madmin.HealDriveInfo{
+			{Endpoint: "/disk1", State: madmin.DriveStateOk},
+			{Endpoint: "/disk2", State: madmin.DriveStateOk},
+			{Endpoint: "/disk3", State: madmin.DriveStateOk},
+			{Endpoint: "/disk4", State: madmin.DriveStateOffline},
+		}},
+	}
+
+	testCases := []struct {
+		opts   madmin.HealOpts
+		res    madmin.HealResultItem
+		err    error
+		result string
+		status string
+	}{
+		{opts: madmin.HealOpts{ScanMode: madmin.HealDeepScan}, res: res, result: healAuditHealed},
+		{opts: madmin.HealOpts{DryRun: true}, res: res, result: healAuditDryRun},
+		{opts: madmin.HealOpts{}, result: healAuditOk},
+		{opts: madmin.HealOpts{}, err: errors.New("heal failed"), result: healAuditFailed, status: "heal failed"},
+		{opts: madmin.HealOpts{Remove: true}, res: madmin.HealResultItem{Detail: healDetailDanglingPurged},
+			err: ObjectNotFound{Bucket: "bucket", Object: "object"}, result: healAuditPurged},
+	}
+
+	for i, testCase := range testCases {
+		entry := newHealAuditEntry(healAuditSourceRound, 1, 2, "bucket", "object", "version", testCase.opts, testCase.res, testCase.err)
+		if entry.API.Name != "Heal" || entry.API.Bucket != "bucket" || entry.API.Object != "object" {
+			t.Errorf("Test %d: unexpected API %#v", i+1, entry.API)
+		}
+		if entry.API.Status != testCase.status {
+			t.Errorf("Test %d: expected status %q, got %q", i+1, testCase.status, entry.API.Status)
+		}
+		if entry.Tags["result"] != testCase.result {
+			t.Errorf("Test %d: expected result %q, got %v", i+1, testCase.result, entry.Tags["result"])
+		}
+		if entry.Tags["poolId"] != 2 || entry.Tags["setId"] != 3 || entry.Tags["versionId"] != "version" || entry.Tags["source"] != healAuditSourceRound {
+			t.Errorf("Test %d: unexpected tags %v", i+1, entry.Tags)
+		}
+	}
+
+	entry := newHealAuditEntry(healQueueSourceMRF, -1, -1, "bucket", "object", "", madmin.HealOpts{ScanMode: madmin.HealDeepScan}, res, nil)
+	if _, ok := entry.Tags["poolId"]; ok {
+		t.Errorf("expected no pool for an unknown erasure set, got %v", entry.Tags)
+	}
+	if drives := entry.Tags["drives"]; !reflect.DeepEqual(drives, []string{"/disk2", "/disk3"}) {
+		t.Errorf("expected repaired drives /disk2 and /disk3, got %v", drives)
+	}
+	if entry.Tags["scanMode"] != "deep" {
+		t.Errorf("expected deep scan mode, got %v", entry.Tags["scanMode"])
+	}
+	before := healAuditShards{Ok: 1, Offline: 1, Missing: 1, Corrupt: 1}
+	if entry.Tags["before"] != before {
+		t.Errorf("expected before %v, got %v", before, entry.Tags["before"])
+	}
+	after := healAuditShards{Ok: 3, Offline: 1}
+	if entry.Tags["after"] != after {
+		t.Errorf("expected after %v, got %v", after, entry.Tags["after"])
+	}
+}
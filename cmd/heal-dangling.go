@@ -0,0 +1,201 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cmd
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// Multipart uploads younger than this are never considered
+// dangling, their metadata may still be written.
+const healDanglingUploadGrace = time.Hour
+
+// healDanglingKind returns the kind of dangling entry of an object
+// version which cannot be reconstructed, metadata when its xl.meta
+// is held by fewer drives than read quorum.
+func healDanglingKind(m FileInfo, errs []error) string {
+	readQuorum := m.Erasure.DataBlocks
+	if m.Deleted {
+		readQuorum = len(errs) / 2
+	}
+	if readQuorum == 0 || countErrs(errs, nil) < readQuorum {
+		return madmin.HealDanglingMetadata
+	}
+	return madmin.HealDanglingVersion
+}
+
+// healDanglingUploads looks for the multipart uploads of the erasure
+// set whose xl.meta is missing or corrupted on more drives than the
+// parity of the upload, their parts can never be completed. fn is
+// called with every upload found, which is removed if purge is set.
+func (er erasureObjects) healDanglingUploads(ctx context.Context, purge bool, fn func(madmin.HealResultItem) error) error {
+	storageDisks := er.getDisks()
+	storageEndpoints := er.getEndpoints()
+
+	// Uploads present on any drive, as leftover parts may be
+	// all that remains of an upload.
+	uploads := make(map[string]struct{})
+	for _, disk := range storageDisks {
+		if disk == nil {
+			continue
+		}
+		shaDirs, err := disk.ListDir(ctx, minioMetaMultipartBucket, "", -1)
+		if err != nil {
+			continue
+		}
+		for _, shaDir := range shaDirs {
+			uploadIDs, err := disk.ListDir(ctx, minioMetaMultipartBucket, shaDir, -1)
+			if err != nil {
+				continue
+			}
+			for _, uploadID := range uploadIDs {
+				uploads[pathJoin(shaDir, strings.TrimSuffix(uploadID, SlashSeparator))] = struct{}{}
+			}
+		}
+	}
+	uploadIDPaths := make([]string, 0, len(uploads))
+	for uploadIDPath := range uploads {
+		uploadIDPaths = append(uploadIDPaths, uploadIDPath)
+	}
+	sort.Strings(uploadIDPaths)
+
+	for _, uploadIDPath := range uploadIDPaths {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		partsMetadata, errs := readAllFileInfo(ctx, storageDisks, minioMetaMultipartBucket, uploadIDPath, "", false)
+
+		parityBlocks := er.defaultParityCount
+		var modTime time.Time
+		for _, m := range partsMetadata {
+			if m.IsValid() {
+				parityBlocks = m.Erasure.ParityBlocks
+				modTime = m.ModTime
+				break
+			}
+		}
+		// Offline drives may still hold the upload, only
+		// drives which lost it count against the parity.
+		var missing int
+		for _, err := range errs {
+			if errors.Is(err, errFileNotFound) || errors.Is(err, errCorruptedFormat) {
+				missing++
+			}
+		}
+		if missing <= parityBlocks {
+			continue
+		}
+		if modTime.IsZero() {
+			for _, disk := range storageDisks {
+				if disk == nil {
+					continue
+				}
+				if vi, err := disk.StatVol(ctx, pathJoin(minioMetaMultipartBucket, uploadIDPath)); err == nil {
+					modTime = vi.Created
+					break
+				}
+			}
+		}
+		if modTime.IsZero() || UTCNow().Sub(modTime) < healDanglingUploadGrace {
+			continue
+		}
+
+		result := defaultHealResult(FileInfo{}, storageDisks, storageEndpoints, errs, minioMetaMultipartBucket, uploadIDPath, "", er.defaultParityCount)
+		result.Dangling = madmin.HealDanglingUpload
+		if purge {
+			er.deleteAll(ctx, minioMetaMultipartBucket, uploadIDPath)
+			result.Detail = healDetailDanglingPurged
+		}
+		if err := fn(result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// healDanglingUploads looks for dangling multipart uploads in all
+// erasure sets selected by the heal options.
+func (z *erasureServerPools) healDanglingUploads(ctx context.Context, opts madmin.HealOpts, purge bool, fn func(madmin.HealResultItem) error) error {
+	for poolIndex, pool := range z.serverPools {
+		for setIndex, set := range pool.sets {
+			if !opts.MatchesSet(poolIndex, setIndex) {
+				continue
+			}
+			if err := set.healDanglingUploads(ctx, purge, fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// logDangling records a dangling entry in the counts of its bucket.
+func (h *healSequence) logDangling(res madmin.HealResultItem) {
+	if res.Dangling == "" {
+		return
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if h.currentStatus.Dangling == nil {
+		h.currentStatus.Dangling = make(map[string]madmin.HealDanglingCounts)
+	}
+	counts := h.currentStatus.Dangling[res.Bucket]
+	switch res.Dangling {
+	case madmin.HealDanglingVersion:
+		counts.Versions++
+	case madmin.HealDanglingMetadata:
+		counts.Metadata++
+	case madmin.HealDanglingUpload:
+		counts.Uploads++
+	}
+	if res.Detail == healDetailDanglingPurged {
+		counts.Purged++
+	}
+	h.currentStatus.Dangling[res.Bucket] = counts
+}
+
+// healDanglingUploads reports the dangling multipart uploads of all
+// erasure sets, and purges them if requested.
+func (h *healSequence) healDanglingUploads(objAPI ObjectLayer) error {
+	z, ok := objAPI.(*erasureServerPools)
+	if !ok {
+		return nil
+	}
+
+	globalHealConfigMu.Lock()
+	cfg := globalHealConfig
+	globalHealConfigMu.Unlock()
+
+	purge := h.settings.PurgeDangling && !h.healDryRun(cfg)
+	return z.healDanglingUploads(h.ctx, h.settings, purge, func(res madmin.HealResultItem) error {
+		if h.isQuitting() {
+			return errHealStopSignalled
+		}
+		h.logDangling(res)
+		if !h.reportProgress {
+			return nil
+		}
+		return h.pushHealResultItem(res)
+	})
+}
@@ -0,0 +1,130 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestHealDanglingKind(t *testing.T) {
+	fi := FileInfo{Erasure: ErasureInfo{DataBlocks: 2, ParityBlocks: 2}}
+	testCases := []struct {
+		fi       FileInfo
+		errs     []error
+		expected string
+	}{
+		{fi, []error{nil, nil, errFileNotFound, errFileNotFound}, madmin.HealDanglingVersion},
+		{fi, []error{nil, errFileNotFound, errFileNotFound, errDiskNotFound}, madmin.HealDanglingMetadata},
+		{FileInfo{}, []error{nil, nil, nil, nil}, madmin.HealDanglingMetadata},
+		{FileInfo{Deleted: true}, []error{nil, nil, errFileNotFound, errFileNotFound}, madmin.HealDanglingVersion},
+		{FileInfo{Deleted: true}, []error{nil, errFileNotFound, errFileNotFound, errFileNotFound}, madmin.HealDanglingMetadata},
+	}
+	for i, testCase := range testCases {
+		if got := healDanglingKind(testCase.fi, testCase.errs); got != testCase.expected {
+			t.Errorf("Test %d: expected %s, got %s", i+1, testCase.expected, got)
+		}
+	}
+}
+
+func TestHealDanglingUploads(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nDisks := 4
+	fsDirs, err := getRandomDisks(nDisks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer removeRoots(fsDirs)
+
+	objLayer, _, err := initObjectLayer(ctx, mustGetPoolEndpoints(fsDirs...))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bucket := "bucket"
+	object := "object"
+	if err = objLayer.MakeBucketWithLocation(ctx, bucket, BucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	uploadID, err := objLayer.NewMultipartUpload(ctx, bucket, object, ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := bytes.Repeat([]byte("a"), 1024)
+	if _, err = objLayer.PutObjectPart(ctx, bucket, object, uploadID, 1, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	z := objLayer.(*erasureServerPools)
+	set := z.serverPools[0].sets[0]
+	uploadIDPath := set.getUploadIDDir(bucket, object, uploadID)
+
+	var found []madmin.HealResultItem
+	collect := func(res madmin.HealResultItem) error {
+		found = append(found, res)
+		return nil
+	}
+
+	// A healthy upload is not dangling.
+	if err = z.healDanglingUploads(ctx, madmin.HealOpts{}, true, collect); err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 0 {
+		t.Fatalf("expected no dangling upload, got %v", found)
+	}
+
+	// Leave the parts of the upload without their metadata.
+	old := time.Now().Add(-2 * healDanglingUploadGrace)
+	for _, dir := range fsDirs {
+		uploadDir := filepath.Join(dir, minioMetaMultipartBucket, uploadIDPath)
+		if err = os.Remove(filepath.Join(uploadDir, xlStorageFormatFile)); err != nil {
+			t.Fatal(err)
+		}
+		if err = os.Chtimes(uploadDir, old, old); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = z.healDanglingUploads(ctx, madmin.HealOpts{}, false, collect); err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 1 || found[0].Dangling != madmin.HealDanglingUpload || found[0].Object != uploadIDPath {
+		t.Fatalf("expected dangling upload %s, got %v", uploadIDPath, found)
+	}
+
+	found = nil
+	if err = z.healDanglingUploads(ctx, madmin.HealOpts{}, true, collect); err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 1 || found[0].Detail != healDetailDanglingPurged {
+		t.Fatalf("expected purged dangling upload, got %v", found)
+	}
+	for _, dir := range fsDirs {
+		if _, err = os.Stat(filepath.Join(dir, minioMetaMultipartBucket, uploadIDPath)); !os.IsNotExist(err) {
+			t.Fatalf("expected upload to be purged from %s, got %v", dir, err)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// Maximum number of objects in the dead-letter list of a server.
+const healDeadLettersMax = 10000
+
+// healDeadLetterList holds the objects which background healing
+// could not heal after all retries, saved to survive a restart.
+type healDeadLetterList struct {
+	Items []madmin.HealDeadLetterItem `json:"items"`
+}
+
+// healDeadLettersPath returns the path of the dead-letter
+// list of this server.
+func healDeadLettersPath() string {
+	node := getSHA256Hash([]byte(GetLocalPeer(globalEndpoints)))
+	return pathJoin(healCheckpointPrefix, "dead-letters", node+".json")
+}
+
+// addHealDeadLetter adds an object which exhausted its retries to
+// the dead-letter list, if the list is full the oldest object is
+// evicted. Caller must hold h.mutex.
+func (h *healSequence) addHealDeadLetter(r *healRetry) {
+	if h.deadLetters == nil {
+		h.deadLetters = make(map[string]madmin.HealDeadLetterItem)
+	}
+	now := UTCNow()
+	key := healFailedItemKey(r.source.bucket, r.source.object, r.source.versionID)
+	item, found := h.deadLetters[key]
+	if !found {
+		if len(h.deadLetters) >= healDeadLettersMax {
+			var oldestKey string
+			for k, v := range h.deadLetters {
+				if oldestKey == "" || v.LastAttempt.Before(h.deadLetters[oldestKey].LastAttempt) {
+					oldestKey = k
+				}
+			}
+			delete(h.deadLetters, oldestKey)
+		}
+		item = madmin.HealDeadLetterItem{
+			Bucket:       r.source.bucket,
+			Object:       r.source.object,
+			VersionID:    r.source.versionID,
+			PoolIndex:    r.poolIndex,
+			SetIndex:     r.setIndex,
+			FirstFailure: now,
+		}
+	}
+	item.Attempts += r.attempts
+	item.Reason = r.err.Error()
+	item.LastAttempt = now
+	h.deadLetters[key] = item
+	h.deadLettersDirty = true
+}
+
+// removeHealDeadLetter removes an object from the dead-letter list
+// once it is healed. Caller must hold h.mutex.
+func (h *healSequence) removeHealDeadLetter(bucket, object, versionID string) {
+	key := healFailedItemKey(bucket, object, versionID)
+	if _, ok := h.deadLetters[key]; ok {
+		delete(h.deadLetters, key)
+		h.deadLettersDirty = true
+	}
+}
+
+// forgetHealFailure forgets the failures of an object which was
+// healed by a later heal round.
+func (h *healSequence) forgetHealFailure(bucket, object, versionID string) {
+	key := healFailedItemKey(bucket, object, versionID)
+	h.mutex.RLock()
+	_, failed := h.healFailedItems[key]
+	_, dead := h.deadLetters[key]
+	h.mutex.RUnlock()
+	if !failed && !dead {
+		return
+	}
+	h.mutex.Lock()
+	h.removeHealFailedItem(bucket, object, versionID)
+	h.mutex.Unlock()
+}
+
+// getHealDeadLetters returns the dead-letter list, latest first.
+func (h *healSequence) getHealDeadLetters() []madmin.HealDeadLetterItem {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	items := make([]madmin.HealDeadLetterItem, 0, len(h.deadLetters))
+	for _, item := range h.deadLetters {
+		items = append(items, item)
+	}
+	sortHealDeadLetters(items)
+	return items
+}
+
+// loadHealDeadLetters restores the saved dead-letter list, objects
+// which failed again since the start are kept as they are.
+func (h *healSequence) loadHealDeadLetters(ctx context.Context, objAPI ObjectLayer) {
+	data, err := readConfig(ctx, objAPI, healDeadLettersPath())
+	if err != nil {
+		if !errors.Is(err, errConfigNotFound) {
+			logger.LogIf(ctx, err)
+		}
+		return
+	}
+	var list healDeadLetterList
+	if err = json.Unmarshal(data, &list); err != nil {
+		logger.LogIf(ctx, err)
+		return
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.deadLetters == nil {
+		h.deadLetters = make(map[string]madmin.HealDeadLetterItem)
+	}
+	for _, item := range list.Items {
+		if len(h.deadLetters) >= healDeadLettersMax {
+			break
+		}
+		key := healFailedItemKey(item.Bucket, item.Object, item.VersionID)
+		if _, ok := h.deadLetters[key]; !ok {
+			h.deadLetters[key] = item
+		}
+	}
+}
+
+// saveHealDeadLetters saves the dead-letter list if it was modified
+// since it was last saved, the saved list is removed once empty.
+func (h *healSequence) saveHealDeadLetters(ctx context.Context, objAPI ObjectLayer) {
+	h.mutex.Lock()
+	if !h.deadLettersDirty {
+		h.mutex.Unlock()
+		return
+	}
+	list := healDeadLetterList{
+		Items: make([]madmin.HealDeadLetterItem, 0, len(h.deadLetters)),
+	}
+	for _, item := range h.deadLetters {
+		list.Items = append(list.Items, item)
+	}
+	h.deadLettersDirty = false
+	h.mutex.Unlock()
+
+	if len(list.Items) == 0 {
+		if err := deleteConfig(ctx, objAPI, healDeadLettersPath()); err != nil && !errors.Is(err, errConfigNotFound) {
+			logger.LogIf(ctx, err)
+		}
+		return
+	}
+	sortHealDeadLetters(list.Items)
+	data, err := json.Marshal(list)
+	if err != nil {
+		logger.LogIf(ctx, err)
+		return
+	}
+	logger.LogIf(ctx, saveConfig(ctx, objAPI, healDeadLettersPath(), data))
+}
+
+// getLocalBackgroundHealDeadLetters returns the dead-letter list
+// of the background heal sequence of this server.
+func getLocalBackgroundHealDeadLetters() ([]madmin.HealDeadLetterItem, bool) {
+	if globalBackgroundHealState == nil {
+		return nil, false
+	}
+	bgSeq, ok := globalBackgroundHealState.getHealSequenceByToken(bgHealingUUID)
+	if !ok {
+		return nil, false
+	}
+	items := bgSeq.getHealDeadLetters()
+	node := GetLocalPeer(globalEndpoints)
+	for i := range items {
+		items[i].Node = node
+	}
+	return items, true
+}
+
+// sortHealDeadLetters orders the dead-letter list, latest first.
+func sortHealDeadLetters(items []madmin.HealDeadLetterItem) {
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].LastAttempt.After(items[j].LastAttempt)
+	})
+}
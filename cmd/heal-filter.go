@@ -0,0 +1,105 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cmd
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/minio/minio/cmd/config/heal"
+	xhttp "github.com/minio/minio/cmd/http"
+	"github.com/minio/minio/pkg/bucket/lifecycle"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// healFilter returns the heal options holding the object filters of
+// the heal sequence, background healing follows the heal configuration.
+func (h *healSequence) healFilter(cfg heal.Config) madmin.HealOpts {
+	opts := h.settings
+	if h.clientToken != bgHealingUUID {
+		return opts
+	}
+	if len(opts.IncludePrefixes) == 0 && len(opts.ExcludePrefixes) == 0 {
+		opts.IncludePrefixes = cfg.IncludePrefixes
+		opts.ExcludePrefixes = cfg.ExcludePrefixes
+	}
+	if len(opts.IncludeTags) == 0 && len(opts.ExcludeTags) == 0 {
+		opts.IncludeTags = cfg.IncludeTags
+		opts.ExcludeTags = cfg.ExcludeTags
+	}
+	return opts
+}
+
+// healSkipVersion returns true if the object version is filtered out
+// by the heal options, tags are not checked in the reserved buckets.
+func healSkipVersion(opts madmin.HealOpts, bucket string, fi FileInfo) bool {
+	if healSkipObject(opts, bucket, fi.Name) || !opts.MatchesModTime(fi.ModTime) {
+		return true
+	}
+	if len(opts.IncludeTags) == 0 && len(opts.ExcludeTags) == 0 || isMinioMetaBucketName(bucket) {
+		return false
+	}
+	return !opts.MatchesTags(healObjectTags(fi))
+}
+
+// healLifecycle returns the lifecycle configuration consulted to skip
+// expiring object versions of the bucket, nil if there is none or
+// expiring versions are healed.
+func healLifecycle(bucket string, horizon time.Duration) *lifecycle.Lifecycle {
+	if horizon <= 0 || globalLifecycleSys == nil || isMinioMetaBucketName(bucket) {
+		return nil
+	}
+	lc, err := globalLifecycleSys.Get(bucket)
+	if err != nil {
+		return nil
+	}
+	return lc
+}
+
+// healSkipExpiring returns true if a rule of the lifecycle configuration
+// expires the object version before now plus the horizon, healing it
+// would spend IO on data about to be removed.
+func healSkipExpiring(lc *lifecycle.Lifecycle, fi FileInfo, horizon time.Duration, now time.Time) bool {
+	if lc == nil || horizon <= 0 || fi.Deleted {
+		return false
+	}
+	_, expiry := lc.PredictExpiryTime(lifecycle.ObjectOpts{
+		Name:             fi.Name,
+		UserTags:         fi.Metadata[xhttp.AmzObjectTagging],
+		ModTime:          fi.ModTime,
+		VersionID:        fi.VersionID,
+		IsLatest:         fi.IsLatest,
+		SuccessorModTime: fi.SuccessorModTime,
+	})
+	return !expiry.IsZero() && expiry.Before(now.Add(horizon))
+}
+
+// healObjectTags returns the tags of the object version.
+func healObjectTags(fi FileInfo) map[string]string {
+	tagStr := fi.Metadata[xhttp.AmzObjectTagging]
+	if tagStr == "" {
+		return nil
+	}
+	values, err := url.ParseQuery(tagStr)
+	if err != nil {
+		return nil
+	}
+	tags := make(map[string]string, len(values))
+	for k := range values {
+		tags[k] = values.Get(k)
+	}
+	return tags
+}
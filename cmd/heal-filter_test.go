@@ -0,0 +1,117 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/minio/cmd/config/heal"
+	xhttp "github.com/minio/minio/cmd/http"
+	"github.com/minio/minio/pkg/bucket/lifecycle"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestHealFilter(t *testing.T) {
+	cfg := heal.Config{
+		ExcludePrefixes: []string{"tmp/"},
+		ExcludeTags:     map[string]string{"tier": "tmp"},
+	}
+
+	bg := &healSequence{clientToken: bgHealingUUID}
+	if filter := bg.healFilter(cfg); len(filter.ExcludePrefixes) != 1 || filter.ExcludeTags["tier"] != "tmp" {
+		t.Errorf("Expected background heal to follow the heal configuration, got %#v", filter)
+	}
+
+	bg.settings.IncludePrefixes = []string{"archive/"}
+	if filter := bg.healFilter(cfg); len(filter.ExcludePrefixes) != 0 || len(filter.IncludePrefixes) != 1 {
+		t.Errorf("Expected background heal prefixes to take precedence, got %#v", filter)
+	}
+
+	seq := &healSequence{clientToken: mustGetUUID()}
+	if filter := seq.healFilter(cfg); len(filter.ExcludePrefixes) != 0 || len(filter.ExcludeTags) != 0 {
+		t.Errorf("Expected heal sequence to ignore the heal configuration, got %#v", filter)
+	}
+}
+
+func TestHealSkipVersion(t *testing.T) {
+	modTime := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	tagged := func(name, tags string) FileInfo {
+		fi := FileInfo{Name: name, ModTime: modTime}
+		if tags != "" {
+			fi.Metadata = map[string]string{xhttp.AmzObjectTagging: tags}
+		}
+		return fi
+	}
+	opts := madmin.HealOpts{
+		ExcludePrefixes: []string{"tmp/", "*/scratch/*"},
+		ExcludeTags:     map[string]string{"tier": "tmp"},
+	}
+	testCases := []struct {
+		opts     madmin.HealOpts
+		bucket   string
+		fi       FileInfo
+		expected bool
+	}{
+		{madmin.HealOpts{}, "bucket", tagged("tmp/a", "tier=tmp"), false},
+		{opts, "bucket", tagged("tmp/a", ""), true},
+		{opts, "bucket", tagged("2021/scratch/a", ""), true},
+		{opts, "bucket", tagged("archive/a", ""), false},
+		{opts, "bucket", tagged("archive/a", "tier=tmp&app=x"), true},
+		{opts, "bucket", tagged("archive/a", "tier=gold"), false},
+		{madmin.HealOpts{IncludeTags: map[string]string{"tier": "gold"}}, "bucket", tagged("a", ""), true},
+		{madmin.HealOpts{IncludeTags: map[string]string{"tier": "gold"}}, "bucket", tagged("a", "tier=gold"), false},
+		{madmin.HealOpts{ModifiedAfter: modTime}, "bucket", tagged("a", ""), true},
+		// Reserved buckets are never filtered by prefix or tags.
+		{opts, minioMetaBucket, tagged("tmp/a", "tier=tmp"), false},
+	}
+	for i, testCase := range testCases {
+		if got := healSkipVersion(testCase.opts, testCase.bucket, testCase.fi); got != testCase.expected {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.expected, got)
+		}
+	}
+}
+
+func TestHealSkipExpiring(t *testing.T) {
+	lc, err := lifecycle.ParseLifecycleConfig(strings.NewReader(`<LifecycleConfiguration><Rule><ID>logs</ID><Status>Enabled</Status><Filter><Prefix>logs/</Prefix></Filter><Expiration><Days>1</Days></Expiration></Rule></LifecycleConfiguration>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	version := func(name string) FileInfo {
+		return FileInfo{Name: name, ModTime: now, IsLatest: true}
+	}
+	testCases := []struct {
+		lc       *lifecycle.Lifecycle
+		fi       FileInfo
+		horizon  time.Duration
+		expected bool
+	}{
+		{nil, version("logs/a"), 48 * time.Hour, false},
+		{lc, version("logs/a"), 0, false},
+		{lc, version("logs/a"), 48 * time.Hour, true},
+		{lc, version("logs/a"), time.Hour, false},
+		{lc, version("data/a"), 48 * time.Hour, false},
+		{lc, FileInfo{Name: "logs/a", ModTime: now, IsLatest: true, Deleted: true}, 48 * time.Hour, false},
+	}
+	for i, testCase := range testCases {
+		if got := healSkipExpiring(testCase.lc, testCase.fi, testCase.horizon, now); got != testCase.expected {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.expected, got)
+		}
+	}
+}
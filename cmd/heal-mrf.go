@@ -0,0 +1,220 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/minio/minio/cmd/logger"
+)
+
+const (
+	// Maximum number of objects in the MRF list of a pool.
+	mrfOperationsMax = 10000
+
+	// Interval between two sweeps of the MRF list, objects of
+	// erasure sets with all drives online are healed and the
+	// list is saved if modified.
+	mrfSweepInterval = 30 * time.Second
+)
+
+// healMRFEntry is an object version written or deleted
+// without reaching all drives of its erasure set.
+type healMRFEntry struct {
+	Bucket    string `json:"bucket"`
+	Object    string `json:"object"`
+	VersionID string `json:"versionId,omitempty"`
+}
+
+// healMRFList holds the MRF entries of an erasure set,
+// saved to survive a restart before they are healed.
+type healMRFList struct {
+	Entries []healMRFEntry `json:"entries"`
+}
+
+func (er *erasureObjects) healMRFListPath() string {
+	return pathJoin(healCheckpointPrefix, fmt.Sprintf("pool-%d", er.poolIndex),
+		fmt.Sprintf("mrf-set-%d.json", er.setNumber))
+}
+
+// loadHealMRFList returns the saved MRF entries of the erasure set.
+func (er *erasureObjects) loadHealMRFList(ctx context.Context) healMRFList {
+	var list healMRFList
+	data, err := readConfig(ctx, er, er.healMRFListPath())
+	if err != nil {
+		if !errors.Is(err, errConfigNotFound) {
+			logger.LogIf(ctx, err)
+		}
+		return list
+	}
+	if err = json.Unmarshal(data, &list); err != nil {
+		logger.LogIf(ctx, err)
+		return healMRFList{}
+	}
+	return list
+}
+
+// saveHealMRFList saves the MRF entries of the erasure set,
+// the saved list is removed when there are no entries.
+func (er *erasureObjects) saveHealMRFList(ctx context.Context, list healMRFList) {
+	if len(list.Entries) == 0 {
+		if err := deleteConfig(ctx, er, er.healMRFListPath()); err != nil && !errors.Is(err, errConfigNotFound) {
+			logger.LogIf(ctx, err)
+		}
+		return
+	}
+	data, err := json.Marshal(list)
+	if err != nil {
+		logger.LogIf(ctx, err)
+		return
+	}
+	logger.LogIf(ctx, saveConfig(ctx, er, er.healMRFListPath(), data))
+}
+
+// addMRFOperation adds an object to the MRF list of the pool,
+// returns false if the list is full.
+func (s *erasureSets) addMRFOperation(u healSource, setIndex int) bool {
+	s.mrfMU.Lock()
+	defer s.mrfMU.Unlock()
+
+	if _, ok := s.mrfOperations[u]; !ok && len(s.mrfOperations) >= mrfOperationsMax {
+		return false
+	}
+	s.mrfOperations[u] = setIndex
+	// Written again, heal once more even if already queued.
+	delete(s.mrfHealing, u)
+	s.mrfDirty = true
+	return true
+}
+
+// finishMRFOperation removes a healed object from the MRF list,
+// an interrupted object stays in the list to be queued again.
+func (s *erasureSets) finishMRFOperation(source healSource, err error) {
+	u := healSource{
+		bucket:    source.bucket,
+		object:    source.object,
+		versionID: source.versionID,
+	}
+
+	s.mrfMU.Lock()
+	defer s.mrfMU.Unlock()
+
+	if _, ok := s.mrfHealing[u]; !ok {
+		// Added again since it was queued.
+		return
+	}
+	delete(s.mrfHealing, u)
+	if err == errHealInterrupted {
+		return
+	}
+	delete(s.mrfOperations, u)
+	s.mrfDirty = true
+}
+
+// loadMRFList restores the MRF entries saved by all erasure sets.
+func (s *erasureSets) loadMRFList(ctx context.Context) {
+	for setIndex, er := range s.sets {
+		for _, e := range er.loadHealMRFList(ctx).Entries {
+			s.addMRFOperation(healSource{
+				bucket:    e.Bucket,
+				object:    e.Object,
+				versionID: e.VersionID,
+			}, setIndex)
+		}
+	}
+	// Nothing changed compared to the saved lists.
+	s.mrfMU.Lock()
+	s.mrfDirty = false
+	s.mrfMU.Unlock()
+}
+
+// saveMRFList saves the MRF entries of all erasure sets
+// if the list was modified since it was last saved.
+func (s *erasureSets) saveMRFList(ctx context.Context) {
+	s.mrfMU.Lock()
+	if !s.mrfDirty {
+		s.mrfMU.Unlock()
+		return
+	}
+	lists := make([]healMRFList, len(s.sets))
+	for u, setIndex := range s.mrfOperations {
+		if setIndex < 0 || setIndex >= len(lists) {
+			continue
+		}
+		lists[setIndex].Entries = append(lists[setIndex].Entries, healMRFEntry{
+			Bucket:    u.bucket,
+			Object:    u.object,
+			VersionID: u.versionID,
+		})
+	}
+	s.mrfDirty = false
+	s.mrfMU.Unlock()
+
+	for setIndex, er := range s.sets {
+		er.saveHealMRFList(ctx, lists[setIndex])
+	}
+}
+
+// isSetOnline returns true if all drives of the erasure set are online.
+func (s *erasureSets) isSetOnline(setIndex int) bool {
+	for _, disk := range s.sets[setIndex].getDisks() {
+		if disk == nil || !disk.IsOnline() {
+			return false
+		}
+	}
+	return true
+}
+
+// healMRFOperations queues the objects of the MRF list which belong
+// to the erasure sets selected by healSet. Objects stay in the list,
+// so that they are saved, until they are healed.
+func (s *erasureSets) healMRFOperations(bgSeq *healSequence, idler *time.Timer, healSet func(setIndex int) bool) {
+	var mrfOperations []healSource
+	s.mrfMU.Lock()
+	for k, v := range s.mrfOperations {
+		if _, ok := s.mrfHealing[k]; ok {
+			continue
+		}
+		if healSet(v) {
+			mrfOperations = append(mrfOperations, k)
+		}
+	}
+	s.mrfMU.Unlock()
+
+	for _, u := range mrfOperations {
+		s.mrfMU.Lock()
+		if s.mrfHealing == nil {
+			s.mrfHealing = make(map[healSource]struct{})
+		}
+		s.mrfHealing[u] = struct{}{}
+		s.mrfMU.Unlock()
+
+		// Send an object to background heal
+		source := u
+		source.mrf = s
+		queued := bgSeq.queueSource(source, healQueueSourceMRF)
+		if !toSourceChTimed(idler, bgSeq.mrfCh, queued) {
+			bgSeq.unqueueSource(queued)
+			s.finishMRFOperation(u, errHealInterrupted)
+			return
+		}
+	}
+}
@@ -0,0 +1,116 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// Tests that the MRF list survives a restart.
+func TestMRFListPersistence(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	s := obj.(*erasureServerPools).serverPools[0]
+	u := healSource{bucket: "bucket", object: "object", versionID: "version"}
+	if !s.addMRFOperation(u, 0) {
+		t.Fatal("expected object to be added to the MRF list")
+	}
+	s.saveMRFList(ctx)
+
+	s.mrfOperations = make(map[healSource]int)
+	s.loadMRFList(ctx)
+	if setIndex, ok := s.mrfOperations[u]; !ok || setIndex != 0 || s.mrfDirty {
+		t.Fatalf("expected MRF list to be restored, got %v", s.mrfOperations)
+	}
+
+	// Objects queued for healing stay in the list until healed.
+	bgSeq := newBgHealSequence()
+	idler := time.NewTimer(time.Millisecond)
+	defer idler.Stop()
+	s.healMRFOperations(bgSeq, idler, func(int) bool { return true })
+	if len(bgSeq.mrfCh) != 1 {
+		t.Fatalf("expected %v to be queued, got %d queued objects", u, len(bgSeq.mrfCh))
+	}
+	// Not queued twice while waiting to be healed.
+	s.healMRFOperations(bgSeq, idler, func(int) bool { return true })
+	if len(bgSeq.mrfCh) != 1 {
+		t.Fatalf("expected %v to be queued once, got %d queued objects", u, len(bgSeq.mrfCh))
+	}
+
+	// The list is saved with the object still queued, as when
+	// another object is added, then the server is killed.
+	other := healSource{bucket: "bucket", object: "other"}
+	if !s.addMRFOperation(other, 0) {
+		t.Fatal("expected object to be added to the MRF list")
+	}
+	s.saveMRFList(ctx)
+	saved := make(map[healMRFEntry]bool)
+	for _, e := range s.sets[0].loadHealMRFList(ctx).Entries {
+		saved[e] = true
+	}
+	if !saved[healMRFEntry{Bucket: u.bucket, Object: u.object, VersionID: u.versionID}] {
+		t.Fatalf("expected queued object to be saved, got %v", saved)
+	}
+	delete(s.mrfOperations, other)
+
+	// The server is stopped before the object is healed.
+	bgSeq.drainSourceCh(nil, time.Second)
+	if _, ok := s.mrfOperations[u]; !ok {
+		t.Fatalf("expected interrupted object to stay in the MRF list, got %v", s.mrfOperations)
+	}
+
+	// Interrupted objects are queued again, and removed once healed.
+	s.healMRFOperations(bgSeq, idler, func(int) bool { return true })
+	got := <-bgSeq.mrfCh
+	if got.bucket != u.bucket || got.object != u.object || got.versionID != u.versionID {
+		t.Fatalf("expected %v to be queued, got %v", u, got)
+	}
+	bgSeq.finishHealSource(got, healResult{})
+	if len(s.mrfOperations) != 0 {
+		t.Fatalf("expected MRF list to be empty, got %v", s.mrfOperations)
+	}
+
+	s.saveMRFList(ctx)
+	if list := s.sets[0].loadHealMRFList(ctx); len(list.Entries) != 0 {
+		t.Fatalf("expected saved MRF list to be removed, got %v", list)
+	}
+}
+
+func TestMRFListFull(t *testing.T) {
+	s := &erasureSets{mrfOperations: make(map[healSource]int)}
+	for i := 0; i < mrfOperationsMax; i++ {
+		s.mrfOperations[healSource{bucket: "bucket", object: fmt.Sprintf("object-%d", i)}] = 0
+	}
+	if s.addMRFOperation(healSource{bucket: "bucket", object: "new"}, 0) {
+		t.Fatal("expected full MRF list to reject new objects")
+	}
+	// Objects already in the list are updated.
+	if !s.addMRFOperation(healSource{bucket: "bucket", object: "object-0"}, 1) {
+		t.Fatal("expected object already in the MRF list to be accepted")
+	}
+}
@@ -0,0 +1,115 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/minio/minio/cmd/config/heal"
+	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/cmd/logger/target/http"
+)
+
+// Heal notification event types.
+const (
+	healEventDriveStarted     = "DriveHealStarted"
+	healEventDriveFinished    = "DriveHealFinished"
+	healEventDriveFailed      = "DriveHealFailed"
+	healEventDriveFailingSoon = "DriveFailingSoon"
+	healEventBucketFinished   = "BucketHealFinished"
+	healEventRoundStarted     = "HealRoundStarted"
+	healEventRoundFinished    = "HealRoundFinished"
+	healEventRoundFailed      = "HealRoundFailed"
+	healEventObjectFailed     = "ObjectHealFailed"
+)
+
+// healEvent is the payload delivered to the heal notify endpoint.
+type healEvent struct {
+	Type      string    `json:"type"`
+	Node      string    `json:"node"`
+	PoolIndex int       `json:"poolIndex"`
+	SetIndex  int       `json:"setIndex"`
+	Drive     string    `json:"drive,omitempty"`
+	Bucket    string    `json:"bucket,omitempty"`
+	Object    string    `json:"object,omitempty"`
+	VersionID string    `json:"versionId,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+	Scanned   uint64    `json:"scanned"`
+	Healed    uint64    `json:"healed"`
+	Failed    uint64    `json:"failed"`
+	Started   time.Time `json:"started,omitempty"`
+	Time      time.Time `json:"time"`
+}
+
+// healNotifier delivers heal events to the configured HTTP endpoint.
+// Delivery is best effort, events are buffered and dropped when the
+// buffer is full so that a slow endpoint never stalls healing.
+type healNotifier struct {
+	mu             sync.RWMutex
+	endpoint       string
+	authToken      string
+	objectFailures bool
+	target         *http.Target
+}
+
+var globalHealNotifier = &healNotifier{}
+
+// update configures the notify endpoint, a new target is only
+// created when the endpoint or its credentials change.
+func (n *healNotifier) update(cfg heal.Config) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.objectFailures = cfg.NotifyObjectFailures
+	if n.endpoint == cfg.NotifyEndpoint && n.authToken == cfg.NotifyAuthToken {
+		return
+	}
+	n.endpoint, n.authToken = cfg.NotifyEndpoint, cfg.NotifyAuthToken
+	n.target = nil
+	if n.endpoint == "" {
+		return
+	}
+	n.target = http.New(
+		http.WithTargetName("heal"),
+		http.WithEndpoint(n.endpoint),
+		http.WithAuthToken(n.authToken),
+		http.WithUserAgent(getUserAgent(getMinioMode())),
+		http.WithLogKind(string(logger.All)),
+		http.WithTransport(NewGatewayHTTPTransport()),
+	)
+}
+
+// send queues the event for delivery without blocking.
+func (n *healNotifier) send(ev healEvent) {
+	n.mu.RLock()
+	target := n.target
+	objectFailures := n.objectFailures
+	n.mu.RUnlock()
+	if target == nil || (ev.Type == healEventObjectFailed && !objectFailures) {
+		return
+	}
+
+	ev.Node = GetLocalPeer(globalEndpoints)
+	if ev.Time.IsZero() {
+		ev.Time = UTCNow()
+	}
+	if err := target.Send(ev, string(logger.All)); err != nil {
+		logger.LogOnceIf(context.Background(), err, "heal-notify")
+	}
+}
@@ -0,0 +1,88 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/minio/minio/cmd/config/heal"
+)
+
+func TestHealNotifier(t *testing.T) {
+	events := make(chan healEvent, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var ev healEvent
+		if err := json.NewDecoder(r.Body).Decode(&ev); err != nil {
+			t.Error(err)
+		}
+		events <- ev
+	}))
+	defer ts.Close()
+
+	n := &healNotifier{}
+	// Not configured, must be a no-op.
+	n.send(healEvent{Type: healEventRoundFinished})
+
+	n.update(heal.Config{NotifyEndpoint: ts.URL})
+	target := n.target
+	n.update(heal.Config{NotifyEndpoint: ts.URL})
+	if n.target != target {
+		t.Fatal("expected target to be reused for an unchanged endpoint")
+	}
+
+	n.send(healEvent{
+		Type:     healEventBucketFinished,
+		SetIndex: 1,
+		Bucket:   "bucket",
+		Scanned:  10,
+		Healed:   2,
+		Failed:   1,
+	})
+	select {
+	case ev := <-events:
+		if ev.Type != healEventBucketFinished || ev.Bucket != "bucket" || ev.SetIndex != 1 {
+			t.Fatalf("unexpected event %#v", ev)
+		}
+		if ev.Scanned != 10 || ev.Healed != 2 || ev.Failed != 1 || ev.Time.IsZero() {
+			t.Fatalf("unexpected event %#v", ev)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for heal event")
+	}
+
+	// Object failures are only notified if configured.
+	n.send(healEvent{Type: healEventObjectFailed, Object: "dropped"})
+	n.update(heal.Config{NotifyEndpoint: ts.URL, NotifyObjectFailures: true})
+	n.send(healEvent{Type: healEventObjectFailed, Object: "object"})
+	select {
+	case ev := <-events:
+		if ev.Type != healEventObjectFailed || ev.Object != "object" {
+			t.Fatalf("unexpected event %#v", ev)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for heal event")
+	}
+
+	n.update(heal.Config{})
+	if n.target != nil {
+		t.Fatal("expected notifications to be disabled")
+	}
+}
@@ -0,0 +1,132 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// healObjectsMaxItems is the maximum number of objects
+// which can be healed by a single heal objects request.
+const healObjectsMaxItems = 1000
+
+// healInflight is an explicitly requested object heal, it
+// is shared by all callers requesting the same object version.
+type healInflight struct {
+	doneCh chan struct{}
+	res    healResult
+}
+
+// finishHealSource records the outcome of healing a source received
+// from sourceCh or mrfCh.
+func (h *healSequence) finishHealSource(source healSource, res healResult) {
+	if source.mrf != nil {
+		source.mrf.finishMRFOperation(source, res.err)
+	}
+	h.finishHealInflight(source, res)
+}
+
+// finishHealInflight records the outcome of healing the source and
+// wakes up all callers waiting on it.
+func (h *healSequence) finishHealInflight(source healSource, res healResult) {
+	if source.inflight == nil {
+		return
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	key := healFailedItemKey(source.bucket, source.object, source.versionID)
+	if h.healInflight[key] != source.inflight {
+		// Already finished.
+		return
+	}
+	delete(h.healInflight, key)
+	source.inflight.res = res
+	close(source.inflight.doneCh)
+}
+
+// healObjects queues the given object versions on the heal sequence,
+// without crawling, and waits for all of them to be healed. An object
+// version already queued by another caller is not queued again, its
+// outcome is shared instead. Items not healed before ctx is canceled
+// or the sequence is stopped are reported as interrupted.
+func (h *healSequence) healObjects(ctx context.Context, items []madmin.HealObjectsItem) []madmin.HealObjectsResult {
+	inflights := make([]*healInflight, len(items))
+	for i, item := range items {
+		source := healSource{
+			bucket:    item.Bucket,
+			object:    item.Object,
+			versionID: item.VersionID,
+		}
+		key := healFailedItemKey(source.bucket, source.object, source.versionID)
+
+		h.mutex.Lock()
+		inflight, ok := h.healInflight[key]
+		if !ok {
+			inflight = &healInflight{doneCh: make(chan struct{})}
+			if h.healInflight == nil {
+				h.healInflight = make(map[string]*healInflight)
+			}
+			h.healInflight[key] = inflight
+		}
+		h.mutex.Unlock()
+		inflights[i] = inflight
+
+		if ok {
+			continue
+		}
+
+		source.inflight = inflight
+		source = h.queueSource(source, healQueueSourceAPI)
+		select {
+		case h.sourceCh <- source:
+		case <-ctx.Done():
+			h.unqueueSource(source)
+			h.finishHealInflight(source, healResult{err: errHealInterrupted})
+		case <-h.ctx.Done():
+			h.unqueueSource(source)
+			h.finishHealInflight(source, healResult{err: errHealInterrupted})
+		}
+	}
+
+	results := make([]madmin.HealObjectsResult, len(items))
+	for i, item := range items {
+		results[i].HealObjectsItem = item
+
+		var res healResult
+		select {
+		case <-inflights[i].doneCh:
+			res = inflights[i].res
+		case <-ctx.Done():
+			res.err = errHealInterrupted
+		}
+
+		switch {
+		case res.err == nil:
+			results[i].Status = madmin.HealObjectsHealed
+		case isErrObjectNotFound(res.err) || isErrVersionNotFound(res.err):
+			results[i].Status = madmin.HealObjectsNotFound
+		default:
+			results[i].Status = madmin.HealObjectsFailed
+			results[i].Detail = res.err.Error()
+		}
+	}
+	return results
+}
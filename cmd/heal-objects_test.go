@@ -0,0 +1,94 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestHealSequenceHealObjects(t *testing.T) {
+	h := newBgHealSequence()
+	h.sourceCh = make(chan healSource, 4)
+
+	items := []madmin.HealObjectsItem{
+		{Bucket: "bucket", Object: "healed"},
+		{Bucket: "bucket", Object: "missing", VersionID: "version"},
+		{Bucket: "bucket", Object: "healed"},
+		{Bucket: "bucket", Object: "failed"},
+	}
+
+	resultsCh := make(chan []madmin.HealObjectsResult)
+	go func() {
+		resultsCh <- h.healObjects(context.Background(), items)
+	}()
+
+	// Identical object versions must only be queued once.
+	for i := 0; i < 3; i++ {
+		source := <-h.sourceCh
+		var err error
+		switch source.object {
+		case "missing":
+			err = VersionNotFound{Bucket: source.bucket, Object: source.object, VersionID: source.versionID}
+		case "failed":
+			err = errors.New("heal failed")
+		}
+		h.finishHealInflight(source, healResult{err: err})
+	}
+
+	results := <-resultsCh
+	if len(h.sourceCh) != 0 {
+		t.Fatalf("expected duplicate items not to be queued, %d queued", len(h.sourceCh))
+	}
+
+	expected := []madmin.HealObjectsStatus{
+		madmin.HealObjectsHealed,
+		madmin.HealObjectsNotFound,
+		madmin.HealObjectsHealed,
+		madmin.HealObjectsFailed,
+	}
+	for i, result := range results {
+		if result.HealObjectsItem != items[i] {
+			t.Errorf("Test %d: expected item %v, got %v", i+1, items[i], result.HealObjectsItem)
+		}
+		if result.Status != expected[i] {
+			t.Errorf("Test %d: expected status %s, got %s", i+1, expected[i], result.Status)
+		}
+	}
+	if results[3].Detail != "heal failed" {
+		t.Errorf("expected failure detail, got %q", results[3].Detail)
+	}
+}
+
+func TestHealSequenceHealObjectsCanceled(t *testing.T) {
+	h := newBgHealSequence()
+	h.sourceCh = make(chan healSource)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := h.healObjects(ctx, []madmin.HealObjectsItem{{Bucket: "bucket", Object: "object"}})
+	if results[0].Status != madmin.HealObjectsFailed || results[0].Detail != errHealInterrupted.Error() {
+		t.Fatalf("expected an interrupted item, got %#v", results[0])
+	}
+	if n := len(h.healInflight); n != 0 {
+		t.Fatalf("expected no in-flight items, got %d", n)
+	}
+}
@@ -0,0 +1,151 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+const (
+	// Minimum interval between two throughput samples
+	// of the heal progress of an erasure set.
+	healProgressInterval = 10 * time.Second
+
+	// Weight of the latest sample in the rolling throughput.
+	healProgressWeight = 0.3
+)
+
+// setHealProgress tracks the progress of healing an erasure set.
+type setHealProgress struct {
+	madmin.SetHealProgress
+
+	// Last throughput sample.
+	sampled        time.Time
+	sampledScanned uint64
+}
+
+// update adds to the progress and refreshes the rolling throughput
+// and the estimated completion once per healProgressInterval.
+func (p *setHealProgress) update(now time.Time, scanned, healed uint64) {
+	p.ObjectsScanned += scanned
+	p.ObjectsHealed += healed
+
+	elapsed := now.Sub(p.sampled)
+	if elapsed < healProgressInterval {
+		return
+	}
+	rate := float64(p.ObjectsScanned-p.sampledScanned) / elapsed.Seconds()
+	if p.Throughput == 0 {
+		p.Throughput = rate
+	} else {
+		p.Throughput = healProgressWeight*rate + (1-healProgressWeight)*p.Throughput
+	}
+	p.sampled = now
+	p.sampledScanned = p.ObjectsScanned
+
+	p.ETA = time.Time{}
+	if p.Throughput > 0 && p.ObjectsTotal > p.ObjectsScanned {
+		remaining := float64(p.ObjectsTotal-p.ObjectsScanned) / p.Throughput
+		p.ETA = now.Add(time.Duration(remaining * float64(time.Second)))
+	}
+}
+
+func setHealProgressKey(poolIndex, setIndex int) string {
+	return fmt.Sprintf("%d/%d", poolIndex, setIndex)
+}
+
+// startSetHealProgress starts tracking the progress of healing
+// the erasure set, total is the estimated number of objects.
+func (ahs *allHealState) startSetHealProgress(poolIndex, setIndex int, total uint64) {
+	ahs.Lock()
+	defer ahs.Unlock()
+
+	now := UTCNow()
+	ahs.setHealProgress[setHealProgressKey(poolIndex, setIndex)] = &setHealProgress{
+		SetHealProgress: madmin.SetHealProgress{
+			PoolIndex:    poolIndex,
+			SetIndex:     setIndex,
+			Started:      now,
+			ObjectsTotal: total,
+		},
+		sampled: now,
+	}
+}
+
+// updateSetHealProgress adds to the progress of healing the
+// erasure set, untracked erasure sets are ignored.
+func (ahs *allHealState) updateSetHealProgress(poolIndex, setIndex int, scanned, healed uint64) {
+	ahs.Lock()
+	defer ahs.Unlock()
+
+	if p, ok := ahs.setHealProgress[setHealProgressKey(poolIndex, setIndex)]; ok {
+		p.update(UTCNow(), scanned, healed)
+	}
+}
+
+// stopSetHealProgress stops tracking the progress of healing the erasure set.
+func (ahs *allHealState) stopSetHealProgress(poolIndex, setIndex int) {
+	ahs.Lock()
+	defer ahs.Unlock()
+
+	delete(ahs.setHealProgress, setHealProgressKey(poolIndex, setIndex))
+}
+
+// getSetHealProgress returns the progress of the erasure sets
+// being healed, ordered by pool and set.
+func (ahs *allHealState) getSetHealProgress() []madmin.SetHealProgress {
+	ahs.RLock()
+	defer ahs.RUnlock()
+
+	if len(ahs.setHealProgress) == 0 {
+		return nil
+	}
+	progress := make([]madmin.SetHealProgress, 0, len(ahs.setHealProgress))
+	for _, p := range ahs.setHealProgress {
+		progress = append(progress, p.SetHealProgress)
+	}
+	sortSetHealProgress(progress)
+	return progress
+}
+
+func sortSetHealProgress(progress []madmin.SetHealProgress) {
+	sort.Slice(progress, func(i, j int) bool {
+		if progress[i].PoolIndex != progress[j].PoolIndex {
+			return progress[i].PoolIndex < progress[j].PoolIndex
+		}
+		return progress[i].SetIndex < progress[j].SetIndex
+	})
+}
+
+// healObjectsEstimate returns the number of objects in the erasure
+// set found by the last data usage scan, 0 if unknown.
+func (er *erasureObjects) healObjectsEstimate(ctx context.Context) uint64 {
+	var cache dataUsageCache
+	if err := cache.load(ctx, er, dataUsageCacheName); err != nil {
+		return 0
+	}
+	root := cache.root()
+	if root == nil {
+		return 0
+	}
+	return cache.flatten(*root).Objects
+}
@@ -0,0 +1,87 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSetHealProgressETA(t *testing.T) {
+	now := time.Now()
+	p := &setHealProgress{sampled: now}
+	p.ObjectsTotal = 1000
+
+	// No throughput until the first sample interval elapses.
+	p.update(now.Add(time.Second), 100, 10)
+	if p.Throughput != 0 || !p.ETA.IsZero() {
+		t.Fatalf("unexpected early estimate %#v", p.SetHealProgress)
+	}
+
+	now = now.Add(healProgressInterval)
+	p.update(now, 100, 0)
+	if p.ObjectsScanned != 200 || p.ObjectsHealed != 10 {
+		t.Fatalf("unexpected counts %#v", p.SetHealProgress)
+	}
+	if p.Throughput != 20 {
+		t.Fatalf("expected 20 objects/s, got %v", p.Throughput)
+	}
+	if eta := now.Add(40 * time.Second); !p.ETA.Equal(eta) {
+		t.Fatalf("expected ETA %v, got %v", eta, p.ETA)
+	}
+
+	// Throughput is a rolling average of the samples.
+	now = now.Add(healProgressInterval)
+	p.update(now, 0, 0)
+	if p.Throughput != 14 {
+		t.Fatalf("expected 14 objects/s, got %v", p.Throughput)
+	}
+
+	// No estimate once the scanned objects exceed the estimated total.
+	now = now.Add(healProgressInterval)
+	p.update(now, 1000, 0)
+	if !p.ETA.IsZero() {
+		t.Fatalf("expected no ETA, got %v", p.ETA)
+	}
+}
+
+func TestSetHealProgress(t *testing.T) {
+	ahs := newHealState(false)
+
+	// Untracked sets are ignored.
+	ahs.updateSetHealProgress(0, 0, 1, 1)
+	if progress := ahs.getSetHealProgress(); progress != nil {
+		t.Fatalf("expected no progress, got %v", progress)
+	}
+
+	ahs.startSetHealProgress(1, 0, 10)
+	ahs.startSetHealProgress(0, 2, 20)
+	ahs.updateSetHealProgress(0, 2, 5, 2)
+	progress := ahs.getSetHealProgress()
+	if len(progress) != 2 || progress[0].PoolIndex != 0 || progress[1].PoolIndex != 1 {
+		t.Fatalf("unexpected progress %#v", progress)
+	}
+	if p := progress[0]; p.ObjectsTotal != 20 || p.ObjectsScanned != 5 || p.ObjectsHealed != 2 || p.Started.IsZero() {
+		t.Fatalf("unexpected progress %#v", p)
+	}
+
+	ahs.stopSetHealProgress(1, 0)
+	ahs.stopSetHealProgress(0, 2)
+	if progress := ahs.getSetHealProgress(); progress != nil {
+		t.Fatalf("expected progress to be cleared, got %v", progress)
+	}
+}
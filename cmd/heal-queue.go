@@ -0,0 +1,123 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// Maximum number of items tracked by the heal queue of a sequence,
+// items queued beyond are healed but not reported.
+const healQueueMax = 10000
+
+// Origins of the items queued for healing.
+const (
+	healQueueSourceAPI     = "api"
+	healQueueSourceMRF     = "mrf"
+	healQueueSourceRead    = "read"
+	healQueueSourceScanner = "scanner"
+)
+
+// healQueue tracks the items waiting in the channels of a heal
+// sequence, which cannot be inspected otherwise.
+type healQueue struct {
+	mu     sync.Mutex
+	nextID uint64
+	items  map[uint64]madmin.HealQueueItem
+}
+
+// queueSource registers the source before it is sent to sourceCh or
+// mrfCh, the returned source must be sent in place of the given one.
+func (h *healSequence) queueSource(source healSource, origin string) healSource {
+	source.origin = origin
+
+	h.queue.mu.Lock()
+	defer h.queue.mu.Unlock()
+
+	if len(h.queue.items) >= healQueueMax {
+		return source
+	}
+	if h.queue.items == nil {
+		h.queue.items = make(map[uint64]madmin.HealQueueItem)
+	}
+	scanMode := h.settings.ScanMode
+	if source.opts != nil {
+		scanMode = source.opts.ScanMode
+	}
+	h.queue.nextID++
+	source.queueID = h.queue.nextID
+	h.queue.items[source.queueID] = madmin.HealQueueItem{
+		Bucket:    source.bucket,
+		Object:    source.object,
+		VersionID: source.versionID,
+		ScanMode:  scanMode,
+		Source:    origin,
+		Queued:    UTCNow(),
+	}
+	return source
+}
+
+// unqueueSource forgets the source once received from the channel,
+// or if it could not be sent.
+func (h *healSequence) unqueueSource(source healSource) {
+	if source.queueID == 0 {
+		return
+	}
+	h.queue.mu.Lock()
+	delete(h.queue.items, source.queueID)
+	h.queue.mu.Unlock()
+}
+
+// getHealQueue returns the queued items, oldest first.
+func (h *healSequence) getHealQueue() []madmin.HealQueueItem {
+	h.queue.mu.Lock()
+	defer h.queue.mu.Unlock()
+
+	// Sources are numbered in the order they are queued.
+	ids := make([]uint64, 0, len(h.queue.items))
+	for id := range h.queue.items {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	items := make([]madmin.HealQueueItem, len(ids))
+	for i, id := range ids {
+		items[i] = h.queue.items[id]
+	}
+	return items
+}
+
+// getLocalBackgroundHealQueue returns the items queued for
+// background healing on this server.
+func getLocalBackgroundHealQueue() ([]madmin.HealQueueItem, bool) {
+	if globalBackgroundHealState == nil {
+		return nil, false
+	}
+	bgSeq, ok := globalBackgroundHealState.getHealSequenceByToken(bgHealingUUID)
+	if !ok {
+		return nil, false
+	}
+	items := bgSeq.getHealQueue()
+	node := GetLocalPeer(globalEndpoints)
+	for i := range items {
+		items[i].Node = node
+	}
+	return items, true
+}
@@ -0,0 +1,90 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestHealQueue(t *testing.T) {
+	h := newBgHealSequence()
+	h.sourceCh = make(chan healSource, 2)
+
+	first := h.queueSource(healSource{bucket: "bucket", object: "object1"}, healQueueSourceMRF)
+	h.sourceCh <- first
+	h.sourceCh <- h.queueSource(healSource{
+		bucket:    "bucket",
+		object:    "object2",
+		versionID: "version",
+		opts:      &madmin.HealOpts{ScanMode: madmin.HealDeepScan},
+	}, healQueueSourceRead)
+
+	items := h.getHealQueue()
+	if len(items) != 2 {
+		t.Fatalf("expected 2 queued items, got %d", len(items))
+	}
+	if items[0].Object != "object1" || items[0].Source != healQueueSourceMRF || items[0].ScanMode != madmin.HealNormalScan {
+		t.Fatalf("unexpected queued item %#v", items[0])
+	}
+	if items[1].VersionID != "version" || items[1].Source != healQueueSourceRead || items[1].ScanMode != madmin.HealDeepScan {
+		t.Fatalf("unexpected queued item %#v", items[1])
+	}
+
+	// Items are forgotten once received.
+	h.unqueueSource(<-h.sourceCh)
+	if items = h.getHealQueue(); len(items) != 1 || items[0].Object != "object2" {
+		t.Fatalf("unexpected queued items %#v", items)
+	}
+
+	// Untracked sources are ignored.
+	h.unqueueSource(healSource{bucket: "bucket"})
+	if items = h.getHealQueue(); len(items) != 1 {
+		t.Fatalf("expected 1 queued item, got %d", len(items))
+	}
+}
+
+func TestQueueScannerHeal(t *testing.T) {
+	saved := globalBackgroundHealState
+	defer func() {
+		globalBackgroundHealState = saved
+	}()
+
+	globalBackgroundHealState = newHealState(false)
+	h := newBgHealSequence()
+	defer h.cancelCtx()
+	h.sourceCh = make(chan healSource, 1)
+	globalBackgroundHealState.healSeqMap[SlashSeparator] = h
+
+	ctx := context.Background()
+	queueScannerHeal(ctx, "bucket", "object1", "", madmin.HealDeepScan)
+	items := h.getHealQueue()
+	if len(items) != 1 || items[0].Source != healQueueSourceScanner || items[0].ScanMode != madmin.HealDeepScan {
+		t.Fatalf("unexpected queued items %#v", items)
+	}
+
+	// Objects are skipped while the queue is full.
+	queueScannerHeal(ctx, "bucket", "object2", "", madmin.HealNormalScan)
+	if items = h.getHealQueue(); len(items) != 1 || items[0].Object != "object1" {
+		t.Fatalf("unexpected queued items %#v", items)
+	}
+	if source := <-h.sourceCh; source.object != "object1" || source.opts == nil || source.opts.Remove != healDeleteDangling {
+		t.Fatalf("unexpected queued source %#v", source)
+	}
+}
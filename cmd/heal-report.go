@@ -0,0 +1,128 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"sort"
+
+	"github.com/minio/minio/cmd/config/heal"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// Maximum number of items in the dry-run report of a heal round.
+const healReportMax = 10000
+
+// healDryRun returns true if the heal sequence only verifies
+// items, background healing follows the heal configuration.
+func (h *healSequence) healDryRun(cfg heal.Config) bool {
+	return h.settings.DryRun || (h.clientToken == bgHealingUUID && cfg.DryRun)
+}
+
+// applyHealDryRun makes opts only verify items if the heal sequence is
+// a dry run, even if the source asked to purge dangling objects.
+func (h *healSequence) applyHealDryRun(cfg heal.Config, opts *madmin.HealOpts) {
+	if h.healDryRun(cfg) {
+		opts.DryRun = true
+		opts.Remove = false
+		opts.PurgeDangling = false
+	}
+}
+
+// addHealReportItem adds the item which would have been healed
+// to the dry-run report, caller must hold h.mutex.
+func (h *healSequence) addHealReportItem(res madmin.HealResultItem) {
+	if len(h.report) >= healReportMax {
+		h.reportTruncated = true
+		return
+	}
+	item := madmin.HealReportItem{
+		Type:       res.Type,
+		Bucket:     res.Bucket,
+		Object:     res.Object,
+		VersionID:  res.VersionID,
+		ObjectSize: res.ObjectSize,
+	}
+	for _, d := range res.Before.Drives {
+		if d.State == madmin.DriveStateMissing || d.State == madmin.DriveStateCorrupt {
+			item.Drives = append(item.Drives, d)
+		}
+	}
+	h.report = append(h.report, item)
+}
+
+// resetHealReport clears the dry-run report at the start of a
+// heal round, caller must hold h.mutex.
+func (h *healSequence) resetHealReport() {
+	h.report = nil
+	h.reportTruncated = false
+}
+
+// getHealReport returns the dry-run report of the current heal round.
+func (h *healSequence) getHealReport() madmin.HealReport {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	report := madmin.HealReport{
+		Started:   h.roundStart,
+		Items:     make([]madmin.HealReportItem, len(h.report)),
+		Truncated: h.reportTruncated,
+	}
+	copy(report.Items, h.report)
+	return report
+}
+
+// getLocalBackgroundHealReport returns the dry-run report of the
+// background heal sequence of this server.
+func getLocalBackgroundHealReport() (madmin.HealReport, bool) {
+	if globalBackgroundHealState == nil {
+		return madmin.HealReport{}, false
+	}
+	bgSeq, ok := globalBackgroundHealState.getHealSequenceByToken(bgHealingUUID)
+	if !ok {
+		return madmin.HealReport{}, false
+	}
+	report := bgSeq.getHealReport()
+	node := GetLocalPeer(globalEndpoints)
+	for i := range report.Items {
+		report.Items[i].Node = node
+	}
+	return report, true
+}
+
+// mergeHealReports merges the dry-run reports of all servers,
+// items are ordered by bucket, object and version.
+func mergeHealReports(reports ...madmin.HealReport) madmin.HealReport {
+	var merged madmin.HealReport
+	for _, report := range reports {
+		if !report.Started.IsZero() && (merged.Started.IsZero() || report.Started.Before(merged.Started)) {
+			merged.Started = report.Started
+		}
+		merged.Items = append(merged.Items, report.Items...)
+		merged.Truncated = merged.Truncated || report.Truncated
+	}
+	sort.SliceStable(merged.Items, func(i, j int) bool {
+		a, b := merged.Items[i], merged.Items[j]
+		if a.Bucket != b.Bucket {
+			return a.Bucket < b.Bucket
+		}
+		if a.Object != b.Object {
+			return a.Object < b.Object
+		}
+		return a.VersionID < b.VersionID
+	})
+	return merged
+}
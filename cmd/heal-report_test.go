@@ -0,0 +1,106 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/minio/minio/cmd/config/heal"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestHealDryRun(t *testing.T) {
+	bgSeq := newBgHealSequence()
+	if bgSeq.healDryRun(heal.Config{}) {
+		t.Fatal("expected background heal to repair objects")
+	}
+	if !bgSeq.healDryRun(heal.Config{DryRun: true}) {
+		t.Fatal("expected background heal to follow the dry-run config")
+	}
+
+	// The heal config only applies to background healing.
+	h := newHealSequence(GlobalContext, "bucket", "", "127.0.0.1", madmin.HealOpts{}, false)
+	if h.healDryRun(heal.Config{DryRun: true}) {
+		t.Fatal("expected heal sequence to ignore the dry-run config")
+	}
+}
+
+func TestHealReport(t *testing.T) {
+	h := newBgHealSequence()
+	res := madmin.HealResultItem{
+		Type:      madmin.HealItemObject,
+		Bucket:    "bucket",
+		Object:    "object",
+		VersionID: "version",
+	}
+	res.Before.Drives = []madmin.HealDriveInfo{
+		{Endpoint: "/data1", State: madmin.DriveStateOk},
+		{Endpoint: "/data2", State: madmin.DriveStateMissing},
+		{Endpoint: "/data3", State: madmin.DriveStateCorrupt},
+	}
+
+	h.startHealRound()
+	h.logWouldHeal(res)
+	report := h.getHealReport()
+	if len(report.Items) != 1 || report.Truncated || report.Started.IsZero() {
+		t.Fatalf("unexpected report %#v", report)
+	}
+	item := report.Items[0]
+	if item.Object != "object" || item.VersionID != "version" || len(item.Drives) != 2 ||
+		item.Drives[0].Endpoint != "/data2" || item.Drives[1].State != madmin.DriveStateCorrupt {
+		t.Fatalf("unexpected report item %#v", item)
+	}
+
+	h.mutex.Lock()
+	for i := 0; i < healReportMax; i++ {
+		h.addHealReportItem(res)
+	}
+	h.mutex.Unlock()
+	if report = h.getHealReport(); len(report.Items) != healReportMax || !report.Truncated {
+		t.Fatalf("expected truncated report of %d items, got %d", healReportMax, len(report.Items))
+	}
+
+	// A new heal round starts a new report.
+	h.endHealRound()
+	h.startHealRound()
+	if report = h.getHealReport(); len(report.Items) != 0 || report.Truncated {
+		t.Fatalf("expected empty report, got %d items", len(report.Items))
+	}
+}
+
+func TestMergeHealReports(t *testing.T) {
+	now := time.Now()
+	merged := mergeHealReports(
+		madmin.HealReport{
+			Started: now,
+			Items:   []madmin.HealReportItem{{Node: "node1", Bucket: "bucket", Object: "b"}},
+		},
+		madmin.HealReport{},
+		madmin.HealReport{
+			Started:   now.Add(-time.Minute),
+			Items:     []madmin.HealReportItem{{Node: "node2", Bucket: "bucket", Object: "a"}},
+			Truncated: true,
+		},
+	)
+	if !merged.Started.Equal(now.Add(-time.Minute)) || !merged.Truncated {
+		t.Fatalf("unexpected merged report %#v", merged)
+	}
+	if len(merged.Items) != 2 || merged.Items[0].Node != "node2" || merged.Items[1].Node != "node1" {
+		t.Fatalf("unexpected merged items %#v", merged.Items)
+	}
+}
@@ -0,0 +1,210 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"time"
+
+)
+
+const (
+	// maximum number of objects waiting to be retried.
+	healRetryQueueMax = 1000
+	// maximum delay between two attempts, the delay before the
+	// first retry is configured and doubled on every attempt.
+	healRetryMaxDelay = 5 * time.Minute
+	// interval at which the retry queue is checked.
+	healRetryCheckInterval = time.Second
+)
+
+// healRetry is an object which failed to heal and is retried
+// after a backoff.
+type healRetry struct {
+	source   healSource
+	attempts int
+	next     time.Time
+	err      error
+
+	// erasure set the object belongs to, used for notifications.
+	poolIndex, setIndex int
+}
+
+// healRetryBackoff returns the delay before the next attempt.
+func healRetryBackoff(delay time.Duration, attempts int) time.Duration {
+	for i := 1; i < attempts && delay < healRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > healRetryMaxDelay {
+		delay = healRetryMaxDelay
+	}
+	return delay
+}
+
+// retryHeal schedules the object which failed to heal to be retried,
+// not found errors are terminal. When the retry queue is full the
+// failure is recorded immediately.
+func (h *healSequence) retryHeal(source healSource, err error, poolIndex, setIndex int) {
+	if isErrObjectNotFound(err) || isErrVersionNotFound(err) {
+		return
+	}
+	cfg := currentHealConfig()
+	r := &healRetry{
+		source:    source,
+		attempts:  1,
+		next:      UTCNow().Add(healRetryBackoff(cfg.GetRetryDelay(), 1)),
+		err:       err,
+		poolIndex: poolIndex,
+		setIndex:  setIndex,
+	}
+	if cfg.GetRetryAttempts() <= 1 {
+		h.healRetryFailed(r)
+		return
+	}
+	key := healFailedItemKey(source.bucket, source.object, source.versionID)
+
+	h.mutex.Lock()
+	if h.healRetries == nil {
+		h.healRetries = make(map[string]*healRetry)
+	}
+	if _, ok := h.healRetries[key]; ok {
+		h.mutex.Unlock()
+		return
+	}
+	if len(h.healRetries) < healRetryQueueMax {
+		h.healRetries[key] = r
+		h.mutex.Unlock()
+		return
+	}
+	h.mutex.Unlock()
+
+	h.healRetryFailed(r)
+}
+
+// healRetryFailed records an object which could not be healed
+// and adds it to the dead-letter list.
+func (h *healSequence) healRetryFailed(r *healRetry) {
+	h.mutex.Lock()
+	h.addHealDeadLetter(r)
+	h.mutex.Unlock()
+
+	if !h.logHealFailure(r.source.bucket, r.source.object, r.source.versionID, r.err) {
+		return
+	}
+	globalHealNotifier.send(healEvent{
+		Type:      healEventObjectFailed,
+		PoolIndex: r.poolIndex,
+		SetIndex:  r.setIndex,
+		Bucket:    r.source.bucket,
+		Object:    r.source.object,
+		VersionID: r.source.versionID,
+		Reason:    r.err.Error(),
+	})
+}
+
+// getHealRetriesCount returns the number of objects waiting to be retried.
+func (h *healSequence) getHealRetriesCount() int {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	return len(h.healRetries)
+}
+
+// processHealRetries attempts to heal all objects whose backoff has
+// expired. Objects which still fail are retried later until the
+// attempts are exhausted.
+func (h *healSequence) processHealRetries(ctx context.Context, healFn func(source healSource) error) {
+	cfg := currentHealConfig()
+	now := UTCNow()
+	var due []*healRetry
+	h.mutex.Lock()
+	for key, r := range h.healRetries {
+		if !r.next.After(now) {
+			due = append(due, r)
+			delete(h.healRetries, key)
+		}
+	}
+	h.mutex.Unlock()
+
+	for _, r := range due {
+		if ctx.Err() != nil {
+			return
+		}
+		h.waitIfPaused(ctx)
+		err := healFn(r.source)
+		if err == nil {
+			h.mutex.Lock()
+			h.removeHealFailedItem(r.source.bucket, r.source.object, r.source.versionID)
+			h.mutex.Unlock()
+			continue
+		}
+		if isErrObjectNotFound(err) || isErrVersionNotFound(err) {
+			continue
+		}
+		r.err = err
+		r.attempts++
+		if r.attempts >= cfg.GetRetryAttempts() {
+			h.healRetryFailed(r)
+			continue
+		}
+		r.next = UTCNow().Add(healRetryBackoff(cfg.GetRetryDelay(), r.attempts))
+		h.mutex.Lock()
+		h.healRetries[healFailedItemKey(r.source.bucket, r.source.object, r.source.versionID)] = r
+		h.mutex.Unlock()
+	}
+}
+
+// healRetriesLoop periodically retries objects which failed to heal,
+// the dead-letter list of background healing is saved when modified.
+func (h *healSequence) healRetriesLoop(ctx context.Context) {
+	ticker := time.NewTicker(healRetryCheckInterval)
+	defer ticker.Stop()
+
+	var deadLettersLoaded bool
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			objAPI := newObjectLayerFn()
+			if objAPI == nil {
+				continue
+			}
+			if h.clientToken == bgHealingUUID {
+				if !deadLettersLoaded {
+					h.loadHealDeadLetters(ctx, objAPI)
+					deadLettersLoaded = true
+				}
+				h.saveHealDeadLetters(ctx, objAPI)
+			}
+			h.processHealRetries(ctx, func(source healSource) error {
+				cfg := currentHealConfig()
+				if err := globalBackgroundHealState.throttle.wait(ctx, cfg.MaxIOPS); err != nil {
+					return err
+				}
+				opts := h.settings
+				if source.opts != nil {
+					opts = *source.opts
+				}
+				res, err := objAPI.HealObject(ctx, source.bucket, source.object, source.versionID, opts)
+				if cfg.Audit {
+					auditHealObject(healAuditSourceRetry, -1, -1, source.bucket, source.object, source.versionID, opts, res, err)
+				}
+				return err
+			})
+		}
+	}
+}
@@ -0,0 +1,154 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/minio/minio/cmd/config/heal"
+)
+
+func TestHealRetryBackoff(t *testing.T) {
+	if d := healRetryBackoff(heal.DefaultRetryDelay, 1); d != heal.DefaultRetryDelay {
+		t.Fatalf("expected %s, got %s", heal.DefaultRetryDelay, d)
+	}
+	if d := healRetryBackoff(heal.DefaultRetryDelay, 3); d != 4*heal.DefaultRetryDelay {
+		t.Fatalf("expected %s, got %s", 4*heal.DefaultRetryDelay, d)
+	}
+	if d := healRetryBackoff(heal.DefaultRetryDelay, 100); d != healRetryMaxDelay {
+		t.Fatalf("expected %s, got %s", healRetryMaxDelay, d)
+	}
+}
+
+func TestHealRetries(t *testing.T) {
+	h := newBgHealSequence()
+	ctx := context.Background()
+
+	// Not found errors are never retried.
+	h.retryHeal(healSource{bucket: "bucket", object: "gone"}, ObjectNotFound{}, 0, 0)
+	if n := h.getHealRetriesCount(); n != 0 {
+		t.Fatalf("expected no retries, got %d", n)
+	}
+
+	h.retryHeal(healSource{bucket: "bucket", object: "healed"}, errDiskNotFound, 0, 0)
+	h.retryHeal(healSource{bucket: "bucket", object: "failed"}, errDiskNotFound, 0, 1)
+	if n := h.getHealRetriesCount(); n != 2 {
+		t.Fatalf("expected 2 retries, got %d", n)
+	}
+
+	expire := func() {
+		h.mutex.Lock()
+		for _, r := range h.healRetries {
+			r.next = time.Time{}
+		}
+		h.mutex.Unlock()
+	}
+
+	// Retries are not attempted before their backoff expires.
+	var attempts int
+	healFn := func(source healSource) error {
+		attempts++
+		if source.object == "healed" {
+			return nil
+		}
+		return errDiskNotFound
+	}
+	h.processHealRetries(ctx, healFn)
+	if attempts != 0 {
+		t.Fatalf("expected no attempts, got %d", attempts)
+	}
+
+	for i := 1; i < heal.DefaultRetryAttempts; i++ {
+		expire()
+		h.processHealRetries(ctx, healFn)
+	}
+	if n := h.getHealRetriesCount(); n != 0 {
+		t.Fatalf("expected retries to be exhausted, got %d", n)
+	}
+	items := h.getHealFailedItems()
+	if len(items) != 1 || items[0].Object != "failed" {
+		t.Fatalf("expected only the failed object to be recorded, got %v", items)
+	}
+	deadLetters := h.getHealDeadLetters()
+	if len(deadLetters) != 1 || deadLetters[0].Object != "failed" || deadLetters[0].SetIndex != 1 {
+		t.Fatalf("expected only the failed object in the dead-letter list, got %v", deadLetters)
+	}
+	if deadLetters[0].Attempts != heal.DefaultRetryAttempts {
+		t.Fatalf("expected %d attempts, got %d", heal.DefaultRetryAttempts, deadLetters[0].Attempts)
+	}
+
+	// Objects healed by a later heal round leave the dead-letter list.
+	h.forgetHealFailure("bucket", "failed", "")
+	if deadLetters = h.getHealDeadLetters(); len(deadLetters) != 0 {
+		t.Fatalf("expected an empty dead-letter list, got %v", deadLetters)
+	}
+}
+
+func TestHealRetriesDisabled(t *testing.T) {
+	globalHealConfigMu.Lock()
+	globalHealConfig.RetryAttempts = 1
+	globalHealConfigMu.Unlock()
+	defer func() {
+		globalHealConfigMu.Lock()
+		globalHealConfig.RetryAttempts = 0
+		globalHealConfigMu.Unlock()
+	}()
+
+	h := newBgHealSequence()
+	h.retryHeal(healSource{bucket: "bucket", object: "failed"}, errDiskNotFound, 0, 0)
+	if n := h.getHealRetriesCount(); n != 0 {
+		t.Fatalf("expected no retries, got %d", n)
+	}
+	if deadLetters := h.getHealDeadLetters(); len(deadLetters) != 1 {
+		t.Fatalf("expected the object in the dead-letter list, got %v", deadLetters)
+	}
+}
+
+func TestHealDeadLettersPersist(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	objLayer, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer removeRoots(fsDirs)
+
+	h := newBgHealSequence()
+	h.healRetryFailed(&healRetry{
+		source:   healSource{bucket: "bucket", object: "failed"},
+		attempts: heal.DefaultRetryAttempts,
+		err:      errDiskNotFound,
+	})
+	h.saveHealDeadLetters(ctx, objLayer)
+
+	restored := newBgHealSequence()
+	restored.loadHealDeadLetters(ctx, objLayer)
+	deadLetters := restored.getHealDeadLetters()
+	if len(deadLetters) != 1 || deadLetters[0].Object != "failed" || deadLetters[0].Reason != errDiskNotFound.Error() {
+		t.Fatalf("expected the dead-letter list to be restored, got %v", deadLetters)
+	}
+
+	// The saved list is removed once empty.
+	restored.forgetHealFailure("bucket", "failed", "")
+	restored.saveHealDeadLetters(ctx, objLayer)
+	if _, err = readConfig(ctx, objLayer, healDeadLettersPath()); err != errConfigNotFound {
+		t.Fatalf("expected the dead-letter list to be removed, got %v", err)
+	}
+}
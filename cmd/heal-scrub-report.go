@@ -0,0 +1,232 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+const (
+	// Scrub reports are saved under this prefix of the reserved
+	// bucket, named after the time their cycle started.
+	healScrubReportPrefix = healCheckpointPrefix + SlashSeparator + "scrub-reports"
+	healScrubReportLayout = "20060102T150405Z"
+
+	// Maximum number of items of a scrub report, and of scrub
+	// reports kept, older reports are removed.
+	healScrubReportItemsMax = 10000
+	healScrubReportsMax     = 30
+)
+
+var errHealScrubReportInvalidID = errors.New("invalid scrub report id")
+
+// healScrubReport collects the object versions found damaged by the
+// erasure sets scrubbed during a scrub cycle.
+type healScrubReport struct {
+	mu     sync.Mutex
+	report madmin.ScrubReport
+}
+
+func newHealScrubReport(started time.Time) *healScrubReport {
+	return &healScrubReport{
+		report: madmin.ScrubReport{
+			ID:      started.UTC().Format(healScrubReportLayout),
+			Node:    GetLocalPeer(globalEndpoints),
+			Started: started,
+		},
+	}
+}
+
+// logScanned records an object version deep verified by the scrubber.
+func (r *healScrubReport) logScanned() {
+	r.mu.Lock()
+	r.report.Scanned++
+	r.mu.Unlock()
+}
+
+// add records a damaged object version with its repair outcome.
+func (r *healScrubReport) add(item madmin.ScrubReportItem) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.report.Items) >= healScrubReportItemsMax {
+		r.report.Truncated = true
+		return
+	}
+	r.report.Items = append(r.report.Items, item)
+}
+
+// healScrubReportItem returns the report item of an object version the
+// scrubber found damaged, the drives are taken from the heal result.
+func healScrubReportItem(poolIndex, setIndex int, fi FileInfo, res madmin.HealResultItem, healErr error, dryRun bool) madmin.ScrubReportItem {
+	item := madmin.ScrubReportItem{
+		Bucket:    res.Bucket,
+		Object:    fi.Name,
+		VersionID: fi.VersionID,
+		PoolIndex: poolIndex,
+		SetIndex:  setIndex,
+		Time:      UTCNow(),
+	}
+	if item.Bucket == "" {
+		item.Bucket = fi.Volume
+	}
+
+	var algorithm string
+	if len(fi.Erasure.Checksums) > 0 {
+		algorithm = fi.Erasure.Checksums[0].Algorithm.String()
+	}
+	for _, d := range res.Before.Drives {
+		switch d.State {
+		case madmin.DriveStateCorrupt:
+			detail := "checksum mismatch"
+			if algorithm != "" {
+				detail = fmt.Sprintf("%s checksum mismatch", algorithm)
+			}
+			item.Drives = append(item.Drives, madmin.ScrubDriveInfo{Endpoint: d.Endpoint, State: d.State, Detail: detail})
+		case madmin.DriveStateMissing:
+			item.Drives = append(item.Drives, madmin.ScrubDriveInfo{Endpoint: d.Endpoint, State: d.State, Detail: "missing shard"})
+		}
+	}
+
+	switch {
+	case healErr != nil:
+		item.Outcome = madmin.ScrubRepairFailed
+		item.Error = healErr.Error()
+	case dryRun:
+		item.Outcome = madmin.ScrubNotRepaired
+	default:
+		item.Outcome = madmin.ScrubRepaired
+	}
+	return item
+}
+
+// healScrubReportPath returns the path of the scrub report in the
+// reserved bucket, the id must be a valid report id.
+func healScrubReportPath(id string) (string, error) {
+	if _, err := time.Parse(healScrubReportLayout, id); err != nil {
+		return "", errHealScrubReportInvalidID
+	}
+	return pathJoin(healScrubReportPrefix, id+".json"), nil
+}
+
+// saveHealScrubReport saves the report of a scrub cycle and removes
+// the oldest reports beyond healScrubReportsMax.
+func saveHealScrubReport(ctx context.Context, objAPI ObjectLayer, r *healScrubReport) error {
+	r.mu.Lock()
+	r.report.Finished = UTCNow()
+	data, err := json.Marshal(r.report)
+	id := r.report.ID
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	reportPath, err := healScrubReportPath(id)
+	if err != nil {
+		return err
+	}
+	if err = saveConfig(ctx, objAPI, reportPath, data); err != nil {
+		return err
+	}
+
+	ids, err := listHealScrubReportIDs(ctx, objAPI)
+	if err != nil {
+		return err
+	}
+	for len(ids) > healScrubReportsMax {
+		if reportPath, err = healScrubReportPath(ids[0]); err == nil {
+			logger.LogIf(ctx, deleteConfig(ctx, objAPI, reportPath))
+		}
+		ids = ids[1:]
+	}
+	return nil
+}
+
+// listHealScrubReportIDs returns the ids of the saved scrub reports,
+// oldest first.
+func listHealScrubReportIDs(ctx context.Context, objAPI ObjectLayer) ([]string, error) {
+	var ids []string
+	marker := ""
+	for {
+		res, err := objAPI.ListObjects(ctx, minioMetaBucket, healScrubReportPrefix+SlashSeparator, marker, "", maxObjectList)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range res.Objects {
+			id := strings.TrimSuffix(path.Base(obj.Name), ".json")
+			if _, err := healScrubReportPath(id); err == nil {
+				ids = append(ids, id)
+			}
+		}
+		if !res.IsTruncated {
+			break
+		}
+		marker = res.NextMarker
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// loadHealScrubReport returns the saved scrub report with the given id.
+func loadHealScrubReport(ctx context.Context, objAPI ObjectLayer, id string) (madmin.ScrubReport, error) {
+	var report madmin.ScrubReport
+	reportPath, err := healScrubReportPath(id)
+	if err != nil {
+		return report, err
+	}
+	data, err := readConfig(ctx, objAPI, reportPath)
+	if err != nil {
+		return report, err
+	}
+	err = json.Unmarshal(data, &report)
+	return report, err
+}
+
+// listHealScrubReports summarizes the saved scrub reports, oldest first.
+func listHealScrubReports(ctx context.Context, objAPI ObjectLayer) ([]madmin.ScrubReportInfo, error) {
+	ids, err := listHealScrubReportIDs(ctx, objAPI)
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]madmin.ScrubReportInfo, 0, len(ids))
+	for _, id := range ids {
+		report, err := loadHealScrubReport(ctx, objAPI, id)
+		if err != nil {
+			if errors.Is(err, errConfigNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		infos = append(infos, madmin.ScrubReportInfo{
+			ID:       report.ID,
+			Started:  report.Started,
+			Finished: report.Finished,
+			Scanned:  report.Scanned,
+			Items:    len(report.Items),
+		})
+	}
+	return infos, nil
+}
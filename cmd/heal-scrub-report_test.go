@@ -0,0 +1,140 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestHealScrubReportItem(t *testing.T) {
+	fi := FileInfo{Volume: "bucket", Name: "object", VersionID: "v1"}
+	fi.Erasure.Checksums = []ChecksumInfo{{PartNumber: 1, Algorithm: HighwayHash256S}}
+	res := madmin.HealResultItem{
+		Before: struct {
+			Drives []madmin.HealDriveInfo `json:"drives"`
+		}{
+			Drives: []madmin.HealDriveInfo{
+				{Endpoint: "/disk1", State: madmin.DriveStateOk},
+				{Endpoint: "/disk2", State: madmin.DriveStateCorrupt},
+				{Endpoint: "/disk3", State: madmin.DriveStateMissing},
+			},
+		},
+	}
+
+	item := healScrubReportItem(1, 2, fi, res, nil, false)
+	if item.Bucket != "bucket" || item.Object != "object" || item.VersionID != "v1" || item.PoolIndex != 1 || item.SetIndex != 2 {
+		t.Fatalf("unexpected report item %#v", item)
+	}
+	if item.Outcome != madmin.ScrubRepaired {
+		t.Fatalf("expected outcome %s, got %s", madmin.ScrubRepaired, item.Outcome)
+	}
+	expected := []madmin.ScrubDriveInfo{
+		{Endpoint: "/disk2", State: madmin.DriveStateCorrupt, Detail: HighwayHash256S.String() + " checksum mismatch"},
+		{Endpoint: "/disk3", State: madmin.DriveStateMissing, Detail: "missing shard"},
+	}
+	if len(item.Drives) != len(expected) {
+		t.Fatalf("expected drives %v, got %v", expected, item.Drives)
+	}
+	for i := range expected {
+		if item.Drives[i] != expected[i] {
+			t.Errorf("drive %d: expected %v, got %v", i, expected[i], item.Drives[i])
+		}
+	}
+
+	if item = healScrubReportItem(0, 0, fi, res, nil, true); item.Outcome != madmin.ScrubNotRepaired {
+		t.Fatalf("expected outcome %s, got %s", madmin.ScrubNotRepaired, item.Outcome)
+	}
+	if item = healScrubReportItem(0, 0, fi, res, errDiskNotFound, false); item.Outcome != madmin.ScrubRepairFailed || item.Error == "" {
+		t.Fatalf("expected a failed repair, got %#v", item)
+	}
+}
+
+func TestHealScrubReportPath(t *testing.T) {
+	testCases := []struct {
+		id      string
+		success bool
+	}{
+		{"20210331T100000Z", true},
+		{"", false},
+		{"../config", false},
+		{"20210331T100000Z/../../config", false},
+	}
+	for i, testCase := range testCases {
+		_, err := healScrubReportPath(testCase.id)
+		if testCase.success != (err == nil) {
+			t.Errorf("Test %d: expected success %v, got %v", i+1, testCase.success, err)
+		}
+	}
+}
+
+func TestHealScrubReportSave(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	started := time.Date(2021, 3, 31, 10, 0, 0, 0, time.UTC)
+	for i := 0; i < healScrubReportsMax+2; i++ {
+		report := newHealScrubReport(started.Add(time.Duration(i) * time.Hour))
+		report.logScanned()
+		report.add(madmin.ScrubReportItem{Bucket: "bucket", Object: "object-" + strconv.Itoa(i), Outcome: madmin.ScrubRepaired})
+		if err = saveHealScrubReport(ctx, obj, report); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	infos, err := listHealScrubReports(ctx, obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != healScrubReportsMax {
+		t.Fatalf("expected %d reports, got %d", healScrubReportsMax, len(infos))
+	}
+	// The two oldest reports are removed.
+	if first := started.Add(2 * time.Hour).Format(healScrubReportLayout); infos[0].ID != first {
+		t.Fatalf("expected oldest report %s, got %s", first, infos[0].ID)
+	}
+	if infos[0].Scanned != 1 || infos[0].Items != 1 {
+		t.Fatalf("unexpected report summary %#v", infos[0])
+	}
+
+	report, err := loadHealScrubReport(ctx, obj, infos[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Items) != 1 || report.Items[0].Object != "object-2" {
+		t.Fatalf("unexpected report items %v", report.Items)
+	}
+
+	if _, err = loadHealScrubReport(ctx, obj, started.Format(healScrubReportLayout)); !errors.Is(err, errConfigNotFound) {
+		t.Fatalf("expected %v, got %v", errConfigNotFound, err)
+	}
+	if _, err = loadHealScrubReport(ctx, obj, "invalid"); !errors.Is(err, errHealScrubReportInvalidID) {
+		t.Fatalf("expected %v, got %v", errHealScrubReportInvalidID, err)
+	}
+}
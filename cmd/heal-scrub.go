@@ -0,0 +1,339 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/minio/minio/cmd/config/heal"
+	"github.com/minio/minio/cmd/logger"
+	"github.com/minio/minio/pkg/bucket/lifecycle"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+const (
+	// Interval between two scrub passes of an erasure set, each pass
+	// only deep verifies the versions which were not verified within
+	// the configured scrub interval.
+	healScrubPassInterval = 24 * time.Hour
+
+	// Interval at which the scrubber checks for erasure sets due
+	// for a scrub pass.
+	healScrubCheckInterval = time.Hour
+
+	// Random delay before contending for the scrub leader lock.
+	healScrubStartDelay = time.Minute
+
+	// Internal metadata holding the time an object version
+	// was last deep verified by the scrubber.
+	healScrubVerifiedKey = ReservedMetadataPrefixLower + "scrub-verified"
+)
+
+var healScrubLeaderLockTimeout = newDynamicTimeout(30*time.Second, 10*time.Second)
+
+// healScrubCheckpoint records the progress of the scrub pass of an
+// erasure set, so an interrupted pass resumes where it stopped.
+type healScrubCheckpoint struct {
+	Started  time.Time `json:"started"`
+	Finished time.Time `json:"finished,omitempty"`
+	Bucket   string    `json:"bucket,omitempty"`
+	Object   string    `json:"object,omitempty"`
+}
+
+func (er *erasureObjects) healScrubCheckpointPath() string {
+	return pathJoin(healCheckpointPrefix, fmt.Sprintf("pool-%d", er.poolIndex),
+		fmt.Sprintf("scrub-set-%d.json", er.setNumber))
+}
+
+// loadHealScrubCheckpoint returns the scrub checkpoint of the erasure set.
+func (er *erasureObjects) loadHealScrubCheckpoint(ctx context.Context) healScrubCheckpoint {
+	var cp healScrubCheckpoint
+	data, err := readConfig(ctx, er, er.healScrubCheckpointPath())
+	if err != nil {
+		if !errors.Is(err, errConfigNotFound) {
+			logger.LogIf(ctx, err)
+		}
+		return cp
+	}
+	if err = json.Unmarshal(data, &cp); err != nil {
+		logger.LogIf(ctx, err)
+		return healScrubCheckpoint{}
+	}
+	return cp
+}
+
+// saveHealScrubCheckpoint saves the scrub checkpoint of the erasure set.
+func (er *erasureObjects) saveHealScrubCheckpoint(ctx context.Context, cp healScrubCheckpoint) {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		logger.LogIf(ctx, err)
+		return
+	}
+	logger.LogIf(ctx, saveConfig(ctx, er, er.healScrubCheckpointPath(), data))
+}
+
+// healScrubStale returns true if the object version was neither written
+// nor deep verified within the scrub interval.
+func healScrubStale(fi FileInfo, now time.Time, interval time.Duration) bool {
+	verified := fi.ModTime
+	if v, ok := fi.Metadata[healScrubVerifiedKey]; ok {
+		if t, err := time.Parse(time.RFC3339, v); err == nil && t.After(verified) {
+			verified = t
+		}
+	}
+	return now.Sub(verified) >= interval
+}
+
+// markHealScrubVerified records the time the object version was deep verified.
+func (er *erasureObjects) markHealScrubVerified(ctx context.Context, bucket, object, versionID string, verified time.Time) error {
+	lk := er.NewNSLock(bucket, object)
+	if err := lk.GetLock(ctx, globalOperationTimeout); err != nil {
+		return err
+	}
+	defer lk.Unlock()
+
+	return er.updateObjectMeta(ctx, bucket, object, map[string]string{
+		healScrubVerifiedKey: verified.UTC().Format(time.RFC3339),
+	}, ObjectOptions{VersionID: versionID})
+}
+
+// runHealScrub deep verifies all objects of the cluster for bitrot once
+// per scrub interval, only one server of the cluster scrubs at a time.
+func runHealScrub(ctx context.Context, z *erasureServerPools) {
+	locker := z.NewNSLock(minioMetaBucket, "runHealScrub.lock")
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for {
+		err := locker.GetLock(ctx, healScrubLeaderLockTimeout)
+		if err != nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Duration(r.Float64() * float64(healScrubStartDelay))):
+			}
+			continue
+		}
+		break
+		// No unlock for "leader" lock.
+	}
+
+	bgSeq := mustGetHealSequence(ctx)
+
+	scrubTimer := time.NewTimer(healScrubStartDelay)
+	defer scrubTimer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-scrubTimer.C:
+		}
+
+		globalHealConfigMu.Lock()
+		cfg := globalHealConfig
+		globalHealConfigMu.Unlock()
+
+		if cfg.ScrubInterval > 0 {
+			z.healScrub(ctx, bgSeq, cfg)
+		}
+		scrubTimer.Reset(healScrubCheckInterval)
+	}
+}
+
+// healScrub runs the scrub pass of all erasure sets which are due,
+// up to the configured number of heal workers at once.
+func (z *erasureServerPools) healScrub(ctx context.Context, bgSeq *healSequence, cfg heal.Config) {
+	buckets, err := z.ListBuckets(ctx)
+	if err != nil {
+		logger.LogIf(ctx, err)
+		return
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		return buckets[i].Name < buckets[j].Name
+	})
+
+	report := newHealScrubReport(UTCNow())
+	defer func() {
+		// Only cycles which verified or found anything are reported.
+		report.mu.Lock()
+		empty := report.report.Scanned == 0 && len(report.report.Items) == 0
+		report.mu.Unlock()
+		if !empty {
+			logger.LogIf(ctx, saveHealScrubReport(GlobalContext, z, report))
+		}
+	}()
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, cfg.GetWorkers())
+	for _, pool := range z.serverPools {
+		for _, set := range pool.sets {
+			select {
+			case <-ctx.Done():
+				wg.Wait()
+				return
+			case sem <- struct{}{}:
+			}
+			wg.Add(1)
+			go func(er *erasureObjects) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				if err := er.healScrubErasureSet(ctx, bgSeq, buckets, cfg, report); err != nil && ctx.Err() == nil {
+					logger.LogIf(ctx, err)
+				}
+			}(set)
+		}
+	}
+	wg.Wait()
+}
+
+// healScrubErasureSet deep verifies the object versions of the erasure
+// set which were not verified within the scrub interval, corrupted
+// versions are healed. The pass is skipped if the previous one finished
+// less than healScrubPassInterval after it started. Damaged versions
+// are added to the report of the scrub cycle.
+func (er *erasureObjects) healScrubErasureSet(ctx context.Context, bgSeq *healSequence, buckets []BucketInfo, cfg heal.Config, report *healScrubReport) error {
+	cp := er.loadHealScrubCheckpoint(ctx)
+	if !cp.Finished.IsZero() {
+		if UTCNow().Sub(cp.Started) < healScrubPassInterval {
+			return nil
+		}
+		cp = healScrubCheckpoint{}
+	}
+	if cp.Started.IsZero() {
+		cp.Started = UTCNow()
+	}
+
+	opts := madmin.HealOpts{
+		ScanMode: madmin.HealDeepScan,
+		Remove:   healDeleteDangling,
+	}
+	if bgSeq.healDryRun(cfg) {
+		opts.DryRun = true
+		opts.Remove = false
+	}
+
+	lastCheckpoint := UTCNow()
+	for _, bucket := range buckets {
+		if bucket.Name < cp.Bucket {
+			continue
+		}
+		forwardTo := ""
+		if bucket.Name == cp.Bucket {
+			forwardTo = cp.Object
+		}
+		cp.Bucket, cp.Object = bucket.Name, forwardTo
+
+		scrubEntry := func(entry metaCacheEntry) {
+			if entry.isDir() || ctx.Err() != nil {
+				return
+			}
+			fivs, err := entry.fileInfoVersions(bucket.Name)
+			if err != nil {
+				logger.LogIf(ctx, err)
+				return
+			}
+			for _, version := range fivs.Versions {
+				if version.Deleted || version.TransitionStatus == lifecycle.TransitionComplete {
+					continue
+				}
+				if !healScrubStale(version, UTCNow(), cfg.ScrubInterval) {
+					continue
+				}
+				bgSeq.waitIfPaused(ctx)
+				waitForHealSchedule(ctx)
+				waitForHealLoad(ctx, cfg)
+				if err := globalBackgroundHealState.throttle.wait(ctx, cfg.MaxIOPS); err != nil {
+					return
+				}
+
+				res, err := er.HealObject(ctx, bucket.Name, version.Name, version.VersionID, opts)
+				if err != nil {
+					if !isErrObjectNotFound(err) && !isErrVersionNotFound(err) && ctx.Err() == nil {
+						logger.LogIf(ctx, err)
+						report.add(healScrubReportItem(er.poolIndex, er.setNumber, version, res, err, opts.DryRun))
+						bgSeq.logHealFailed(madmin.HealItemObject)
+						retryOpts := opts
+						bgSeq.retryHeal(healSource{
+							bucket:    bucket.Name,
+							object:    version.Name,
+							versionID: version.VersionID,
+							opts:      &retryOpts,
+						}, err, er.poolIndex, er.setNumber)
+					}
+					continue
+				}
+				report.logScanned()
+				if healResultNeedsHeal(res) {
+					report.add(healScrubReportItem(er.poolIndex, er.setNumber, version, res, nil, opts.DryRun))
+					if opts.DryRun {
+						bgSeq.logWouldHeal(res)
+						continue
+					}
+					bgSeq.logHealed(madmin.HealItemObject)
+				}
+				if err = er.markHealScrubVerified(ctx, bucket.Name, version.Name, version.VersionID, UTCNow()); err != nil {
+					if !isErrObjectNotFound(err) && !isErrVersionNotFound(err) {
+						logger.LogIf(ctx, err)
+					}
+				}
+			}
+			if time.Since(lastCheckpoint) >= healCheckpointInterval {
+				cp.Object = entry.name
+				er.saveHealScrubCheckpoint(ctx, cp)
+				lastCheckpoint = time.Now()
+			}
+		}
+
+		disks := er.getOnlineDisks()
+		if len(disks) == 0 {
+			return errors.New("healScrubErasureSet: No online disks found")
+		}
+		err := listPathRaw(ctx, listPathRawOptions{
+			disks:          disks,
+			bucket:         bucket.Name,
+			recursive:      true,
+			forwardTo:      forwardTo,
+			minDisks:       1,
+			reportNotFound: false,
+			agreed:         scrubEntry,
+			partial: func(entries metaCacheEntries, nAgreed int, errs []error) {
+				if entry, _ := entries.firstFound(); entry != nil {
+					scrubEntry(*entry)
+				}
+			},
+		})
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			er.saveHealScrubCheckpoint(ctx, cp)
+			return err
+		}
+	}
+
+	cp.Finished = UTCNow()
+	cp.Bucket, cp.Object = "", ""
+	er.saveHealScrubCheckpoint(ctx, cp)
+	return nil
+}
@@ -0,0 +1,117 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/minio/minio/cmd/config/heal"
+)
+
+func TestHealScrubStale(t *testing.T) {
+	now := time.Date(2021, 3, 31, 10, 0, 0, 0, time.UTC)
+	interval := 30 * 24 * time.Hour
+	testCases := []struct {
+		modTime  time.Time
+		verified string
+		expected bool
+	}{
+		{now.Add(-time.Hour), "", false},
+		{now.Add(-interval), "", true},
+		{now.Add(-2 * interval), now.Add(-time.Hour).Format(time.RFC3339), false},
+		{now.Add(-2 * interval), now.Add(-interval).Format(time.RFC3339), true},
+		// Invalid timestamps fall back to the modification time.
+		{now.Add(-2 * interval), "invalid", true},
+	}
+	for i, testCase := range testCases {
+		fi := FileInfo{ModTime: testCase.modTime}
+		if testCase.verified != "" {
+			fi.Metadata = map[string]string{healScrubVerifiedKey: testCase.verified}
+		}
+		if got := healScrubStale(fi, now, interval); got != testCase.expected {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.expected, got)
+		}
+	}
+}
+
+func TestHealScrubErasureSet(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resetGlobalHealState()
+	defer resetGlobalHealState()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	bucket, object := "bucket", "object"
+	if err = obj.MakeBucketWithLocation(ctx, bucket, BucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	data := bytes.Repeat([]byte("a"), 1024)
+	_, err = obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	er := obj.(*erasureServerPools).serverPools[0].sets[0]
+	cfg := heal.Config{ScrubInterval: time.Nanosecond}
+	buckets := []BucketInfo{{Name: bucket}}
+	report := newHealScrubReport(UTCNow())
+	if err = er.healScrubErasureSet(ctx, newBgHealSequence(), buckets, cfg, report); err != nil {
+		t.Fatal(err)
+	}
+	if report.report.Scanned != 1 || len(report.report.Items) != 0 {
+		t.Fatalf("expected one clean object in the scrub report, got %#v", report.report)
+	}
+
+	fi, _, _, err := er.getObjectFileInfo(ctx, bucket, object, ObjectOptions{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fi.Metadata[healScrubVerifiedKey]; !ok {
+		t.Fatalf("expected the object to be marked verified, got %v", fi.Metadata)
+	}
+	cp := er.loadHealScrubCheckpoint(ctx)
+	if cp.Finished.IsZero() || cp.Bucket != "" {
+		t.Fatalf("expected the scrub pass to be finished, got %#v", cp)
+	}
+
+	// The next pass only starts once healScrubPassInterval elapsed.
+	if err = er.healScrubErasureSet(ctx, newBgHealSequence(), buckets, cfg, newHealScrubReport(UTCNow())); err != nil {
+		t.Fatal(err)
+	}
+	if next := er.loadHealScrubCheckpoint(ctx); !next.Started.Equal(cp.Started) {
+		t.Fatalf("expected no new scrub pass, got %#v", next)
+	}
+
+	// The object is still readable once marked verified.
+	var buf bytes.Buffer
+	if err = GetObject(ctx, obj, bucket, object, 0, int64(len(data)), &buf, "", ObjectOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), data) {
+		t.Fatal("expected object data to be unchanged")
+	}
+}
@@ -185,3 +185,68 @@ func (st *HTTPStats) updateStats(api string, r *http.Request, w *logger.Response
 func newHTTPStats() *HTTPStats {
 	return &HTTPStats{}
 }
+
+// bucketHTTPAPIStats holds statistics information about
+// HTTP requests made to a bucket, by API.
+type bucketHTTPAPIStats struct {
+	totalRequests HTTPAPIStats
+	totalErrors   HTTPAPIStats
+}
+
+// BucketHTTPStats holds statistics information about
+// HTTP requests made to each bucket
+type BucketHTTPStats struct {
+	sync.RWMutex
+	buckets map[string]*bucketHTTPAPIStats
+}
+
+// Update statistics of the bucket from http response data
+func (st *BucketHTTPStats) updateStats(bucket, api string, w *logger.ResponseWriter) {
+	st.Lock()
+	stats, ok := st.buckets[bucket]
+	if !ok {
+		stats = &bucketHTTPAPIStats{}
+		st.buckets[bucket] = stats
+	}
+	st.Unlock()
+
+	stats.totalRequests.Inc(api)
+	if (w.StatusCode < 200 || w.StatusCode >= 300) && w.StatusCode != 0 {
+		stats.totalErrors.Inc(api)
+	}
+	bucketHTTPRequestsDuration.With(prometheus.Labels{"bucket": bucket, "api": api}).Observe(w.TimeToFirstByte.Seconds())
+}
+
+// load returns the total requests and errors of every bucket by API.
+func (st *BucketHTTPStats) load() (requests, errors map[string]map[string]int) {
+	st.RLock()
+	defer st.RUnlock()
+	requests = make(map[string]map[string]int, len(st.buckets))
+	errors = make(map[string]map[string]int, len(st.buckets))
+	for bucket, stats := range st.buckets {
+		requests[bucket] = stats.totalRequests.Load()
+		errors[bucket] = stats.totalErrors.Load()
+	}
+	return requests, errors
+}
+
+// delete removes the statistics of a deleted bucket.
+func (st *BucketHTTPStats) delete(bucket string) {
+	st.Lock()
+	defer st.Unlock()
+	stats, ok := st.buckets[bucket]
+	if !ok {
+		return
+	}
+	for api := range stats.totalRequests.Load() {
+		bucketHTTPRequestsDuration.DeleteLabelValues(bucket, api)
+	}
+	delete(st.buckets, bucket)
+}
+
+// Prepare new BucketHTTPStats structure
+func newBucketHTTPStats() *BucketHTTPStats {
+	return &BucketHTTPStats{
+		buckets: make(map[string]*bucketHTTPAPIStats),
+	}
+}
@@ -0,0 +1,66 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/minio/minio/cmd/logger"
+)
+
+func TestBucketHTTPStats(t *testing.T) {
+	stats := newBucketHTTPStats()
+
+	ok := logger.NewResponseWriter(httptest.NewRecorder())
+	ok.WriteHeader(http.StatusOK)
+	notFound := logger.NewResponseWriter(httptest.NewRecorder())
+	notFound.WriteHeader(http.StatusNotFound)
+
+	stats.updateStats("bucket", "getobject", ok)
+	stats.updateStats("bucket", "getobject", notFound)
+	stats.updateStats("bucket", "putobject", ok)
+	stats.updateStats("other", "getobject", ok)
+
+	requests, errs := stats.load()
+	if requests["bucket"]["getobject"] != 2 || requests["bucket"]["putobject"] != 1 || requests["other"]["getobject"] != 1 {
+		t.Fatalf("unexpected requests %v", requests)
+	}
+	if errs["bucket"]["getobject"] != 1 || errs["bucket"]["putobject"] != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	stats.delete("bucket")
+	requests, _ = stats.load()
+	if _, found := requests["bucket"]; found || len(requests) != 1 {
+		t.Fatalf("expected the stats of the deleted bucket to be removed, got %v", requests)
+	}
+}
+
+func TestBucketStatsTracked(t *testing.T) {
+	defer func(sys *BucketMetadataSys) { globalBucketMetadataSys = sys }(globalBucketMetadataSys)
+
+	globalBucketMetadataSys = NewBucketMetadataSys()
+	globalBucketMetadataSys.Set("bucket", newBucketMetadata("bucket"))
+	if !bucketStatsTracked("bucket") {
+		t.Error("expected requests to an existing bucket to be tracked")
+	}
+	if bucketStatsTracked("missing") {
+		t.Error("expected requests to a missing bucket not to be tracked")
+	}
+}
@@ -228,3 +228,28 @@ func Trace(f http.HandlerFunc, logBody bool, w http.ResponseWriter, r *http.Requ
 	}
 	return t
 }
+
+// callTrace returns the trace of a storage layer or OS call on the
+// path, which started at startTime.
+func callTrace(traceType trace.Type, funcName, path string, startTime time.Time) trace.Info {
+	nodeName := GetLocalPeer(globalEndpoints)
+	// strip port from the host address
+	if host, _, err := net.SplitHostPort(nodeName); err == nil {
+		nodeName = host
+	}
+
+	t := trace.Info{
+		TraceType: traceType,
+		NodeName:  nodeName,
+		FuncName:  funcName,
+		Time:      startTime,
+	}
+	duration := time.Since(startTime)
+	switch traceType {
+	case trace.Storage:
+		t.StorageStats = trace.StorageStats{Path: path, Duration: duration}
+	case trace.OS:
+		t.OSStats = trace.OSStats{Path: path, Duration: duration}
+	}
+	return t
+}
@@ -31,6 +31,7 @@ import (
 	humanize "github.com/dustin/go-humanize"
 	"github.com/minio/minio-go/v7/pkg/set"
 	"github.com/minio/minio/cmd/config"
+	xjwt "github.com/minio/minio/cmd/jwt"
 	"github.com/minio/minio/cmd/logger"
 	"github.com/minio/minio/pkg/auth"
 	iampolicy "github.com/minio/minio/pkg/iam/policy"
@@ -1217,6 +1218,44 @@ func (sys *IAMSys) GetServiceAccountParent(ctx context.Context, accessKey string
 	return "", nil
 }
 
+// GetServiceAccount - returns the credentials of a service account along
+// with its inline policy, the policy is nil when the service account
+// inherits the policies of its parent.
+func (sys *IAMSys) GetServiceAccount(ctx context.Context, accessKey string) (auth.Credentials, *iampolicy.Policy, error) {
+	if !sys.Initialized() {
+		return auth.Credentials{}, nil, errServerNotInitialized
+	}
+
+	sys.store.rlock()
+	sa, ok := sys.iamUsersMap[accessKey]
+	sys.store.runlock()
+
+	if !ok || !sa.IsServiceAccount() {
+		return auth.Credentials{}, nil, errNoSuchServiceAccount
+	}
+
+	claims := xjwt.NewMapClaims()
+	if err := xjwt.ParseWithClaims(sa.SessionToken, claims, func(*xjwt.MapClaims) ([]byte, error) {
+		return []byte(globalActiveCred.SecretKey), nil
+	}); err != nil {
+		return auth.Credentials{}, nil, err
+	}
+
+	sp, ok := claims.Lookup(iampolicy.SessionPolicyName)
+	if !ok {
+		return sa, nil, nil
+	}
+	spBytes, err := base64.StdEncoding.DecodeString(sp)
+	if err != nil {
+		return auth.Credentials{}, nil, err
+	}
+	policy, err := iampolicy.ParseConfig(bytes.NewReader(spBytes))
+	if err != nil {
+		return auth.Credentials{}, nil, err
+	}
+	return sa, policy, nil
+}
+
 // DeleteServiceAccount - delete a service account
 func (sys *IAMSys) DeleteServiceAccount(ctx context.Context, accessKey string) error {
 	if !sys.Initialized() {
@@ -39,6 +39,9 @@ type lockRequesterInfo struct {
 	Owner string
 	// Quorum represents the quorum required for this lock to be active.
 	Quorum int
+	// Waiting indicates that the lock was requested by this node but
+	// is not granted yet, Timestamp is then the time of the request.
+	Waiting bool
 }
 
 // isWriteLock returns whether the lock is a write or read lock.
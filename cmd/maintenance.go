@@ -0,0 +1,127 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+// maintenanceState keeps track of the background subsystems
+// paused on this server, workers of a paused subsystem block
+// in waitIfPaused until the subsystem is resumed.
+type maintenanceState struct {
+	mu      sync.Mutex
+	enabled bool
+	since   time.Time
+	// paused maps a subsystem to a channel closed on resume.
+	paused map[string]chan struct{}
+}
+
+var globalMaintenance = newMaintenanceState()
+
+func newMaintenanceState() *maintenanceState {
+	return &maintenanceState{
+		paused: make(map[string]chan struct{}),
+	}
+}
+
+// pause the given background subsystems.
+func (m *maintenanceState) pause(subsystems ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, subsys := range subsystems {
+		if _, ok := m.paused[subsys]; !ok {
+			m.paused[subsys] = make(chan struct{})
+		}
+	}
+}
+
+// resume the given background subsystems, waking up all waiting workers.
+func (m *maintenanceState) resume(subsystems ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, subsys := range subsystems {
+		if resumeCh, ok := m.paused[subsys]; ok {
+			close(resumeCh)
+			delete(m.paused, subsys)
+		}
+	}
+}
+
+// enable maintenance mode, pausing all background subsystems.
+func (m *maintenanceState) enable() {
+	m.pause(madmin.MaintenanceSubsystems...)
+	m.mu.Lock()
+	if !m.enabled {
+		m.enabled = true
+		m.since = UTCNow()
+	}
+	m.mu.Unlock()
+}
+
+// disable maintenance mode, resuming all background subsystems.
+func (m *maintenanceState) disable() {
+	m.mu.Lock()
+	m.enabled = false
+	m.since = time.Time{}
+	m.mu.Unlock()
+	m.resume(madmin.MaintenanceSubsystems...)
+}
+
+// isPaused returns true if the background subsystem is paused.
+func (m *maintenanceState) isPaused(subsys string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.paused[subsys]
+	return ok
+}
+
+// waitIfPaused blocks while the background subsystem is paused,
+// it returns early if the context is canceled.
+func (m *maintenanceState) waitIfPaused(ctx context.Context, subsys string) {
+	m.mu.Lock()
+	resumeCh, ok := m.paused[subsys]
+	m.mu.Unlock()
+	if !ok {
+		return
+	}
+	select {
+	case <-resumeCh:
+	case <-ctx.Done():
+	}
+}
+
+// status returns the maintenance status of this server.
+func (m *maintenanceState) status() madmin.ServerMaintenanceStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	st := madmin.ServerMaintenanceStatus{
+		Endpoint: GetLocalPeer(globalEndpoints),
+		Enabled:  m.enabled,
+		Since:    m.since,
+	}
+	for subsys := range m.paused {
+		st.Paused = append(st.Paused, subsys)
+	}
+	sort.Strings(st.Paused)
+	return st
+}
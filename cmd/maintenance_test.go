@@ -0,0 +1,67 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestMaintenanceState(t *testing.T) {
+	m := newMaintenanceState()
+
+	// Not paused, must not block.
+	m.waitIfPaused(context.Background(), madmin.MaintenanceHeal)
+
+	m.enable()
+	st := m.status()
+	if !st.Enabled || len(st.Paused) != len(madmin.MaintenanceSubsystems) {
+		t.Fatalf("expected all subsystems to be paused, got %#v", st)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		m.waitIfPaused(context.Background(), madmin.MaintenanceReplication)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("expected waitIfPaused to block in maintenance mode")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// Canceled context must not block.
+	m.waitIfPaused(ctx, madmin.MaintenanceScanner)
+
+	m.disable()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected waitIfPaused to return after maintenance mode is disabled")
+	}
+
+	st = m.status()
+	if st.Enabled || len(st.Paused) != 0 {
+		t.Fatalf("expected no paused subsystems, got %#v", st)
+	}
+}
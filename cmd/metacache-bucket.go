@@ -286,6 +286,7 @@ func (b *bucketMetacache) cleanup() {
 	// Entries to remove.
 	remove := make(map[string]struct{})
 	currentCycle := intDataUpdateTracker.current()
+	maxStaleness, _ := bucketListCacheStaleness(b.bucket)
 
 	// Test on a copy
 	// cleanup is the only one deleting caches.
@@ -297,7 +298,7 @@ func (b *bucketMetacache) cleanup() {
 			remove[id] = struct{}{}
 			continue
 		}
-		if !cache.worthKeeping(currentCycle) {
+		if !cache.worthKeeping(currentCycle, maxStaleness) {
 			b.debugf("cache %s not worth keeping", id)
 			remove[id] = struct{}{}
 			continue
@@ -83,6 +83,34 @@ func (e *metaCacheEntry) matches(other *metaCacheEntry, bucket string) bool {
 	return eFi.ModTime.Equal(oFi.ModTime) && eFi.Size == oFi.Size && eFi.VersionID == oFi.VersionID
 }
 
+// matchesVersions returns if the entries match by comparing all versions.
+// Unlike matches, divergence in version count or in any single version
+// will be reported as a mismatch.
+func (e *metaCacheEntry) matchesVersions(other *metaCacheEntry, bucket string) bool {
+	if !e.matches(other, bucket) {
+		return false
+	}
+	if e.isDir() || other.isDir() {
+		return e.isDir() == other.isDir()
+	}
+	eFivs, eErr := e.fileInfoVersions(bucket)
+	oFivs, oErr := other.fileInfoVersions(bucket)
+	if eErr != nil || oErr != nil {
+		return false
+	}
+	if len(eFivs.Versions) != len(oFivs.Versions) {
+		return false
+	}
+	for i := range eFivs.Versions {
+		eFi, oFi := eFivs.Versions[i], oFivs.Versions[i]
+		if eFi.VersionID != oFi.VersionID || eFi.Deleted != oFi.Deleted ||
+			eFi.Size != oFi.Size || !eFi.ModTime.Equal(oFi.ModTime) {
+			return false
+		}
+	}
+	return true
+}
+
 // isInDir returns whether the entry is in the dir when considering the separator.
 func (e metaCacheEntry) isInDir(dir, separator string) bool {
 	if len(dir) == 0 {
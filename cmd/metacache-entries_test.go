@@ -21,6 +21,9 @@ import (
 	"reflect"
 	"sort"
 	"testing"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 func Test_metaCacheEntries_sort(t *testing.T) {
@@ -263,3 +266,42 @@ func Test_metaCacheEntry_isInDir(t *testing.T) {
 		})
 	}
 }
+
+func Test_metaCacheEntry_matchesVersions(t *testing.T) {
+	v1, v2 := uuid.New().String(), uuid.New().String()
+	t1 := time.Unix(1600000000, 0).UTC()
+	t2 := t1.Add(time.Minute)
+	t3 := t2.Add(time.Minute)
+
+	newEntry := func(versions ...FileInfo) metaCacheEntry {
+		var xlMeta xlMetaV2
+		for _, fi := range versions {
+			if err := xlMeta.AddVersion(fi); err != nil {
+				t.Fatal(err)
+			}
+		}
+		buf, err := xlMeta.MarshalMsg(append(xlHeader[:], xlVersionV1[:]...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return metaCacheEntry{name: "object", metadata: buf}
+	}
+
+	a := newEntry(FileInfo{VersionID: v1, ModTime: t1, Deleted: true}, FileInfo{VersionID: v2, ModTime: t3, Deleted: true})
+	b := newEntry(FileInfo{VersionID: v1, ModTime: t1, Deleted: true}, FileInfo{VersionID: v2, ModTime: t3, Deleted: true})
+	c := newEntry(FileInfo{VersionID: v1, ModTime: t2, Deleted: true}, FileInfo{VersionID: v2, ModTime: t3, Deleted: true})
+	d := newEntry(FileInfo{VersionID: v2, ModTime: t3, Deleted: true})
+
+	if !a.matchesVersions(&b, "bucket") {
+		t.Error("identical entries should match")
+	}
+	if !a.matches(&c, "bucket") {
+		t.Error("entries with the same latest version should match")
+	}
+	if a.matchesVersions(&c, "bucket") {
+		t.Error("entries with a diverging older version should not match")
+	}
+	if a.matchesVersions(&d, "bucket") {
+		t.Error("entries with a different version count should not match")
+	}
+}
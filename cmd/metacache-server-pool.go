@@ -110,12 +110,17 @@ func (z *erasureServerPools) listPath(ctx context.Context, o listPathOptions) (e
 		o.BaseDir = o.Prefix
 	}
 
+	if o.MaxStaleness == 0 {
+		o.MaxStaleness, _ = bucketListCacheStaleness(o.Bucket)
+	}
+
 	// For very small recursive listings, don't same cache.
 	// Attempts to avoid expensive listings to run for a long
 	// while when clients aren't interested in results.
 	// If the client DOES resume the listing a full cache
 	// will be generated due to the marker without ID and this check failing.
-	if o.Limit < 10 && o.Marker == "" && o.Create && o.Recursive {
+	// Buckets with a listing cache serve these from the cache.
+	if o.Limit < 10 && o.Marker == "" && o.Create && o.Recursive && o.MaxStaleness == 0 {
 		o.discardResult = true
 		o.Transient = true
 	}
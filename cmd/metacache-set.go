@@ -88,6 +88,11 @@ type listPathOptions struct {
 	// OldestCycle indicates the oldest cycle acceptable.
 	OldestCycle uint64
 
+	// MaxStaleness is the maximum age of a cache which is acceptable
+	// regardless of changes since it was started.
+	// Set for buckets with a listing cache enabled.
+	MaxStaleness time.Duration
+
 	// Include pure directories.
 	IncludeDirectories bool
 
@@ -794,6 +799,10 @@ type listPathRawOptions struct {
 	minDisks       int
 	reportNotFound bool
 
+	// strict requires all versions of an entry to match
+	// before it is reported as agreed.
+	strict bool
+
 	// Callbacks with results:
 	// If set to nil, it will not be called.
 
@@ -900,7 +909,8 @@ func listPathRaw(ctx context.Context, opts listPathRawOptions) (err error) {
 				continue
 			}
 			// If exact match, we agree.
-			if current.matches(&entry, opts.bucket) {
+			if (!opts.strict && current.matches(&entry, opts.bucket)) ||
+				(opts.strict && current.matchesVersions(&entry, opts.bucket)) {
 				topEntries[i] = entry
 				agree++
 				continue
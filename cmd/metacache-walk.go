@@ -269,6 +269,7 @@ func (p *xlStorageDiskIDCheck) WalkDir(ctx context.Context, opts WalkDirOptions,
 	if err := p.checkDiskStale(); err != nil {
 		return err
 	}
+	defer p.traceStorage("WalkDir", opts.Bucket, opts.BaseDir)()
 	return p.storage.WalkDir(ctx, opts, wr)
 }
 
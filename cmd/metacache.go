@@ -84,7 +84,14 @@ func (m *metacache) matches(o *listPathOptions, extend time.Duration) bool {
 		o.debugf("cache %s state or stream version mismatch", m.id)
 		return false
 	}
-	if m.startedCycle < o.OldestCycle {
+	if o.MaxStaleness > 0 {
+		// Listing cache of the bucket, changes since the cache
+		// was started are accepted for a bounded time.
+		if time.Since(m.started) > o.MaxStaleness {
+			o.debugf("cache %s older than max staleness %v", m.id, o.MaxStaleness)
+			return false
+		}
+	} else if m.startedCycle < o.OldestCycle {
 		o.debugf("cache %s cycle too old", m.id)
 		return false
 	}
@@ -115,7 +122,7 @@ func (m *metacache) matches(o *listPathOptions, extend time.Duration) bool {
 		return false
 	}
 
-	if m.finished() && m.endedCycle <= o.OldestCycle {
+	if o.MaxStaleness == 0 && m.finished() && m.endedCycle <= o.OldestCycle {
 		if extend <= 0 {
 			// If scan has ended the oldest requested must be less.
 			o.debugf("cache %s ended and cycle (%v) <= oldest allowed (%v)", m.id, m.endedCycle, o.OldestCycle)
@@ -132,7 +139,9 @@ func (m *metacache) matches(o *listPathOptions, extend time.Duration) bool {
 }
 
 // worthKeeping indicates if the cache by itself is worth keeping.
-func (m *metacache) worthKeeping(currentCycle uint64) bool {
+// maxStaleness is the staleness bound of the listing cache of the
+// bucket, zero if the bucket has none.
+func (m *metacache) worthKeeping(currentCycle uint64, maxStaleness time.Duration) bool {
 	if m == nil {
 		return false
 	}
@@ -147,6 +156,9 @@ func (m *metacache) worthKeeping(currentCycle uint64) bool {
 	case cache.finished() && time.Since(cache.lastHandout) > 48*time.Hour:
 		// Keep only for 2 days. Fallback if scanner is clogged.
 		return false
+	case cache.finished() && cache.status == scanStateSuccess && time.Since(cache.started) <= maxStaleness:
+		// Listings of the bucket may still be served from it.
+		return true
 	case cache.finished() && currentCycle >= dataUsageUpdateDirCycles && cache.startedCycle < currentCycle-dataUsageUpdateDirCycles:
 		// Cycle is too old to be valuable.
 		return false
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/minio/minio/cmd/logger"
+	bucketBandwidth "github.com/minio/minio/pkg/bucket/bandwidth"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	dto "github.com/prometheus/client_model/go"
@@ -99,6 +100,9 @@ const (
 	writeBytes    MetricName = "write_bytes"
 	wcharBytes    MetricName = "wchar_bytes"
 
+	bandwidthCurrentBytes MetricName = "bandwidth_current_bytes"
+	bandwidthLimitBytes   MetricName = "bandwidth_limit_bytes"
+
 	usagePercent MetricName = "update_percent"
 
 	commitInfo  MetricName = "commit_info"
@@ -182,6 +186,8 @@ func GetGeneratorsForPeer() []MetricsGenerator {
 		getMinioVersionMetrics,
 		getNetworkMetrics,
 		getS3TTFBMetric,
+		getReplicationBandwidthMetrics,
+		getBucketHTTPMetrics,
 	}
 	return g
 }
@@ -337,6 +343,24 @@ func getBucketRepReceivedBytesMD() MetricDescription {
 		Type:      gaugeMetric,
 	}
 }
+func getBucketRepBandwidthLimitMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: replicationSubsystem,
+		Name:      bandwidthLimitBytes,
+		Help:      "Bandwidth limit in bytes per second of the replication to the target bucket.",
+		Type:      gaugeMetric,
+	}
+}
+func getBucketRepBandwidthCurrentMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: replicationSubsystem,
+		Name:      bandwidthCurrentBytes,
+		Help:      "Current bandwidth in bytes per second of the replication to the target bucket.",
+		Type:      gaugeMetric,
+	}
+}
 func getBucketObjectDistributionMD() MetricDescription {
 	return MetricDescription{
 		Namespace: bucketMetricNamespace,
@@ -562,6 +586,33 @@ func getS3TTFBDistributionMD() MetricDescription {
 		Type:      gaugeMetric,
 	}
 }
+func getBucketRequestsTotalMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: requestsSubsystem,
+		Name:      total,
+		Help:      "Total number of S3 requests to the bucket, by API.",
+		Type:      counterMetric,
+	}
+}
+func getBucketRequestsErrorsMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: requestsSubsystem,
+		Name:      errorsTotal,
+		Help:      "Total number of S3 requests to the bucket with errors, by API.",
+		Type:      counterMetric,
+	}
+}
+func getBucketTTFBDistributionMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: timeSubsystem,
+		Name:      ttfbDistribution,
+		Help:      "Distribution of the time to first byte of S3 requests to the bucket, by API.",
+		Type:      gaugeMetric,
+	}
+}
 func getMinioFDOpenMD() MetricDescription {
 	return MetricDescription{
 		Namespace: nodeMetricNamespace,
@@ -748,47 +799,83 @@ func getGoMetrics() MetricsGroup {
 		},
 	}
 }
+// collectHistogramMetrics converts the prometheus histogram data to
+// internal metric data with the description.
+func collectHistogramMetrics(histogram *prometheus.HistogramVec, description MetricDescription, metrics *MetricsGroup) {
+	// Read prometheus metric on this channel
+	ch := make(chan prometheus.Metric)
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	// Read prometheus histogram data and convert it to internal metric data
+	go func() {
+		defer wg.Done()
+		for promMetric := range ch {
+			dtoMetric := &dto.Metric{}
+			err := promMetric.Write(dtoMetric)
+			if err != nil {
+				logger.LogIf(GlobalContext, err)
+				return
+			}
+			h := dtoMetric.GetHistogram()
+			for _, b := range h.Bucket {
+				labels := make(map[string]string)
+				for _, lp := range dtoMetric.GetLabel() {
+					labels[*lp.Name] = *lp.Value
+				}
+				labels["le"] = fmt.Sprintf("%.3f", *b.UpperBound)
+				metric := Metric{
+					Description:    description,
+					VariableLabels: labels,
+					Value:          float64(b.GetCumulativeCount()),
+				}
+				metrics.Metrics = append(metrics.Metrics, metric)
+			}
+		}
+
+	}()
+
+	histogram.Collect(ch)
+	close(ch)
+	wg.Wait()
+}
+
 func getS3TTFBMetric() MetricsGroup {
 	return MetricsGroup{
 		Metrics: []Metric{},
 		initialize: func(ctx context.Context, metrics *MetricsGroup) {
+			collectHistogramMetrics(httpRequestsDuration, getS3TTFBDistributionMD(), metrics)
+		},
+	}
+}
 
-			// Read prometheus metric on this channel
-			ch := make(chan prometheus.Metric)
-			var wg sync.WaitGroup
-			wg.Add(1)
-
-			// Read prometheus histogram data and convert it to internal metric data
-			go func() {
-				defer wg.Done()
-				for promMetric := range ch {
-					dtoMetric := &dto.Metric{}
-					err := promMetric.Write(dtoMetric)
-					if err != nil {
-						logger.LogIf(GlobalContext, err)
-						return
-					}
-					h := dtoMetric.GetHistogram()
-					for _, b := range h.Bucket {
-						labels := make(map[string]string)
-						for _, lp := range dtoMetric.GetLabel() {
-							labels[*lp.Name] = *lp.Value
-						}
-						labels["le"] = fmt.Sprintf("%.3f", *b.UpperBound)
-						metric := Metric{
-							Description:    getS3TTFBDistributionMD(),
-							VariableLabels: labels,
-							Value:          float64(b.GetCumulativeCount()),
-						}
-						metrics.Metrics = append(metrics.Metrics, metric)
-					}
+func getBucketHTTPMetrics() MetricsGroup {
+	return MetricsGroup{
+		Metrics: []Metric{},
+		initialize: func(ctx context.Context, metrics *MetricsGroup) {
+			if !globalAPIConfig.bucketMetricsEnabled() {
+				return
+			}
+			requests, errors := globalBucketHTTPStats.load()
+			for bucket, apiStats := range requests {
+				for api, value := range apiStats {
+					metrics.Metrics = append(metrics.Metrics, Metric{
+						Description:    getBucketRequestsTotalMD(),
+						Value:          float64(value),
+						VariableLabels: map[string]string{"bucket": bucket, "api": api},
+					})
 				}
-
-			}()
-
-			httpRequestsDuration.Collect(ch)
-			close(ch)
-			wg.Wait()
+			}
+			for bucket, apiStats := range errors {
+				for api, value := range apiStats {
+					metrics.Metrics = append(metrics.Metrics, Metric{
+						Description:    getBucketRequestsErrorsMD(),
+						Value:          float64(value),
+						VariableLabels: map[string]string{"bucket": bucket, "api": api},
+					})
+				}
+			}
+			collectHistogramMetrics(bucketHTTPRequestsDuration, getBucketTTFBDistributionMD(), metrics)
 		},
 	}
 }
@@ -1013,7 +1100,7 @@ func getBucketUsageMetrics() MetricsGroup {
 				return
 			}
 
-			if globalIsGateway {
+			if !globalAPIConfig.bucketMetricsEnabled() {
 				return
 			}
 
@@ -1074,6 +1161,29 @@ func getBucketUsageMetrics() MetricsGroup {
 		},
 	}
 }
+func getReplicationBandwidthMetrics() MetricsGroup {
+	return MetricsGroup{
+		Metrics: []Metric{},
+		initialize: func(ctx context.Context, metrics *MetricsGroup) {
+			if globalBucketMonitor == nil || !globalAPIConfig.bucketMetricsEnabled() {
+				return
+			}
+			report := globalBucketMonitor.GetReport(bucketBandwidth.SelectBuckets())
+			for bucket, details := range report.BucketStats {
+				metrics.Metrics = append(metrics.Metrics, Metric{
+					Description:    getBucketRepBandwidthLimitMD(),
+					Value:          float64(details.LimitInBytesPerSecond),
+					VariableLabels: map[string]string{"bucket": bucket},
+				})
+				metrics.Metrics = append(metrics.Metrics, Metric{
+					Description:    getBucketRepBandwidthCurrentMD(),
+					Value:          details.CurrentBandwidthInBytesPerSecond,
+					VariableLabels: map[string]string{"bucket": bucket},
+				})
+			}
+		},
+	}
+}
 func getLocalStorageMetrics() MetricsGroup {
 	return MetricsGroup{
 		Metrics: []Metric{},
@@ -18,6 +18,7 @@ package cmd
 
 import (
 	"net/http"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -36,6 +37,16 @@ var (
 		},
 		[]string{"api"},
 	)
+	// bucketHTTPRequestsDuration is only exported by the v2 metrics,
+	// when per bucket metrics are enabled.
+	bucketHTTPRequestsDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "s3_bucket_ttfb_seconds",
+			Help:    "Time taken by requests to a bucket served by current MinIO server instance",
+			Buckets: []float64{.05, .1, .25, .5, 1, 2.5, 5, 10},
+		},
+		[]string{"bucket", "api"},
+	)
 	minioVersionInfo = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: "minio",
@@ -181,6 +192,77 @@ func healingMetricsPrometheus(ch chan<- prometheus.Metric) {
 			float64(v), string(s[0]), string(s[1]),
 		)
 	}
+	for k, v := range bgSeq.getFailedItemsMap() {
+		ch <- prometheus.MustNewConstMetric(
+			prometheus.NewDesc(
+				prometheus.BuildFQName(healMetricsNamespace, "objects", "failed"),
+				"Objects which failed to heal in current self healing run",
+				[]string{"type"}, nil),
+			prometheus.GaugeValue,
+			float64(v), string(k),
+		)
+	}
+
+	queued := len(bgSeq.sourceCh) + len(bgSeq.mrfCh)
+	if globalBackgroundHealRoutine != nil {
+		queued += len(globalBackgroundHealRoutine.tasks)
+	}
+	ch <- prometheus.MustNewConstMetric(
+		prometheus.NewDesc(
+			prometheus.BuildFQName(healMetricsNamespace, "queue", "depth"),
+			"Items waiting to be healed",
+			nil, nil),
+		prometheus.GaugeValue,
+		float64(queued),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		prometheus.NewDesc(
+			prometheus.BuildFQName(healMetricsNamespace, "drives", "healing"),
+			"Drives currently being healed",
+			nil, nil),
+		prometheus.GaugeValue,
+		float64(globalBackgroundHealState.healDriveCount()),
+	)
+
+	for _, p := range globalBackgroundHealState.getSetHealProgress() {
+		pool, set := strconv.Itoa(p.PoolIndex+1), strconv.Itoa(p.SetIndex+1)
+		ch <- prometheus.MustNewConstMetric(
+			prometheus.NewDesc(
+				prometheus.BuildFQName(healMetricsNamespace, "set", "objects_total"),
+				"Estimated objects in the erasure set being healed",
+				[]string{"pool", "set"}, nil),
+			prometheus.GaugeValue,
+			float64(p.ObjectsTotal), pool, set,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			prometheus.NewDesc(
+				prometheus.BuildFQName(healMetricsNamespace, "set", "objects_scanned"),
+				"Objects scanned in the erasure set being healed",
+				[]string{"pool", "set"}, nil),
+			prometheus.GaugeValue,
+			float64(p.ObjectsScanned), pool, set,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			prometheus.NewDesc(
+				prometheus.BuildFQName(healMetricsNamespace, "set", "objects_healed"),
+				"Objects healed in the erasure set being healed",
+				[]string{"pool", "set"}, nil),
+			prometheus.GaugeValue,
+			float64(p.ObjectsHealed), pool, set,
+		)
+		var eta float64
+		if !p.ETA.IsZero() {
+			eta = time.Until(p.ETA).Seconds()
+		}
+		ch <- prometheus.MustNewConstMetric(
+			prometheus.NewDesc(
+				prometheus.BuildFQName(healMetricsNamespace, "set", "eta_seconds"),
+				"Estimated seconds until the erasure set is healed, 0 while unknown",
+				[]string{"pool", "set"}, nil),
+			prometheus.GaugeValue,
+			eta, pool, set,
+		)
+	}
 }
 
 // collects gateway specific metrics for MinIO instance in Prometheus specific format
@@ -427,7 +509,7 @@ func bucketUsageMetricsPrometheus(ch chan<- prometheus.Metric) {
 		return
 	}
 
-	if globalIsGateway {
+	if globalIsGateway || !globalAPIConfig.bucketMetricsEnabled() {
 		return
 	}
 
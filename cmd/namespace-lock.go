@@ -28,6 +28,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cespare/xxhash/v2"
 	"github.com/minio/minio/cmd/logger"
 	"github.com/minio/minio/pkg/dsync"
 	"github.com/minio/minio/pkg/lsync"
@@ -36,6 +37,9 @@ import (
 // local lock servers
 var globalLockServer *localLocker
 
+// distributed lock requests of this node waiting to be granted
+var globalLockWaiters = newLockWaiters()
+
 // RWLocker - locker interface to introduce GetRLock, RUnlock.
 type RWLocker interface {
 	GetLock(ctx context.Context, timeout *dynamicTimeout) (timedOutErr error)
@@ -146,6 +150,10 @@ func (di *distLockInstance) GetLock(ctx context.Context, timeout *dynamicTimeout
 	lockSource := getSource(2)
 	start := UTCNow()
 
+	const writer = true
+	globalLockWaiters.add(di.rwMutex.Names, di.opsID, lockSource, writer)
+	defer globalLockWaiters.remove(di.opsID)
+
 	if !di.rwMutex.GetLock(ctx, di.opsID, lockSource, dsync.Options{
 		Timeout: timeout.Timeout(),
 	}) {
@@ -166,6 +174,10 @@ func (di *distLockInstance) GetRLock(ctx context.Context, timeout *dynamicTimeou
 	lockSource := getSource(2)
 	start := UTCNow()
 
+	const writer = false
+	globalLockWaiters.add(di.rwMutex.Names, di.opsID, lockSource, writer)
+	defer globalLockWaiters.remove(di.opsID)
+
 	if !di.rwMutex.GetRLock(ctx, di.opsID, lockSource, dsync.Options{
 		Timeout: timeout.Timeout(),
 	}) {
@@ -260,6 +272,99 @@ func (li *localLockInstance) RUnlock() {
 	}
 }
 
+// Number of shards of lockWaiters, each lock request takes the
+// mutex of one shard only.
+const lockWaitersShards = 64
+
+// lockWaiters keeps track of the lock requests which wait to be
+// granted, so that they can be listed along with the granted locks.
+type lockWaiters struct {
+	shards [lockWaitersShards]lockWaitersShard
+}
+
+type lockWaitersShard struct {
+	mu      sync.Mutex
+	waiting map[string]lockWaiter // keyed by request UID
+}
+
+// lockWaiter is a lock request waiting to be granted.
+type lockWaiter struct {
+	resources []string
+	writer    bool
+	source    string
+	since     time.Time
+}
+
+func newLockWaiters() *lockWaiters {
+	lw := &lockWaiters{}
+	for i := range lw.shards {
+		lw.shards[i].waiting = make(map[string]lockWaiter)
+	}
+	return lw
+}
+
+func (lw *lockWaiters) shard(uid string) *lockWaitersShard {
+	return &lw.shards[xxhash.Sum64String(uid)%lockWaitersShards]
+}
+
+// add records a lock request on resources as waiting, until remove
+// is called once the request is granted or has failed.
+func (lw *lockWaiters) add(resources []string, uid, source string, writer bool) {
+	shard := lw.shard(uid)
+	shard.mu.Lock()
+	shard.waiting[uid] = lockWaiter{
+		resources: resources,
+		writer:    writer,
+		source:    source,
+		since:     UTCNow(),
+	}
+	shard.mu.Unlock()
+}
+
+// remove forgets the lock request added with uid.
+func (lw *lockWaiters) remove(uid string) {
+	shard := lw.shard(uid)
+	shard.mu.Lock()
+	delete(shard.waiting, uid)
+	shard.mu.Unlock()
+}
+
+// dupLockMap returns the waiting lock requests keyed by resource.
+func (lw *lockWaiters) dupLockMap() map[string][]lockRequesterInfo {
+	owner := GetLocalPeer(globalEndpoints)
+	lockCopy := make(map[string][]lockRequesterInfo)
+	for i := range lw.shards {
+		shard := &lw.shards[i]
+		shard.mu.Lock()
+		for uid, w := range shard.waiting {
+			for _, resource := range w.resources {
+				lockCopy[resource] = append(lockCopy[resource], lockRequesterInfo{
+					Name:          resource,
+					Writer:        w.writer,
+					UID:           uid,
+					Timestamp:     w.since,
+					TimeLastCheck: w.since,
+					Source:        w.source,
+					Owner:         owner,
+					Waiting:       true,
+				})
+			}
+		}
+		shard.mu.Unlock()
+	}
+	return lockCopy
+}
+
+// localLockEntries returns the locks granted by this node and the lock
+// requests of this node waiting to be granted, keyed by resource.
+func localLockEntries() map[string][]lockRequesterInfo {
+	locks := globalLockServer.DupLockMap()
+	for resource, lris := range globalLockWaiters.dupLockMap() {
+		locks[resource] = append(locks[resource], lris...)
+	}
+	return locks
+}
+
 func getSource(n int) string {
 	var funcName string
 	pc, filename, lineNum, ok := runtime.Caller(n)
@@ -100,3 +100,31 @@ func TestNSLockRace(t *testing.T) {
 		}
 	}
 }
+
+func TestLockWaiters(t *testing.T) {
+	lw := newLockWaiters()
+
+	lw.add([]string{"bucket/a", "bucket/b"}, "uid1", "source", true)
+	lw.add([]string{"bucket/a"}, "uid2", "source", false)
+
+	locks := lw.dupLockMap()
+	if len(locks["bucket/a"]) != 2 || len(locks["bucket/b"]) != 1 {
+		t.Fatalf("unexpected waiting locks %v", locks)
+	}
+	for _, lri := range locks["bucket/b"] {
+		if !lri.Waiting || !lri.Writer || lri.UID != "uid1" {
+			t.Errorf("unexpected waiting lock %v", lri)
+		}
+	}
+
+	lw.remove("uid1")
+	locks = lw.dupLockMap()
+	if len(locks["bucket/a"]) != 1 || len(locks["bucket/b"]) != 0 {
+		t.Fatalf("unexpected waiting locks %v", locks)
+	}
+
+	lw.remove("uid2")
+	if locks = lw.dupLockMap(); len(locks) != 0 {
+		t.Fatalf("expected no waiting locks, got %v", locks)
+	}
+}
@@ -281,6 +281,182 @@ func (sys *NotificationSys) BackgroundHealStatus() ([]madmin.BgHealState, []Noti
 	return states, ng.Wait()
 }
 
+// BackgroundHealReport - returns the dry-run background heal report of all peers
+func (sys *NotificationSys) BackgroundHealReport() ([]madmin.HealReport, []NotificationPeerErr) {
+	ng := WithNPeers(len(sys.peerClients))
+	reports := make([]madmin.HealReport, len(sys.peerClients))
+	for idx, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		idx := idx
+		client := client
+		ng.Go(GlobalContext, func() error {
+			report, err := client.BackgroundHealReport()
+			if err != nil {
+				return err
+			}
+			reports[idx] = report
+			return nil
+		}, idx, *client.host)
+	}
+
+	return reports, ng.Wait()
+}
+
+// BackgroundHealQueue - returns the items queued for background healing on all peers
+func (sys *NotificationSys) BackgroundHealQueue() ([][]madmin.HealQueueItem, []NotificationPeerErr) {
+	ng := WithNPeers(len(sys.peerClients))
+	queues := make([][]madmin.HealQueueItem, len(sys.peerClients))
+	for idx, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		idx := idx
+		client := client
+		ng.Go(GlobalContext, func() error {
+			items, err := client.BackgroundHealQueue()
+			if err != nil {
+				return err
+			}
+			queues[idx] = items
+			return nil
+		}, idx, *client.host)
+	}
+
+	return queues, ng.Wait()
+}
+
+// BackgroundHealDeadLetters - returns the dead-letter list of background healing on all peers
+func (sys *NotificationSys) BackgroundHealDeadLetters() ([][]madmin.HealDeadLetterItem, []NotificationPeerErr) {
+	ng := WithNPeers(len(sys.peerClients))
+	lists := make([][]madmin.HealDeadLetterItem, len(sys.peerClients))
+	for idx, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		idx := idx
+		client := client
+		ng.Go(GlobalContext, func() error {
+			items, err := client.BackgroundHealDeadLetters()
+			if err != nil {
+				return err
+			}
+			lists[idx] = items
+			return nil
+		}, idx, *client.host)
+	}
+
+	return lists, ng.Wait()
+}
+
+// BackgroundHealAction - pauses or resumes background healing on all peers
+func (sys *NotificationSys) BackgroundHealAction(action madmin.BgHealAction) []NotificationPeerErr {
+	ng := WithNPeers(len(sys.peerClients))
+	for idx, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		client := client
+		ng.Go(GlobalContext, func() error {
+			return client.BackgroundHealAction(action)
+		}, idx, *client.host)
+	}
+	return ng.Wait()
+}
+
+// Maintenance - enables or disables maintenance mode on all peers
+func (sys *NotificationSys) Maintenance(action madmin.MaintenanceAction) []NotificationPeerErr {
+	ng := WithNPeers(len(sys.peerClients))
+	for idx, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		client := client
+		ng.Go(GlobalContext, func() error {
+			return client.Maintenance(action)
+		}, idx, *client.host)
+	}
+	return ng.Wait()
+}
+
+// StopRebalance - stops the rebalance of the pools running on any peer.
+func (sys *NotificationSys) StopRebalance() []NotificationPeerErr {
+	ng := WithNPeers(len(sys.peerClients))
+	for idx, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		client := client
+		ng.Go(GlobalContext, func() error {
+			return client.StopRebalance()
+		}, idx, *client.host)
+	}
+	return ng.Wait()
+}
+
+// StopKeyRotation - stops the key rotation of encrypted objects running
+// on any peer.
+func (sys *NotificationSys) StopKeyRotation() []NotificationPeerErr {
+	ng := WithNPeers(len(sys.peerClients))
+	for idx, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		client := client
+		ng.Go(GlobalContext, func() error {
+			return client.StopKeyRotation()
+		}, idx, *client.host)
+	}
+	return ng.Wait()
+}
+
+// LoadTierConfig - reloads the remote tiers on all peers.
+func (sys *NotificationSys) LoadTierConfig() []NotificationPeerErr {
+	ng := WithNPeers(len(sys.peerClients))
+	for idx, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		client := client
+		ng.Go(GlobalContext, func() error {
+			return client.LoadTierConfig()
+		}, idx, *client.host)
+	}
+	return ng.Wait()
+}
+
+// MaintenanceStatus - returns maintenance status of all peers
+func (sys *NotificationSys) MaintenanceStatus() []madmin.ServerMaintenanceStatus {
+	ng := WithNPeers(len(sys.peerClients))
+	statuses := make([]madmin.ServerMaintenanceStatus, len(sys.peerClients))
+	for idx, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		idx := idx
+		client := client
+		ng.Go(GlobalContext, func() error {
+			st, err := client.MaintenanceStatus()
+			if err != nil {
+				return err
+			}
+			statuses[idx] = st
+			return nil
+		}, idx, *client.host)
+	}
+
+	for idx, nErr := range ng.Wait() {
+		if nErr.Err != nil {
+			statuses[idx] = madmin.ServerMaintenanceStatus{
+				Endpoint: nErr.Host.String(),
+				Err:      nErr.Err.Error(),
+			}
+		}
+	}
+	return statuses
+}
+
 // StartProfiling - start profiling on remote peers, by initiating a remote RPC.
 func (sys *NotificationSys) StartProfiling(profiler string) []NotificationPeerErr {
 	ng := WithNPeers(len(sys.peerClients))
@@ -643,7 +819,7 @@ func (sys *NotificationSys) GetLocks(ctx context.Context, r *http.Request) []*Pe
 	}
 	locksResp = append(locksResp, &PeerLocks{
 		Addr:  getHostName(r),
-		Locks: globalLockServer.DupLockMap(),
+		Locks: localLockEntries(),
 	})
 	return locksResp
 }
@@ -1242,6 +1418,52 @@ func (sys *NotificationSys) ServerInfo() []madmin.ServerProperties {
 	return reply
 }
 
+// DrivesHealth - returns the health of the drives of all peers.
+func (sys *NotificationSys) DrivesHealth() []madmin.ServerDrivesHealth {
+	reply := make([]madmin.ServerDrivesHealth, len(sys.peerClients))
+	var wg sync.WaitGroup
+	for i, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(client *peerRESTClient, idx int) {
+			defer wg.Done()
+			info, err := client.DrivesHealth()
+			if err != nil {
+				info.Addr = client.host.String()
+				info.Error = err.Error()
+			}
+			reply[idx] = info
+		}(client, i)
+	}
+	wg.Wait()
+	return reply
+}
+
+// DriveSpeedtest - runs the drive speedtest on all peers in parallel.
+func (sys *NotificationSys) DriveSpeedtest(ctx context.Context, size, blockSize int64) []madmin.ServerDrivesSpeedtest {
+	reply := make([]madmin.ServerDrivesSpeedtest, len(sys.peerClients))
+	var wg sync.WaitGroup
+	for i, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(client *peerRESTClient, idx int) {
+			defer wg.Done()
+			info, err := client.DriveSpeedtest(ctx, size, blockSize)
+			if err != nil {
+				info.Addr = client.host.String()
+				info.Error = err.Error()
+			}
+			reply[idx] = info
+		}(client, i)
+	}
+	wg.Wait()
+	return reply
+}
+
 // GetLocalDiskIDs - return disk ids of the local disks of the peers.
 func (sys *NotificationSys) GetLocalDiskIDs(ctx context.Context) (localDiskIDs [][]string) {
 	localDiskIDs = make([][]string, len(sys.peerClients))
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	humanize "github.com/dustin/go-humanize"
+	"github.com/minio/minio/cmd/config/storageclass"
 	"github.com/minio/minio/pkg/bucket/replication"
 	"github.com/minio/minio/pkg/hash"
 	"github.com/minio/minio/pkg/madmin"
@@ -73,8 +74,17 @@ type objectHistogramInterval struct {
 const (
 	// dataUsageBucketLen must be length of ObjectsHistogramIntervals
 	dataUsageBucketLen = 7
+
+	// dataUsageStorageClassLen must be length of dataUsageStorageClasses
+	dataUsageStorageClassLen = 2
 )
 
+// dataUsageStorageClasses are the storage classes objects are counted against.
+var dataUsageStorageClasses = [dataUsageStorageClassLen]string{
+	storageclass.STANDARD,
+	storageclass.RRS,
+}
+
 // ObjectsHistogramIntervals is the list of all intervals
 // of object sizes to be included in objects histogram.
 var ObjectsHistogramIntervals = []objectHistogramInterval{
@@ -91,6 +101,7 @@ var ObjectsHistogramIntervals = []objectHistogramInterval{
 // - total size of the bucket
 // - total objects in a bucket
 // - object size histogram per bucket
+// - objects count per storage class
 type BucketUsageInfo struct {
 	Size                   uint64            `json:"size"`
 	ReplicationPendingSize uint64            `json:"objectsPendingReplicationTotalSize"`
@@ -99,6 +110,9 @@ type BucketUsageInfo struct {
 	ReplicaSize            uint64            `json:"objectReplicaTotalSize"`
 	ObjectsCount           uint64            `json:"objectsCount"`
 	ObjectSizesHistogram   map[string]uint64 `json:"objectsSizesHistogram"`
+
+	// ObjectsCountByStorageClass is the number of objects per storage class.
+	ObjectsCountByStorageClass map[string]uint64 `json:"objectsCountByStorageClass,omitempty"`
 }
 
 // DataUsageInfo represents data usage stats of the underlying Object API
@@ -2641,8 +2641,12 @@ func (api objectAPIHandlers) CompleteMultipartUploadHandler(w http.ResponseWrite
 		}
 	}
 
+	// The size of the parts is needed to enforce the hard quota of
+	// the bucket, before the upload is completed.
+	quota := globalBucketQuotaSys.hasHardQuota(bucket)
+
 	partsMap := make(map[string]PartInfo)
-	if isEncrypted {
+	if isEncrypted || quota {
 		maxParts := 10000
 		listPartsInfo, err := objectAPI.ListObjectParts(ctx, bucket, object, uploadID, 0, maxParts, ObjectOptions{})
 		if err != nil {
@@ -2673,6 +2677,23 @@ func (api objectAPIHandlers) CompleteMultipartUploadHandler(w http.ResponseWrite
 		completeParts = append(completeParts, part)
 	}
 
+	if quota {
+		var size int64
+		for _, part := range completeParts {
+			if bkPartInfo, ok := partsMap[strconv.Itoa(part.PartNumber)]; ok {
+				if bkPartInfo.ActualSize > 0 {
+					size += bkPartInfo.ActualSize
+				} else {
+					size += bkPartInfo.Size
+				}
+			}
+		}
+		if err = enforceBucketQuota(ctx, bucket, size); err != nil {
+			writeErrorResponse(ctx, w, toAPIError(ctx, err), r.URL, guessIsBrowserReq(r))
+			return
+		}
+	}
+
 	completeMultiPartUpload := objectAPI.CompleteMultipartUpload
 
 	// This code is specifically to handle the requirements for slow
@@ -0,0 +1,91 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"os"
+	"time"
+
+	"github.com/minio/minio/pkg/disk"
+	"github.com/minio/minio/pkg/trace"
+)
+
+// Names of the traced OS calls.
+const (
+	osMetricOpenFile         = "os.OpenFile"
+	osMetricOpenFileDirectIO = "os.OpenFileDirectIO"
+	osMetricOpen             = "os.Open"
+	osMetricRead             = "os.Read"
+	osMetricRename           = "os.Rename"
+	osMetricRemove           = "os.Remove"
+	osMetricRemoveAll        = "os.RemoveAll"
+	osMetricMkdirAll         = "os.MkdirAll"
+)
+
+// traceOS returns a function which publishes an OS trace of the call
+// on the path when it is called, once the call returns.
+func traceOS(funcName, path string) func() {
+	if globalOSTrace.NumSubscribers() == 0 {
+		return func() {}
+	}
+	startTime := time.Now()
+	return func() {
+		globalOSTrace.Publish(callTrace(trace.OS, funcName, path, startTime))
+	}
+}
+
+// OpenFile captures time taken to call os.OpenFile
+func OpenFile(name string, flag int, perm os.FileMode) (*os.File, error) {
+	defer traceOS(osMetricOpenFile, name)()
+	return os.OpenFile(name, flag, perm)
+}
+
+// OpenFileDirectIO captures time taken to call disk.OpenFileDirectIO
+func OpenFileDirectIO(name string, flag int, perm os.FileMode) (*os.File, error) {
+	defer traceOS(osMetricOpenFileDirectIO, name)()
+	return disk.OpenFileDirectIO(name, flag, perm)
+}
+
+// Open captures time taken to call os.Open
+func Open(name string) (*os.File, error) {
+	defer traceOS(osMetricOpen, name)()
+	return os.Open(name)
+}
+
+// Rename captures time taken to call os.Rename
+func Rename(src, dst string) error {
+	defer traceOS(osMetricRename, src)()
+	return os.Rename(src, dst)
+}
+
+// Remove captures time taken to call os.Remove
+func Remove(name string) error {
+	defer traceOS(osMetricRemove, name)()
+	return os.Remove(name)
+}
+
+// RemoveAll captures time taken to call os.RemoveAll
+func RemoveAll(dirPath string) error {
+	defer traceOS(osMetricRemoveAll, dirPath)()
+	return os.RemoveAll(dirPath)
+}
+
+// MkdirAll captures time taken to call os.MkdirAll
+func MkdirAll(dirPath string, mode os.FileMode) error {
+	defer traceOS(osMetricMkdirAll, dirPath)()
+	return os.MkdirAll(dirPath, mode)
+}
@@ -621,6 +621,99 @@ func (client *peerRESTClient) SignalService(sig serviceSignal) error {
 	return nil
 }
 
+// Maintenance - enables or disables maintenance mode on the peer.
+func (client *peerRESTClient) Maintenance(action madmin.MaintenanceAction) error {
+	values := make(url.Values)
+	values.Set(peerRESTMaintenance, string(action))
+	respBody, err := client.call(peerRESTMethodMaintenance, values, nil, -1)
+	if err != nil {
+		return err
+	}
+	defer http.DrainBody(respBody)
+	return nil
+}
+
+// StopRebalance - stops the rebalance of the pools running on the peer.
+func (client *peerRESTClient) StopRebalance() error {
+	respBody, err := client.call(peerRESTMethodStopRebalance, nil, nil, -1)
+	if err != nil {
+		return err
+	}
+	defer http.DrainBody(respBody)
+	return nil
+}
+
+// StopKeyRotation - stops the key rotation of encrypted objects running
+// on the peer.
+func (client *peerRESTClient) StopKeyRotation() error {
+	respBody, err := client.call(peerRESTMethodStopKeyRotation, nil, nil, -1)
+	if err != nil {
+		return err
+	}
+	defer http.DrainBody(respBody)
+	return nil
+}
+
+// LoadTierConfig - reloads the remote tiers on the peer.
+func (client *peerRESTClient) LoadTierConfig() error {
+	respBody, err := client.call(peerRESTMethodLoadTierConfig, nil, nil, -1)
+	if err != nil {
+		return err
+	}
+	defer http.DrainBody(respBody)
+	return nil
+}
+
+// DrivesHealth - returns the health of the drives of the peer.
+func (client *peerRESTClient) DrivesHealth() (info madmin.ServerDrivesHealth, err error) {
+	respBody, err := client.call(peerRESTMethodDrivesHealth, nil, nil, -1)
+	if err != nil {
+		return
+	}
+	defer http.DrainBody(respBody)
+	err = gob.NewDecoder(respBody).Decode(&info)
+	return info, err
+}
+
+// DriveSpeedtest - runs the drive speedtest on the drives of the peer.
+func (client *peerRESTClient) DriveSpeedtest(ctx context.Context, size, blockSize int64) (info madmin.ServerDrivesSpeedtest, err error) {
+	values := make(url.Values)
+	values.Set(peerRESTSize, strconv.FormatInt(size, 10))
+	values.Set(peerRESTBlockSize, strconv.FormatInt(blockSize, 10))
+	respBody, err := client.callWithContext(ctx, peerRESTMethodDriveSpeedtest, values, nil, -1)
+	if err != nil {
+		return
+	}
+	defer http.DrainBody(respBody)
+	err = gob.NewDecoder(respBody).Decode(&info)
+	return info, err
+}
+
+// BackgroundHealAction - pauses or resumes background healing on the peer.
+func (client *peerRESTClient) BackgroundHealAction(action madmin.BgHealAction) error {
+	values := make(url.Values)
+	values.Set(peerRESTHealAction, string(action))
+	respBody, err := client.call(peerRESTMethodBackgroundHealAction, values, nil, -1)
+	if err != nil {
+		return err
+	}
+	defer http.DrainBody(respBody)
+	return nil
+}
+
+// MaintenanceStatus - returns the maintenance status of the peer.
+func (client *peerRESTClient) MaintenanceStatus() (madmin.ServerMaintenanceStatus, error) {
+	respBody, err := client.call(peerRESTMethodMaintenanceStatus, nil, nil, -1)
+	if err != nil {
+		return madmin.ServerMaintenanceStatus{}, err
+	}
+	defer http.DrainBody(respBody)
+
+	status := madmin.ServerMaintenanceStatus{}
+	err = gob.NewDecoder(respBody).Decode(&status)
+	return status, err
+}
+
 func (client *peerRESTClient) BackgroundHealStatus() (madmin.BgHealState, error) {
 	respBody, err := client.call(peerRESTMethodBackgroundHealStatus, nil, nil, -1)
 	if err != nil {
@@ -633,6 +726,45 @@ func (client *peerRESTClient) BackgroundHealStatus() (madmin.BgHealState, error)
 	return state, err
 }
 
+// BackgroundHealReport - returns the dry-run background heal report of the peer.
+func (client *peerRESTClient) BackgroundHealReport() (madmin.HealReport, error) {
+	respBody, err := client.call(peerRESTMethodBackgroundHealReport, nil, nil, -1)
+	if err != nil {
+		return madmin.HealReport{}, err
+	}
+	defer http.DrainBody(respBody)
+
+	report := madmin.HealReport{}
+	err = gob.NewDecoder(respBody).Decode(&report)
+	return report, err
+}
+
+// BackgroundHealQueue - returns the items queued for background healing on the peer.
+func (client *peerRESTClient) BackgroundHealQueue() ([]madmin.HealQueueItem, error) {
+	respBody, err := client.call(peerRESTMethodBackgroundHealQueue, nil, nil, -1)
+	if err != nil {
+		return nil, err
+	}
+	defer http.DrainBody(respBody)
+
+	var items []madmin.HealQueueItem
+	err = gob.NewDecoder(respBody).Decode(&items)
+	return items, err
+}
+
+// BackgroundHealDeadLetters - returns the dead-letter list of background healing on the peer.
+func (client *peerRESTClient) BackgroundHealDeadLetters() ([]madmin.HealDeadLetterItem, error) {
+	respBody, err := client.call(peerRESTMethodHealDeadLetters, nil, nil, -1)
+	if err != nil {
+		return nil, err
+	}
+	defer http.DrainBody(respBody)
+
+	var items []madmin.HealDeadLetterItem
+	err = gob.NewDecoder(respBody).Decode(&items)
+	return items, err
+}
+
 // GetLocalDiskIDs - get a peer's local disks' IDs.
 func (client *peerRESTClient) GetLocalDiskIDs(ctx context.Context) (diskIDs []string) {
 	respBody, err := client.callWithContext(ctx, peerRESTMethodGetLocalDiskIDs, nil, nil, -1)
@@ -682,10 +814,9 @@ func (client *peerRESTClient) UpdateMetacacheListing(ctx context.Context, m meta
 
 }
 
-func (client *peerRESTClient) doTrace(traceCh chan interface{}, doneCh <-chan struct{}, trcAll, trcErr bool) {
+func (client *peerRESTClient) doTrace(traceCh chan interface{}, doneCh <-chan struct{}, traceOpts traceOpts) {
 	values := make(url.Values)
-	values.Set(peerRESTTraceAll, strconv.FormatBool(trcAll))
-	values.Set(peerRESTTraceErr, strconv.FormatBool(trcErr))
+	traceOpts.encode(values)
 
 	// To cancel the REST request in case doneCh gets closed.
 	ctx, cancel := context.WithCancel(GlobalContext)
@@ -779,10 +910,10 @@ func (client *peerRESTClient) Listen(listenCh chan interface{}, doneCh <-chan st
 }
 
 // Trace - send http trace request to peer nodes
-func (client *peerRESTClient) Trace(traceCh chan interface{}, doneCh <-chan struct{}, trcAll, trcErr bool) {
+func (client *peerRESTClient) Trace(traceCh chan interface{}, doneCh <-chan struct{}, traceOpts traceOpts) {
 	go func() {
 		for {
-			client.doTrace(traceCh, doneCh, trcAll, trcErr)
+			client.doTrace(traceCh, doneCh, traceOpts)
 			select {
 			case <-doneCh:
 				return
@@ -17,7 +17,7 @@
 package cmd
 
 const (
-	peerRESTVersion       = "v12"
+	peerRESTVersion       = "v22"
 	peerRESTVersionPrefix = SlashSeparator + peerRESTVersion
 	peerRESTPrefix        = minioReservedBucketPath + "/peer"
 	peerRESTPath          = peerRESTPrefix + peerRESTVersionPrefix
@@ -39,6 +39,10 @@ const (
 	peerRESTMethodServerUpdate           = "/serverupdate"
 	peerRESTMethodSignalService          = "/signalservice"
 	peerRESTMethodBackgroundHealStatus   = "/backgroundhealstatus"
+	peerRESTMethodBackgroundHealAction   = "/backgroundhealaction"
+	peerRESTMethodBackgroundHealReport   = "/backgroundhealreport"
+	peerRESTMethodBackgroundHealQueue    = "/backgroundhealqueue"
+	peerRESTMethodHealDeadLetters        = "/backgroundhealdeadletters"
 	peerRESTMethodGetLocks               = "/getlocks"
 	peerRESTMethodLoadUser               = "/loaduser"
 	peerRESTMethodLoadServiceAccount     = "/loadserviceaccount"
@@ -59,21 +63,35 @@ const (
 	peerRESTMethodGetMetacacheListing    = "/getmetacache"
 	peerRESTMethodUpdateMetacacheListing = "/updatemetacache"
 	peerRESTMethodGetPeerMetrics         = "/peermetrics"
+	peerRESTMethodMaintenance            = "/maintenance"
+	peerRESTMethodMaintenanceStatus      = "/maintenancestatus"
+	peerRESTMethodStopRebalance          = "/stoprebalance"
+	peerRESTMethodStopKeyRotation        = "/stopkeyrotation"
+	peerRESTMethodLoadTierConfig         = "/loadtierconfig"
+	peerRESTMethodDrivesHealth           = "/driveshealth"
+	peerRESTMethodDriveSpeedtest         = "/drivespeedtest"
 )
 
 const (
-	peerRESTBucket      = "bucket"
-	peerRESTBuckets     = "buckets"
-	peerRESTUser        = "user"
-	peerRESTGroup       = "group"
-	peerRESTUserTemp    = "user-temp"
-	peerRESTPolicy      = "policy"
-	peerRESTUserOrGroup = "user-or-group"
-	peerRESTIsGroup     = "is-group"
-	peerRESTSignal      = "signal"
-	peerRESTProfiler    = "profiler"
-	peerRESTTraceAll    = "all"
-	peerRESTTraceErr    = "err"
+	peerRESTBucket       = "bucket"
+	peerRESTBuckets      = "buckets"
+	peerRESTUser         = "user"
+	peerRESTGroup        = "group"
+	peerRESTUserTemp     = "user-temp"
+	peerRESTPolicy       = "policy"
+	peerRESTUserOrGroup  = "user-or-group"
+	peerRESTIsGroup      = "is-group"
+	peerRESTSignal       = "signal"
+	peerRESTProfiler     = "profiler"
+	peerRESTTraceS3      = "s3"
+	peerRESTTraceAll     = "all"
+	peerRESTTraceStorage = "storage"
+	peerRESTTraceOS      = "os"
+	peerRESTTraceErr     = "err"
+	peerRESTMaintenance  = "maintenance"
+	peerRESTHealAction   = "heal-action"
+	peerRESTSize         = "size"
+	peerRESTBlockSize    = "blocksize"
 
 	peerRESTListenBucket = "bucket"
 	peerRESTListenPrefix = "prefix"
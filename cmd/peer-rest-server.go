@@ -48,7 +48,7 @@ func (s *peerRESTServer) GetLocksHandler(w http.ResponseWriter, r *http.Request)
 	}
 
 	ctx := newContext(r, w, "GetLocks")
-	logger.LogIf(ctx, gob.NewEncoder(w).Encode(globalLockServer.DupLockMap()))
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(localLockEntries()))
 
 	w.(http.Flusher).Flush()
 
@@ -905,8 +905,7 @@ func (s *peerRESTServer) TraceHandler(w http.ResponseWriter, r *http.Request) {
 		s.writeErrorResponse(w, errors.New("Invalid request"))
 		return
 	}
-	trcAll := r.URL.Query().Get(peerRESTTraceAll) == "true"
-	trcErr := r.URL.Query().Get(peerRESTTraceErr) == "true"
+	traceOpts := getTraceOpts(r.URL.Query())
 
 	w.WriteHeader(http.StatusOK)
 	w.(http.Flusher).Flush()
@@ -918,9 +917,7 @@ func (s *peerRESTServer) TraceHandler(w http.ResponseWriter, r *http.Request) {
 	// Use buffered channel to take care of burst sends or slow w.Write()
 	ch := make(chan interface{}, 2000)
 
-	globalHTTPTrace.Subscribe(ch, doneCh, func(entry interface{}) bool {
-		return mustTrace(entry, trcAll, trcErr)
-	})
+	traceOpts.subscribe(ch, doneCh)
 
 	keepAliveTicker := time.NewTicker(500 * time.Millisecond)
 	defer keepAliveTicker.Stop()
@@ -960,6 +957,196 @@ func (s *peerRESTServer) BackgroundHealStatusHandler(w http.ResponseWriter, r *h
 	logger.LogIf(ctx, gob.NewEncoder(w).Encode(state))
 }
 
+// BackgroundHealReportHandler - returns the dry-run background heal report of this server.
+func (s *peerRESTServer) BackgroundHealReportHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "BackgroundHealReport")
+
+	report, ok := getLocalBackgroundHealReport()
+	if !ok {
+		s.writeErrorResponse(w, errServerNotInitialized)
+		return
+	}
+
+	defer w.(http.Flusher).Flush()
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(report))
+}
+
+// BackgroundHealQueueHandler - returns the items queued for background healing on this server.
+func (s *peerRESTServer) BackgroundHealQueueHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "BackgroundHealQueue")
+
+	items, ok := getLocalBackgroundHealQueue()
+	if !ok {
+		s.writeErrorResponse(w, errServerNotInitialized)
+		return
+	}
+
+	defer w.(http.Flusher).Flush()
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(items))
+}
+
+// BackgroundHealDeadLettersHandler - returns the dead-letter list of background healing on this server.
+func (s *peerRESTServer) BackgroundHealDeadLettersHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "BackgroundHealDeadLetters")
+
+	items, ok := getLocalBackgroundHealDeadLetters()
+	if !ok {
+		s.writeErrorResponse(w, errServerNotInitialized)
+		return
+	}
+
+	defer w.(http.Flusher).Flush()
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(items))
+}
+
+// BackgroundHealActionHandler - pauses or resumes background healing on this server.
+func (s *peerRESTServer) BackgroundHealActionHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	if err := backgroundHealAction(madmin.BgHealAction(mux.Vars(r)[peerRESTHealAction])); err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+
+	w.(http.Flusher).Flush()
+}
+
+// MaintenanceHandler - enables or disables maintenance mode on this server.
+func (s *peerRESTServer) MaintenanceHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	switch madmin.MaintenanceAction(mux.Vars(r)[peerRESTMaintenance]) {
+	case madmin.MaintenanceActionStart:
+		globalMaintenance.enable()
+	case madmin.MaintenanceActionStop:
+		globalMaintenance.disable()
+	default:
+		s.writeErrorResponse(w, errors.New("unsupported maintenance action"))
+		return
+	}
+
+	w.(http.Flusher).Flush()
+}
+
+// MaintenanceStatusHandler - returns the maintenance status of this server.
+func (s *peerRESTServer) MaintenanceStatusHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "MaintenanceStatus")
+
+	defer w.(http.Flusher).Flush()
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(globalMaintenance.status()))
+}
+
+// StopRebalanceHandler - stops the rebalance of the pools running on this server.
+func (s *peerRESTServer) StopRebalanceHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	if z, ok := newObjectLayerFn().(*erasureServerPools); ok {
+		z.stopRebalance()
+	}
+
+	w.(http.Flusher).Flush()
+}
+
+// StopKeyRotationHandler - stops the key rotation of encrypted objects
+// running on this server.
+func (s *peerRESTServer) StopKeyRotationHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	if z, ok := newObjectLayerFn().(*erasureServerPools); ok {
+		z.stopKeyRotation()
+	}
+
+	w.(http.Flusher).Flush()
+}
+
+// LoadTierConfigHandler - reloads the remote tiers on this server.
+func (s *peerRESTServer) LoadTierConfigHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	objAPI := newObjectLayerFn()
+	if objAPI == nil || globalTierConfigMgr == nil {
+		s.writeErrorResponse(w, errServerNotInitialized)
+		return
+	}
+
+	if err := globalTierConfigMgr.Init(r.Context(), objAPI); err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+
+	w.(http.Flusher).Flush()
+}
+
+// DrivesHealthHandler - returns the health of the drives of this server.
+func (s *peerRESTServer) DrivesHealthHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	ctx := newContext(r, w, "DrivesHealth")
+
+	defer w.(http.Flusher).Flush()
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(getLocalDrivesHealth()))
+}
+
+// DriveSpeedtestHandler - runs the drive speedtest on the local drives.
+func (s *peerRESTServer) DriveSpeedtestHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.writeErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+
+	size, blockSize, err := parseSpeedtestSizes(r.URL.Query().Get(peerRESTSize), r.URL.Query().Get(peerRESTBlockSize))
+	if err != nil {
+		s.writeErrorResponse(w, err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(newContext(r, w, "DriveSpeedtest"))
+	defer cancel()
+
+	info := getLocalDrivesSpeedtest(ctx, size, blockSize)
+
+	defer w.(http.Flusher).Flush()
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(info))
+}
+
 // ConsoleLogHandler sends console logs of this node back to peer rest client
 func (s *peerRESTServer) ConsoleLogHandler(w http.ResponseWriter, r *http.Request) {
 	if !s.IsValid(w, r) {
@@ -1086,6 +1273,17 @@ func registerPeerRESTHandlers(router *mux.Router) {
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodTrace).HandlerFunc(server.TraceHandler)
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodListen).HandlerFunc(httpTraceHdrs(server.ListenHandler))
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodBackgroundHealStatus).HandlerFunc(server.BackgroundHealStatusHandler)
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodBackgroundHealAction).HandlerFunc(httpTraceHdrs(server.BackgroundHealActionHandler)).Queries(restQueries(peerRESTHealAction)...)
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodBackgroundHealReport).HandlerFunc(server.BackgroundHealReportHandler)
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodBackgroundHealQueue).HandlerFunc(server.BackgroundHealQueueHandler)
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodHealDeadLetters).HandlerFunc(server.BackgroundHealDeadLettersHandler)
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodMaintenance).HandlerFunc(httpTraceHdrs(server.MaintenanceHandler)).Queries(restQueries(peerRESTMaintenance)...)
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodMaintenanceStatus).HandlerFunc(server.MaintenanceStatusHandler)
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodStopRebalance).HandlerFunc(httpTraceHdrs(server.StopRebalanceHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodStopKeyRotation).HandlerFunc(httpTraceHdrs(server.StopKeyRotationHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodLoadTierConfig).HandlerFunc(httpTraceHdrs(server.LoadTierConfigHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodDrivesHealth).HandlerFunc(httpTraceHdrs(server.DrivesHealthHandler))
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodDriveSpeedtest).HandlerFunc(httpTraceHdrs(server.DriveSpeedtestHandler)).Queries(restQueries(peerRESTSize, peerRESTBlockSize)...)
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodLog).HandlerFunc(server.ConsoleLogHandler)
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetLocalDiskIDs).HandlerFunc(httpTraceHdrs(server.GetLocalDiskIDs))
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetBandwidth).HandlerFunc(httpTraceHdrs(server.GetBandwidth))
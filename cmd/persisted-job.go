@@ -0,0 +1,168 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/minio/minio/cmd/logger"
+)
+
+const (
+	// Interval between saving the progress of a persisted job, a job
+	// which was not saved for persistedJobStaleAfter has stopped along
+	// with its server.
+	persistedJobSaveInterval = time.Minute
+	persistedJobStaleAfter   = 5 * time.Minute
+)
+
+// persistedJobStartedError returns the error of starting a persisted
+// job while another one is in progress.
+func persistedJobStartedError(code, job string) AdminError {
+	return AdminError{
+		Code:       "XMinio" + code + "AlreadyStarted",
+		Message:    "A " + job + " is already in progress",
+		StatusCode: http.StatusConflict,
+	}
+}
+
+// persistedJobNotStartedError returns the error of reading or stopping
+// a persisted job which was never started.
+func persistedJobNotStartedError(code, job string) AdminError {
+	return AdminError{
+		Code:       "XMinio" + code + "NotStarted",
+		Message:    "No " + job + " is in progress",
+		StatusCode: http.StatusNotFound,
+	}
+}
+
+// persistedJobMeta holds the state shared by all persisted jobs, a
+// persisted job is a background job whose state is saved to the
+// backend so that its progress can be read and stopped from any
+// server.
+type persistedJobMeta struct {
+	ID         string    `json:"id"`
+	StartedAt  time.Time `json:"startedAt"`
+	StoppedAt  time.Time `json:"stoppedAt,omitempty"`
+	LastUpdate time.Time `json:"lastUpdate"`
+}
+
+func newPersistedJobMeta() persistedJobMeta {
+	now := UTCNow()
+	return persistedJobMeta{
+		ID:         mustGetUUID(),
+		StartedAt:  now,
+		LastUpdate: now,
+	}
+}
+
+// stale returns true if the job was not saved for
+// persistedJobStaleAfter, its server went away.
+func (m persistedJobMeta) stale(now time.Time) bool {
+	return now.Sub(m.LastUpdate) > persistedJobStaleAfter
+}
+
+func (m *persistedJobMeta) setLastUpdate(now time.Time) {
+	m.LastUpdate = now
+}
+
+// persistedJobState is the saved state of a persisted job, which
+// embeds persistedJobMeta.
+type persistedJobState interface {
+	setLastUpdate(now time.Time)
+}
+
+func loadPersistedJobMeta(ctx context.Context, objAPI ObjectLayer, configFile string, meta persistedJobState) error {
+	data, err := readConfig(ctx, objAPI, configFile)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, meta)
+}
+
+func savePersistedJobMeta(ctx context.Context, objAPI ObjectLayer, configFile string, meta persistedJobState) error {
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	return saveConfig(ctx, objAPI, configFile, data)
+}
+
+// startPersistedJob calls start, which loads the saved state of a
+// persisted job, checks that it is not running and saves the state of
+// the new job, under a cluster-wide lock so that no two servers start
+// the same job.
+func startPersistedJob(ctx context.Context, objAPI ObjectLayer, configFile string, start func() error) error {
+	// Saving configFile takes the lock of configFile itself.
+	lk := objAPI.NewNSLock(minioMetaBucket, configFile+".lock")
+	if err := lk.GetLock(ctx, globalOperationTimeout); err != nil {
+		return err
+	}
+	defer lk.Unlock()
+	return start()
+}
+
+// persistedJob runs a persisted job on this server.
+type persistedJob struct {
+	objAPI     ObjectLayer
+	configFile string
+
+	// status returns a copy of the state of the job, false once
+	// the job is forgotten.
+	status func() (persistedJobState, bool)
+}
+
+// saveProgress saves the progress of the job.
+func (j persistedJob) saveProgress(ctx context.Context) {
+	meta, ok := j.status()
+	if !ok {
+		return
+	}
+	meta.setLastUpdate(UTCNow())
+	logger.LogIf(ctx, savePersistedJobMeta(ctx, j.objAPI, j.configFile, meta))
+}
+
+// run runs the job in the background and saves its progress every
+// persistedJobSaveInterval. Once the job returns, stopped records its
+// outcome, the final progress is saved and done forgets the job.
+func (j persistedJob) run(ctx context.Context, job func(context.Context) error, stopped func(error), done func()) {
+	go func() {
+		doneCh := make(chan struct{})
+		go func() {
+			defer close(doneCh)
+			stopped(job(ctx))
+		}()
+
+		ticker := time.NewTicker(persistedJobSaveInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				j.saveProgress(ctx)
+			case <-doneCh:
+				// Save with a fresh context, ctx is canceled when
+				// the job is stopped or the server shuts down.
+				j.saveProgress(context.Background())
+				done()
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,157 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testJobMeta struct {
+	persistedJobMeta
+	Status string `json:"status"`
+}
+
+func TestPersistedJobMetaStale(t *testing.T) {
+	now := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	meta := persistedJobMeta{LastUpdate: now}
+	if meta.stale(now.Add(persistedJobStaleAfter)) {
+		t.Errorf("expected job saved %s ago not to be stale", persistedJobStaleAfter)
+	}
+	if !meta.stale(now.Add(2 * persistedJobStaleAfter)) {
+		t.Errorf("expected job saved %s ago to be stale", 2*persistedJobStaleAfter)
+	}
+}
+
+// Tests that the final state of a stopped job is saved before the job
+// is forgotten.
+func TestPersistedJobRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	var mu sync.Mutex
+	meta := &testJobMeta{persistedJobMeta: newPersistedJobMeta(), Status: "Started"}
+	job := persistedJob{
+		objAPI:     obj,
+		configFile: "test-job.json",
+		status: func() (persistedJobState, bool) {
+			mu.Lock()
+			defer mu.Unlock()
+			if meta == nil {
+				return &testJobMeta{}, false
+			}
+			m := *meta
+			return &m, true
+		},
+	}
+
+	jctx, jcancel := context.WithCancel(ctx)
+	doneCh := make(chan struct{})
+	job.run(jctx, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}, func(err error) {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected %v, got %v", context.Canceled, err)
+		}
+		mu.Lock()
+		meta.Status = "Stopped"
+		meta.StoppedAt = UTCNow()
+		mu.Unlock()
+	}, func() {
+		mu.Lock()
+		meta = nil
+		mu.Unlock()
+		close(doneCh)
+	})
+	jcancel()
+
+	select {
+	case <-doneCh:
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected job to be done once stopped")
+	}
+
+	var saved testJobMeta
+	if err = loadPersistedJobMeta(ctx, obj, "test-job.json", &saved); err != nil {
+		t.Fatal(err)
+	}
+	if saved.Status != "Stopped" || saved.StoppedAt.IsZero() || saved.ID == "" {
+		t.Fatalf("expected stopped job to be saved, got %#v", saved)
+	}
+}
+
+// Tests that concurrent starts of the same job start it once.
+func TestStartPersistedJob(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	errStarted := errors.New("already started")
+	start := func() error {
+		var meta testJobMeta
+		err := loadPersistedJobMeta(ctx, obj, "test-job.json", &meta)
+		if err != nil && !errors.Is(err, errConfigNotFound) {
+			return err
+		}
+		if err == nil {
+			return errStarted
+		}
+		meta = testJobMeta{persistedJobMeta: newPersistedJobMeta(), Status: "Started"}
+		return savePersistedJobMeta(ctx, obj, "test-job.json", &meta)
+	}
+
+	errs := make([]error, 4)
+	var wg sync.WaitGroup
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = startPersistedJob(ctx, obj, "test-job.json", start)
+		}(i)
+	}
+	wg.Wait()
+
+	var started int
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			started++
+		case !errors.Is(err, errStarted):
+			t.Fatal(err)
+		}
+	}
+	if started != 1 {
+		t.Fatalf("expected the job to be started once, got %d", started)
+	}
+}
@@ -235,6 +235,9 @@ func newAllSubsystems() {
 
 	// Create new bucket replication subsytem
 	globalBucketTargetSys = NewBucketTargetSys()
+
+	// Create new remote tiers subsystem
+	globalTierConfigMgr = newTierConfigMgr()
 }
 
 func initServer(ctx context.Context, newObject ObjectLayer) error {
@@ -397,6 +400,9 @@ func initAllSubsystems(ctx context.Context, newObject ObjectLayer) (err error) {
 	// Initialize bucket targets sub-system.
 	globalBucketTargetSys.Init(ctx, buckets, newObject)
 
+	// Initialize remote tiers sub-system.
+	logger.LogIf(ctx, globalTierConfigMgr.Init(ctx, newObject))
+
 	return nil
 }
 
@@ -509,6 +515,7 @@ func serverMain(ctx *cli.Context) {
 		initAutoHeal(GlobalContext, newObject)
 		initBackgroundTransition(GlobalContext, newObject)
 		initBackgroundExpiry(GlobalContext, newObject)
+		initDriveHealthMonitor(GlobalContext, newObject)
 	}
 
 	initDataScanner(GlobalContext, newObject)
@@ -0,0 +1,180 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/minio/minio/pkg/disk"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+const (
+	// Bytes written to and read back from each drive by default.
+	speedtestDefaultSize = 256 * humanize.MiByte
+
+	// Size of each write and read by default.
+	speedtestDefaultBlockSize = 4 * humanize.MiByte
+
+	// Upper bound of the bytes written to each drive.
+	speedtestMaxSize = 4 * humanize.GiByte
+
+	// Direct IO requires the block size to be a multiple of this.
+	speedtestBlockAlign = 4 * humanize.KiByte
+)
+
+// parseSpeedtestSizes returns the size and block size of a drive
+// speedtest from their string values, empty values use the defaults.
+func parseSpeedtestSizes(sizeStr, blockSizeStr string) (size, blockSize int64, err error) {
+	size, blockSize = speedtestDefaultSize, speedtestDefaultBlockSize
+	if sizeStr != "" {
+		if size, err = strconv.ParseInt(sizeStr, 10, 64); err != nil {
+			return 0, 0, err
+		}
+	}
+	if blockSizeStr != "" {
+		if blockSize, err = strconv.ParseInt(blockSizeStr, 10, 64); err != nil {
+			return 0, 0, err
+		}
+	}
+	switch {
+	case blockSize <= 0 || blockSize%speedtestBlockAlign != 0:
+		return 0, 0, fmt.Errorf("block size must be a positive multiple of %d", speedtestBlockAlign)
+	case size < blockSize:
+		return 0, 0, errors.New("size must be at least the block size")
+	case size > speedtestMaxSize:
+		return 0, 0, fmt.Errorf("size must be at most %d", speedtestMaxSize)
+	}
+	return size, blockSize, nil
+}
+
+// speedtestThroughput returns n bytes over d in bytes per second.
+func speedtestThroughput(n int64, d time.Duration) uint64 {
+	if d <= 0 {
+		return 0
+	}
+	return uint64(float64(n) / d.Seconds())
+}
+
+// driveSpeedtest writes size bytes in blockSize writes to a temporary
+// file on the drive and reads them back, both with direct IO so that
+// the page cache does not affect the result.
+func driveSpeedtest(ctx context.Context, drivePath string, size, blockSize int64) (result madmin.DriveSpeedtestResult) {
+	result.Path = drivePath
+	if _, err := os.Stat(drivePath); err != nil {
+		result.Error = fmt.Sprintf("stat: %v", err)
+		return result
+	}
+
+	filePath := pathJoin(drivePath, minioMetaTmpBucket, "speedtest-"+mustGetUUID())
+	defer os.Remove(filePath)
+
+	blocks := size / blockSize
+	buf := disk.AlignedBlock(int(blockSize))
+
+	w, err := OpenFileDirectIO(filePath, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0666)
+	if err != nil {
+		result.Error = fmt.Sprintf("write: %v", err)
+		return result
+	}
+	start := time.Now()
+	for i := int64(0); i < blocks; i++ {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		if _, err = w.Write(buf); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = disk.Fdatasync(w)
+	}
+	elapsed := time.Since(start)
+	w.Close()
+	if err != nil {
+		result.Error = fmt.Sprintf("write: %v", err)
+		return result
+	}
+	result.WriteThroughput = speedtestThroughput(blocks*blockSize, elapsed)
+
+	r, err := OpenFileDirectIO(filePath, os.O_RDONLY, 0666)
+	if err != nil {
+		result.Error = fmt.Sprintf("read: %v", err)
+		return result
+	}
+	defer r.Close()
+	start = time.Now()
+	for i := int64(0); i < blocks; i++ {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		if _, err = io.ReadFull(r, buf); err != nil {
+			break
+		}
+	}
+	if err != nil {
+		result.Error = fmt.Sprintf("read: %v", err)
+		return result
+	}
+	result.ReadThroughput = speedtestThroughput(blocks*blockSize, time.Since(start))
+	return result
+}
+
+// getLocalDrivesSpeedtest runs the drive speedtest on the local drives,
+// first one drive at a time and then on all drives in parallel.
+func getLocalDrivesSpeedtest(ctx context.Context, size, blockSize int64) madmin.ServerDrivesSpeedtest {
+	var drivePaths []string
+	for _, ep := range globalEndpoints {
+		for _, endpoint := range ep.Endpoints {
+			if endpoint.IsLocal {
+				drivePaths = append(drivePaths, endpoint.Path)
+			}
+		}
+	}
+
+	info := madmin.ServerDrivesSpeedtest{
+		Addr:     GetLocalPeer(globalEndpoints),
+		Serial:   make([]madmin.DriveSpeedtestResult, len(drivePaths)),
+		Parallel: make([]madmin.DriveSpeedtestResult, len(drivePaths)),
+	}
+	for i, drivePath := range drivePaths {
+		info.Serial[i] = driveSpeedtest(ctx, drivePath, size, blockSize)
+	}
+
+	var wg sync.WaitGroup
+	for i, drivePath := range drivePaths {
+		wg.Add(1)
+		go func(i int, drivePath string) {
+			defer wg.Done()
+			info.Parallel[i] = driveSpeedtest(ctx, drivePath, size, blockSize)
+		}(i, drivePath)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		info.Error = err.Error()
+	}
+	return info
+}
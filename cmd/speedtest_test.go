@@ -0,0 +1,98 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dustin/go-humanize"
+)
+
+func TestParseSpeedtestSizes(t *testing.T) {
+	testCases := []struct {
+		size, blockSize       string
+		expSize, expBlockSize int64
+		shouldFail            bool
+	}{
+		{"", "", speedtestDefaultSize, speedtestDefaultBlockSize, false},
+		{"1048576", "65536", humanize.MiByte, 64 * humanize.KiByte, false},
+		{"65536", "1048576", 0, 0, true},
+		{"1048576", "1000", 0, 0, true},
+		{"1048576", "0", 0, 0, true},
+		{"abc", "", 0, 0, true},
+		{"10737418240", "", 0, 0, true},
+	}
+
+	for i, testCase := range testCases {
+		size, blockSize, err := parseSpeedtestSizes(testCase.size, testCase.blockSize)
+		if testCase.shouldFail {
+			if err == nil {
+				t.Errorf("case %d: expected to fail", i+1)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: %v", i+1, err)
+		}
+		if size != testCase.expSize || blockSize != testCase.expBlockSize {
+			t.Errorf("case %d: expected %d/%d, got %d/%d", i+1, testCase.expSize, testCase.expBlockSize, size, blockSize)
+		}
+	}
+}
+
+func TestDriveSpeedtest(t *testing.T) {
+	drivePath, err := ioutil.TempDir("", "minio-speedtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(drivePath)
+
+	if err = os.MkdirAll(filepath.Join(drivePath, minioMetaTmpBucket), 0777); err != nil {
+		t.Fatal(err)
+	}
+	f, err := OpenFileDirectIO(filepath.Join(drivePath, "direct"), os.O_CREATE|os.O_WRONLY, 0666)
+	if err != nil {
+		t.Skipf("direct IO not supported: %v", err)
+	}
+	f.Close()
+
+	result := driveSpeedtest(context.Background(), drivePath, humanize.MiByte, 64*humanize.KiByte)
+	if result.Error != "" {
+		t.Fatal(result.Error)
+	}
+	if result.ReadThroughput == 0 || result.WriteThroughput == 0 {
+		t.Fatalf("expected throughput to be measured, got %+v", result)
+	}
+
+	// The test file must be removed.
+	entries, err := ioutil.ReadDir(filepath.Join(drivePath, minioMetaTmpBucket))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("expected no leftover files, got %d", len(entries))
+	}
+
+	result = driveSpeedtest(context.Background(), filepath.Join(drivePath, "missing"), humanize.MiByte, 64*humanize.KiByte)
+	if result.Error == "" {
+		t.Fatal("expected missing drive to fail")
+	}
+}
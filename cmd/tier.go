@@ -0,0 +1,234 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"path"
+	"sort"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	miniogo "github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio/cmd/config/storageclass"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+const tierConfigFile = "tier-config.json"
+
+var (
+	errTierAlreadyExists = AdminError{
+		Code:       "XMinioAdminTierAlreadyExists",
+		Message:    "A remote tier with the specified name already exists",
+		StatusCode: http.StatusConflict,
+	}
+	errTierNameInvalid = AdminError{
+		Code:       "XMinioAdminTierNameInvalid",
+		Message:    "Remote tier names must only contain uppercase letters, digits, '-' and '_' and differ from the standard storage classes",
+		StatusCode: http.StatusBadRequest,
+	}
+	errTierConfigInvalid = AdminError{
+		Code:       "XMinioAdminTierConfigInvalid",
+		Message:    "Remote tiers require an endpoint, credentials and a bucket",
+		StatusCode: http.StatusBadRequest,
+	}
+)
+
+// tierConfigMgr holds the remote tiers which lifecycle rules of all
+// buckets can transition objects to, with a client per tier.
+type tierConfigMgr struct {
+	sync.RWMutex
+	tiers   map[string]madmin.TierConfig
+	clients map[string]*miniogo.Client
+}
+
+func newTierConfigMgr() *tierConfigMgr {
+	return &tierConfigMgr{
+		tiers:   make(map[string]madmin.TierConfig),
+		clients: make(map[string]*miniogo.Client),
+	}
+}
+
+// validTierName returns true if the name can be used as the storage
+// class of lifecycle transitions.
+func validTierName(name string) bool {
+	if name == "" || name == storageclass.STANDARD || name == storageclass.RRS {
+		return false
+	}
+	for _, r := range name {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// newTierClient returns a client of the remote bucket of the tier.
+func newTierClient(cfg madmin.TierConfig) (*miniogo.Client, error) {
+	getRemoteTargetInstanceTransportOnce.Do(func() {
+		getRemoteTargetInstanceTransport = newGatewayHTTPTransport(10 * time.Minute)
+	})
+	return miniogo.New(cfg.Endpoint, &miniogo.Options{
+		Creds:     credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
+		Secure:    cfg.Secure,
+		Region:    cfg.Region,
+		Transport: getRemoteTargetInstanceTransport,
+	})
+}
+
+// isTier returns true if a remote tier with the name exists.
+func (m *tierConfigMgr) isTier(name string) bool {
+	m.RLock()
+	defer m.RUnlock()
+	_, ok := m.tiers[name]
+	return ok
+}
+
+// getTier returns the remote tier with the name and its client.
+func (m *tierConfigMgr) getTier(name string) (madmin.TierConfig, *miniogo.Client, bool) {
+	m.RLock()
+	defer m.RUnlock()
+	cfg, ok := m.tiers[name]
+	return cfg, m.clients[name], ok
+}
+
+// listTiers returns the remote tiers sorted by name, without their
+// secret keys.
+func (m *tierConfigMgr) listTiers() []madmin.TierConfig {
+	m.RLock()
+	defer m.RUnlock()
+	tiers := make([]madmin.TierConfig, 0, len(m.tiers))
+	for _, cfg := range m.tiers {
+		cfg.SecretKey = ""
+		tiers = append(tiers, cfg)
+	}
+	sort.Slice(tiers, func(i, j int) bool {
+		return tiers[i].Name < tiers[j].Name
+	})
+	return tiers
+}
+
+// addTier validates the remote bucket of the tier is reachable with
+// the tier credentials, then adds and saves the tier.
+func (m *tierConfigMgr) addTier(ctx context.Context, objAPI ObjectLayer, cfg madmin.TierConfig) error {
+	if !validTierName(cfg.Name) {
+		return errTierNameInvalid
+	}
+	if cfg.Endpoint == "" || cfg.AccessKey == "" || cfg.SecretKey == "" || cfg.Bucket == "" {
+		return errTierConfigInvalid
+	}
+	if m.isTier(cfg.Name) {
+		return errTierAlreadyExists
+	}
+
+	client, err := newTierClient(cfg)
+	if err != nil {
+		return BucketRemoteTargetNotFound{Bucket: cfg.Bucket}
+	}
+	found, err := client.BucketExists(ctx, cfg.Bucket)
+	if err != nil {
+		return BucketRemoteConnectionErr{Bucket: cfg.Bucket}
+	}
+	if !found {
+		return BucketRemoteDestinationNotFound{Bucket: cfg.Bucket}
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.tiers[cfg.Name]; ok {
+		return errTierAlreadyExists
+	}
+	tiers := make(map[string]madmin.TierConfig, len(m.tiers)+1)
+	for name, tier := range m.tiers {
+		tiers[name] = tier
+	}
+	tiers[cfg.Name] = cfg
+	if err = saveTierConfig(ctx, objAPI, tiers); err != nil {
+		return err
+	}
+	m.tiers = tiers
+	m.clients[cfg.Name] = client
+	return nil
+}
+
+// Init loads the remote tiers saved in the cluster.
+func (m *tierConfigMgr) Init(ctx context.Context, objAPI ObjectLayer) error {
+	tiers, err := loadTierConfig(ctx, objAPI)
+	if err != nil {
+		if errors.Is(err, errConfigNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	clients := make(map[string]*miniogo.Client, len(tiers))
+	for name, cfg := range tiers {
+		client, err := newTierClient(cfg)
+		if err != nil {
+			return err
+		}
+		clients[name] = client
+	}
+
+	m.Lock()
+	m.tiers, m.clients = tiers, clients
+	m.Unlock()
+	return nil
+}
+
+func saveTierConfig(ctx context.Context, objAPI ObjectLayer, tiers map[string]madmin.TierConfig) error {
+	data, err := json.Marshal(tiers)
+	if err != nil {
+		return err
+	}
+
+	// The tier credentials are encrypted like the server config.
+	if globalConfigEncrypted {
+		data, err = madmin.EncryptData(globalActiveCred.String(), data)
+		if err != nil {
+			return err
+		}
+	}
+	return saveConfig(ctx, objAPI, path.Join(minioConfigPrefix, tierConfigFile), data)
+}
+
+func loadTierConfig(ctx context.Context, objAPI ObjectLayer) (map[string]madmin.TierConfig, error) {
+	data, err := readConfig(ctx, objAPI, path.Join(minioConfigPrefix, tierConfigFile))
+	if err != nil {
+		return nil, err
+	}
+	if globalConfigEncrypted && !utf8.Valid(data) {
+		data, err = madmin.DecryptData(globalActiveCred.String(), bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tiers := make(map[string]madmin.TierConfig)
+	if err = json.Unmarshal(data, &tiers); err != nil {
+		return nil, err
+	}
+	return tiers, nil
+}
@@ -0,0 +1,209 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio/pkg/bucket/lifecycle"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+func TestValidTierName(t *testing.T) {
+	testCases := []struct {
+		name  string
+		valid bool
+	}{
+		{"WARM", true},
+		{"COLD-TIER_2", true},
+		{"", false},
+		{"STANDARD", false},
+		{"REDUCED_REDUNDANCY", false},
+		{"warm", false},
+		{"WARM TIER", false},
+	}
+	for _, testCase := range testCases {
+		if valid := validTierName(testCase.name); valid != testCase.valid {
+			t.Errorf("%q: expected valid %v, got %v", testCase.name, testCase.valid, valid)
+		}
+	}
+}
+
+func TestTransitionTargetRemoteObject(t *testing.T) {
+	testCases := []struct {
+		target    transitionTarget
+		versionID string
+		object    string
+		remoteVID string
+	}{
+		{transitionTarget{bucket: "remote"}, "v1", "dir/object", "v1"},
+		{transitionTarget{bucket: "remote", tier: "WARM"}, "v1", "bucket/v1/dir/object", ""},
+		{transitionTarget{bucket: "remote", tier: "WARM", prefix: "minio"}, "", "minio/bucket/null/dir/object", ""},
+	}
+	for i, testCase := range testCases {
+		object, versionID := testCase.target.remoteObject("bucket", "dir/object", testCase.versionID)
+		if object != testCase.object || versionID != testCase.remoteVID {
+			t.Errorf("Test %d: expected %s (%s), got %s (%s)", i+1, testCase.object, testCase.remoteVID, object, versionID)
+		}
+	}
+}
+
+func TestTierConfigMgr(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	mgr := newTierConfigMgr()
+	invalid := []madmin.TierConfig{
+		{Name: "warm", Endpoint: "localhost:9000", AccessKey: "access", SecretKey: "secret", Bucket: "remote"},
+		{Name: "WARM", Endpoint: "localhost:9000", AccessKey: "access", Bucket: "remote"},
+		{Name: "WARM", Endpoint: "localhost:9000", AccessKey: "access", SecretKey: "secret"},
+	}
+	for i, cfg := range invalid {
+		if err = mgr.addTier(ctx, obj, cfg); err == nil {
+			t.Errorf("Test %d: expected tier %#v to be rejected", i+1, cfg)
+		}
+	}
+
+	// No tiers saved yet.
+	if err = mgr.Init(ctx, obj); err != nil {
+		t.Fatal(err)
+	}
+	if tiers := mgr.listTiers(); len(tiers) != 0 {
+		t.Fatalf("expected no tiers, got %#v", tiers)
+	}
+
+	tiers := map[string]madmin.TierConfig{
+		"WARM": {Name: "WARM", Endpoint: "localhost:9000", AccessKey: "access", SecretKey: "secret", Bucket: "remote"},
+		"COLD": {Name: "COLD", Endpoint: "localhost:9000", AccessKey: "access", SecretKey: "secret", Bucket: "remote", Prefix: "cold"},
+	}
+	if err = saveTierConfig(ctx, obj, tiers); err != nil {
+		t.Fatal(err)
+	}
+	if err = mgr.Init(ctx, obj); err != nil {
+		t.Fatal(err)
+	}
+	if !mgr.isTier("WARM") || mgr.isTier("HOT") {
+		t.Fatalf("unexpected tiers %#v", mgr.listTiers())
+	}
+	if cfg, client, ok := mgr.getTier("COLD"); !ok || client == nil || cfg.SecretKey != "secret" {
+		t.Fatalf("unexpected tier %#v", cfg)
+	}
+
+	list := mgr.listTiers()
+	if len(list) != 2 || list[0].Name != "COLD" || list[1].Name != "WARM" {
+		t.Fatalf("unexpected tiers %#v", list)
+	}
+	for _, cfg := range list {
+		if cfg.SecretKey != "" {
+			t.Errorf("expected the secret key of tier %s to be stripped", cfg.Name)
+		}
+	}
+
+	if err = mgr.addTier(ctx, obj, tiers["WARM"]); err != errTierAlreadyExists {
+		t.Fatalf("expected %v, got %v", errTierAlreadyExists, err)
+	}
+}
+
+// Tests that reading the latest version of a transitioned object
+// reads the data of that version from the remote tier.
+func TestGetTransitionedObjectReader(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+	setObjectLayer(obj)
+
+	data := []byte("transitioned data")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/remote/bucket/v1/object" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Last-Modified", UTCNow().Format(http.TimeFormat))
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	lc, err := lifecycle.ParseLifecycleConfig(strings.NewReader(`<LifecycleConfiguration><Rule><ID>rule</ID><Status>Enabled</Status><Filter></Filter><Transition><Days>1</Days><StorageClass>WARM</StorageClass></Transition></Rule></LifecycleConfiguration>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := madmin.TierConfig{
+		Name:      "WARM",
+		Endpoint:  strings.TrimPrefix(ts.URL, "http://"),
+		AccessKey: "access",
+		SecretKey: "secret",
+		Bucket:    "remote",
+		Region:    "us-east-1",
+	}
+	client, err := newTierClient(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer func(metadataSys *BucketMetadataSys, targetSys *BucketTargetSys, tierMgr *tierConfigMgr) {
+		globalBucketMetadataSys, globalBucketTargetSys, globalTierConfigMgr = metadataSys, targetSys, tierMgr
+	}(globalBucketMetadataSys, globalBucketTargetSys, globalTierConfigMgr)
+	globalBucketMetadataSys = NewBucketMetadataSys()
+	globalBucketMetadataSys.Set("bucket", BucketMetadata{Name: "bucket", lifecycleConfig: lc})
+	globalBucketTargetSys = NewBucketTargetSys()
+	globalTierConfigMgr = newTierConfigMgr()
+	globalTierConfigMgr.tiers[cfg.Name] = cfg
+	globalTierConfigMgr.clients[cfg.Name] = client
+
+	oi := ObjectInfo{
+		Bucket:    "bucket",
+		Name:      "object",
+		VersionID: "v1",
+		IsLatest:  true,
+		Size:      int64(len(data)),
+	}
+	// No version ID is requested when reading the latest version.
+	gr, err := getTransitionedObjectReader(ctx, "bucket", "object", nil, http.Header{}, oi, ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gr.Close()
+	got, err := ioutil.ReadAll(gr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Fatalf("expected %q, got %q", data, got)
+	}
+}
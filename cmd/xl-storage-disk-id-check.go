@@ -18,13 +18,123 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"io"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/minio/minio/pkg/trace"
+)
+
+const (
+	// Window in which read errors on a disk are accumulated.
+	diskReadErrWindow = 5 * time.Minute
+
+	// Number of read errors within diskReadErrWindow after which
+	// a disk is considered to be degrading, objects failing to be
+	// read from such a disk are queued for a deep heal.
+	diskReadErrThreshold = 5
 )
 
+// diskReadErrTracker accumulates read errors observed on a disk,
+// a rising error rate is a sign of a slowly degrading drive.
+type diskReadErrTracker struct {
+	mu          sync.Mutex
+	windowStart time.Time
+	count       int
+}
+
+// observe records a read error and returns true if the number of read
+// errors within the current window has reached diskReadErrThreshold.
+func (t *diskReadErrTracker) observe(now time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if now.Sub(t.windowStart) > diskReadErrWindow {
+		t.windowStart = now
+		t.count = 0
+	}
+	t.count++
+	return t.count >= diskReadErrThreshold
+}
+
+// isDiskReadErr returns true for errors which indicate that the
+// underlying drive failed to serve the data, as opposed to the data
+// simply not being present.
+func isDiskReadErr(err error) bool {
+	return errors.Is(err, errFaultyDisk) || errors.Is(err, errFileCorrupt)
+}
+
+// diskLatencyTracker holds a moving average of the latency of
+// the reads served by a disk.
+type diskLatencyTracker struct {
+	avg int64 // nanoseconds, accessed atomically
+}
+
+// observe records the latency of a read.
+func (t *diskLatencyTracker) observe(d time.Duration) {
+	for {
+		old := atomic.LoadInt64(&t.avg)
+		avg := old + (int64(d)-old)/8
+		if atomic.CompareAndSwapInt64(&t.avg, old, avg) {
+			return
+		}
+	}
+}
+
+// value returns the moving average of the read latency.
+func (t *diskLatencyTracker) value() time.Duration {
+	return time.Duration(atomic.LoadInt64(&t.avg))
+}
+
 // Detects change in underlying disk.
 type xlStorageDiskIDCheck struct {
+	// latency and ioStats are first to be 64-bit aligned for atomic access.
+	latency diskLatencyTracker
+	ioStats diskIOStats
+
 	storage *xlStorage
 	diskID  string
+
+	readErrs diskReadErrTracker
+}
+
+// trackReadErr records read errors on the disk, once the disk reports
+// read errors above the threshold the affected object is handed over
+// to the background healer for a deep scan.
+func (p *xlStorageDiskIDCheck) trackReadErr(volume, object, versionID string, err error) {
+	if !isDiskReadErr(err) || isMinioMetaBucketName(volume) {
+		return
+	}
+	if p.readErrs.observe(UTCNow()) {
+		healObjectOnDiskErr(volume, object, versionID)
+	}
+}
+
+// partPathToObject returns the object name from a path pointing to
+// an object part, i.e 'object/dataDir/part.N'.
+func partPathToObject(partPath string) string {
+	if !strings.HasPrefix(path.Base(partPath), "part.") {
+		return partPath
+	}
+	return path.Dir(path.Dir(partPath))
+}
+
+// traceStorage returns a function which publishes a storage trace of
+// the call on the paths of the drive when it is called, once the call
+// returns.
+func (p *xlStorageDiskIDCheck) traceStorage(funcName string, paths ...string) func() {
+	if globalStorageTrace.NumSubscribers() == 0 {
+		return func() {}
+	}
+	startTime := time.Now()
+	return func() {
+		tracePath := path.Join(append([]string{p.String()}, paths...)...)
+		globalStorageTrace.Publish(callTrace(trace.Storage, "storage."+funcName, tracePath, startTime))
+	}
 }
 
 func (p *xlStorageDiskIDCheck) String() string {
@@ -111,6 +221,7 @@ func (p *xlStorageDiskIDCheck) MakeVolBulk(ctx context.Context, volumes ...strin
 	if err = p.checkDiskStale(); err != nil {
 		return err
 	}
+	defer p.traceStorage("MakeVolBulk")()
 	return p.storage.MakeVolBulk(ctx, volumes...)
 }
 
@@ -118,6 +229,7 @@ func (p *xlStorageDiskIDCheck) MakeVol(ctx context.Context, volume string) (err
 	if err = p.checkDiskStale(); err != nil {
 		return err
 	}
+	defer p.traceStorage("MakeVol", volume)()
 	return p.storage.MakeVol(ctx, volume)
 }
 
@@ -125,6 +237,7 @@ func (p *xlStorageDiskIDCheck) ListVols(ctx context.Context) ([]VolInfo, error)
 	if err := p.checkDiskStale(); err != nil {
 		return nil, err
 	}
+	defer p.traceStorage("ListVols")()
 	return p.storage.ListVols(ctx)
 }
 
@@ -132,6 +245,7 @@ func (p *xlStorageDiskIDCheck) StatVol(ctx context.Context, volume string) (vol
 	if err = p.checkDiskStale(); err != nil {
 		return vol, err
 	}
+	defer p.traceStorage("StatVol", volume)()
 	return p.storage.StatVol(ctx, volume)
 }
 
@@ -139,6 +253,7 @@ func (p *xlStorageDiskIDCheck) DeleteVol(ctx context.Context, volume string, for
 	if err = p.checkDiskStale(); err != nil {
 		return err
 	}
+	defer p.traceStorage("DeleteVol", volume)()
 	return p.storage.DeleteVol(ctx, volume, forceDelete)
 }
 
@@ -146,6 +261,7 @@ func (p *xlStorageDiskIDCheck) WalkVersions(ctx context.Context, volume, dirPath
 	if err := p.checkDiskStale(); err != nil {
 		return nil, err
 	}
+	defer p.traceStorage("WalkVersions", volume, dirPath)()
 	return p.storage.WalkVersions(ctx, volume, dirPath, marker, recursive, endWalkCh)
 }
 
@@ -153,6 +269,7 @@ func (p *xlStorageDiskIDCheck) ListDir(ctx context.Context, volume, dirPath stri
 	if err := p.checkDiskStale(); err != nil {
 		return nil, err
 	}
+	defer p.traceStorage("ListDir", volume, dirPath)()
 
 	return p.storage.ListDir(ctx, volume, dirPath, count)
 }
@@ -161,23 +278,43 @@ func (p *xlStorageDiskIDCheck) ReadFile(ctx context.Context, volume string, path
 	if err := p.checkDiskStale(); err != nil {
 		return 0, err
 	}
-
-	return p.storage.ReadFile(ctx, volume, path, offset, buf, verifier)
+	defer p.traceStorage("ReadFile", volume, path)()
+
+	start := time.Now()
+	defer func() {
+		p.latency.observe(time.Since(start))
+		p.ioStats.observe(false, time.Since(start), err)
+	}()
+	n, err = p.storage.ReadFile(ctx, volume, path, offset, buf, verifier)
+	if err != nil {
+		p.trackReadErr(volume, partPathToObject(path), "", err)
+	}
+	return n, err
 }
 
 func (p *xlStorageDiskIDCheck) AppendFile(ctx context.Context, volume string, path string, buf []byte) (err error) {
 	if err = p.checkDiskStale(); err != nil {
 		return err
 	}
+	defer p.traceStorage("AppendFile", volume, path)()
 
+	start := time.Now()
+	defer func() {
+		p.ioStats.observe(true, time.Since(start), err)
+	}()
 	return p.storage.AppendFile(ctx, volume, path, buf)
 }
 
-func (p *xlStorageDiskIDCheck) CreateFile(ctx context.Context, volume, path string, size int64, reader io.Reader) error {
-	if err := p.checkDiskStale(); err != nil {
+func (p *xlStorageDiskIDCheck) CreateFile(ctx context.Context, volume, path string, size int64, reader io.Reader) (err error) {
+	if err = p.checkDiskStale(); err != nil {
 		return err
 	}
+	defer p.traceStorage("CreateFile", volume, path)()
 
+	start := time.Now()
+	defer func() {
+		p.ioStats.observe(true, time.Since(start), err)
+	}()
 	return p.storage.CreateFile(ctx, volume, path, size, reader)
 }
 
@@ -185,23 +322,34 @@ func (p *xlStorageDiskIDCheck) ReadFileStream(ctx context.Context, volume, path
 	if err := p.checkDiskStale(); err != nil {
 		return nil, err
 	}
+	defer p.traceStorage("ReadFileStream", volume, path)()
 
-	return p.storage.ReadFileStream(ctx, volume, path, offset, length)
+	rc, err := p.storage.ReadFileStream(ctx, volume, path, offset, length)
+	if err != nil {
+		p.trackReadErr(volume, partPathToObject(path), "", err)
+	}
+	return rc, err
 }
 
 func (p *xlStorageDiskIDCheck) RenameFile(ctx context.Context, srcVolume, srcPath, dstVolume, dstPath string) error {
 	if err := p.checkDiskStale(); err != nil {
 		return err
 	}
+	defer p.traceStorage("RenameFile", srcVolume, srcPath)()
 
 	return p.storage.RenameFile(ctx, srcVolume, srcPath, dstVolume, dstPath)
 }
 
-func (p *xlStorageDiskIDCheck) RenameData(ctx context.Context, srcVolume, srcPath, dataDir, dstVolume, dstPath string) error {
-	if err := p.checkDiskStale(); err != nil {
+func (p *xlStorageDiskIDCheck) RenameData(ctx context.Context, srcVolume, srcPath, dataDir, dstVolume, dstPath string) (err error) {
+	if err = p.checkDiskStale(); err != nil {
 		return err
 	}
+	defer p.traceStorage("RenameData", srcVolume, srcPath)()
 
+	start := time.Now()
+	defer func() {
+		p.ioStats.observe(true, time.Since(start), err)
+	}()
 	return p.storage.RenameData(ctx, srcVolume, srcPath, dataDir, dstVolume, dstPath)
 }
 
@@ -209,6 +357,7 @@ func (p *xlStorageDiskIDCheck) CheckParts(ctx context.Context, volume string, pa
 	if err = p.checkDiskStale(); err != nil {
 		return err
 	}
+	defer p.traceStorage("CheckParts", volume, path)()
 
 	return p.storage.CheckParts(ctx, volume, path, fi)
 }
@@ -217,6 +366,7 @@ func (p *xlStorageDiskIDCheck) CheckFile(ctx context.Context, volume string, pat
 	if err = p.checkDiskStale(); err != nil {
 		return err
 	}
+	defer p.traceStorage("CheckFile", volume, path)()
 
 	return p.storage.CheckFile(ctx, volume, path)
 }
@@ -225,6 +375,7 @@ func (p *xlStorageDiskIDCheck) Delete(ctx context.Context, volume string, path s
 	if err = p.checkDiskStale(); err != nil {
 		return err
 	}
+	defer p.traceStorage("Delete", volume, path)()
 
 	return p.storage.Delete(ctx, volume, path, recursive)
 }
@@ -237,6 +388,7 @@ func (p *xlStorageDiskIDCheck) DeleteVersions(ctx context.Context, volume string
 		}
 		return errs
 	}
+	defer p.traceStorage("DeleteVersions", volume)()
 	return p.storage.DeleteVersions(ctx, volume, versions)
 }
 
@@ -244,6 +396,7 @@ func (p *xlStorageDiskIDCheck) VerifyFile(ctx context.Context, volume, path stri
 	if err := p.checkDiskStale(); err != nil {
 		return err
 	}
+	defer p.traceStorage("VerifyFile", volume, path)()
 
 	return p.storage.VerifyFile(ctx, volume, path, fi)
 }
@@ -252,7 +405,12 @@ func (p *xlStorageDiskIDCheck) WriteAll(ctx context.Context, volume string, path
 	if err = p.checkDiskStale(); err != nil {
 		return err
 	}
+	defer p.traceStorage("WriteAll", volume, path)()
 
+	start := time.Now()
+	defer func() {
+		p.ioStats.observe(true, time.Since(start), err)
+	}()
 	return p.storage.WriteAll(ctx, volume, path, b)
 }
 
@@ -260,6 +418,7 @@ func (p *xlStorageDiskIDCheck) DeleteVersion(ctx context.Context, volume, path s
 	if err = p.checkDiskStale(); err != nil {
 		return err
 	}
+	defer p.traceStorage("DeleteVersion", volume, path)()
 
 	return p.storage.DeleteVersion(ctx, volume, path, fi, forceDelMarker)
 }
@@ -268,7 +427,12 @@ func (p *xlStorageDiskIDCheck) WriteMetadata(ctx context.Context, volume, path s
 	if err = p.checkDiskStale(); err != nil {
 		return err
 	}
+	defer p.traceStorage("WriteMetadata", volume, path)()
 
+	start := time.Now()
+	defer func() {
+		p.ioStats.observe(true, time.Since(start), err)
+	}()
 	return p.storage.WriteMetadata(ctx, volume, path, fi)
 }
 
@@ -276,14 +440,25 @@ func (p *xlStorageDiskIDCheck) ReadVersion(ctx context.Context, volume, path, ve
 	if err = p.checkDiskStale(); err != nil {
 		return fi, err
 	}
-
-	return p.storage.ReadVersion(ctx, volume, path, versionID, readData)
+	defer p.traceStorage("ReadVersion", volume, path)()
+
+	start := time.Now()
+	defer func() {
+		p.latency.observe(time.Since(start))
+		p.ioStats.observe(false, time.Since(start), err)
+	}()
+	fi, err = p.storage.ReadVersion(ctx, volume, path, versionID, readData)
+	if err != nil {
+		p.trackReadErr(volume, path, versionID, err)
+	}
+	return fi, err
 }
 
 func (p *xlStorageDiskIDCheck) ReadAll(ctx context.Context, volume string, path string) (buf []byte, err error) {
 	if err = p.checkDiskStale(); err != nil {
 		return nil, err
 	}
+	defer p.traceStorage("ReadAll", volume, path)()
 
 	return p.storage.ReadAll(ctx, volume, path)
 }
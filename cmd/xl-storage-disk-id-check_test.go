@@ -0,0 +1,55 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDiskReadErrTracker(t *testing.T) {
+	var tracker diskReadErrTracker
+	now := time.Now()
+	for i := 1; i < diskReadErrThreshold; i++ {
+		if tracker.observe(now) {
+			t.Fatalf("Unexpected threshold reached after %d errors", i)
+		}
+	}
+	if !tracker.observe(now) {
+		t.Fatal("Expected threshold to be reached")
+	}
+	// Errors outside the window start a new window.
+	if tracker.observe(now.Add(2 * diskReadErrWindow)) {
+		t.Fatal("Expected threshold to be reset in a new window")
+	}
+}
+
+func TestPartPathToObject(t *testing.T) {
+	testCases := []struct {
+		partPath string
+		object   string
+	}{
+		{"object/c06e0436-f813-447e-ae5e-f2564df9dfd4/part.1", "object"},
+		{"dir/object/c06e0436-f813-447e-ae5e-f2564df9dfd4/part.10", "dir/object"},
+		{"dir/object", "dir/object"},
+	}
+	for _, testCase := range testCases {
+		if got := partPathToObject(testCase.partPath); got != testCase.object {
+			t.Errorf("Expected %s, got %s", testCase.object, got)
+		}
+	}
+}
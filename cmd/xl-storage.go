@@ -63,6 +63,9 @@ const (
 	// Size of each buffer.
 	readAheadBufSize = 1 << 20
 
+	// Offsets of reads with O_DIRECT must be aligned to this.
+	directioAlignSize = 4 * humanize.KiByte
+
 	// Small file threshold below which data accompanies metadata from storage layer.
 	smallFileThreshold = 128 * humanize.KiByte // Optimized for NVMe/SSDs
 	// For hardrives it is possible to set this to a lower value to avoid any
@@ -282,7 +285,7 @@ func newXLStorage(ep Endpoint) (*xlStorage, error) {
 	if err = p.CreateFile(GlobalContext, minioMetaTmpBucket, tmpFile, 1, strings.NewReader("0")); err != nil {
 		return p, err
 	}
-	defer os.Remove(pathJoin(p.diskPath, minioMetaTmpBucket, tmpFile))
+	defer Remove(pathJoin(p.diskPath, minioMetaTmpBucket, tmpFile))
 
 	volumeDir, err := p.getVolDir(minioMetaTmpBucket)
 	if err != nil {
@@ -407,6 +410,10 @@ func (s *xlStorage) NSScanner(ctx context.Context, cache dataUsageCache) (dataUs
 		sizeS := sizeSummary{}
 		for _, version := range fivs.Versions {
 			oi := version.ToObjectInfo(item.bucket, item.objectPath())
+			if oi.IsLatest && !oi.DeleteMarker {
+				// Account the object against the storage class of its latest version.
+				sizeS.storageClass = oi.StorageClass
+			}
 			if objAPI != nil {
 				totalSize += item.applyActions(ctx, objAPI, actionMeta{
 					oi:         oi,
@@ -712,9 +719,9 @@ func (s *xlStorage) DeleteVol(ctx context.Context, volume string, forceDelete bo
 	}
 
 	if forceDelete {
-		err = os.RemoveAll(volumeDir)
+		err = RemoveAll(volumeDir)
 	} else {
-		err = os.Remove(volumeDir)
+		err = Remove(volumeDir)
 	}
 
 	if err != nil {
@@ -1061,7 +1068,7 @@ func (s *xlStorage) renameLegacyMetadata(volumeDir, path string) (err error) {
 		}
 	}()
 
-	if err = os.Rename(srcFilePath, dstFilePath); err != nil {
+	if err = Rename(srcFilePath, dstFilePath); err != nil {
 		switch {
 		case isSysErrNotDir(err):
 			return errFileNotFound
@@ -1152,9 +1159,9 @@ func (s *xlStorage) ReadVersion(ctx context.Context, volume, path, versionID str
 func (s *xlStorage) readAllData(volumeDir string, filePath string, requireDirectIO bool) (buf []byte, err error) {
 	var f *os.File
 	if requireDirectIO {
-		f, err = disk.OpenFileDirectIO(filePath, readMode, 0666)
+		f, err = OpenFileDirectIO(filePath, readMode, 0666)
 	} else {
-		f, err = os.OpenFile(filePath, readMode, 0)
+		f, err = OpenFile(filePath, readMode, 0)
 	}
 	if err != nil {
 		if osIsNotExist(err) {
@@ -1190,10 +1197,12 @@ func (s *xlStorage) readAllData(volumeDir string, filePath string, requireDirect
 	}
 
 	atomic.AddInt32(&s.activeIOCount, 1)
-	rd := &odirectReader{f, nil, nil, true, true, s, nil}
+	rd := &odirectReader{f, nil, nil, true, true, s, nil, 0}
 	defer rd.Close() // activeIOCount is decremented in Close()
 
+	doneRead := traceOS(osMetricRead, filePath)
 	buf, err = ioutil.ReadAll(rd)
+	doneRead()
 	if err != nil {
 		err = osErrToFileErr(err)
 	}
@@ -1270,7 +1279,7 @@ func (s *xlStorage) ReadFile(ctx context.Context, volume string, path string, of
 	}
 
 	// Open the file for reading.
-	file, err := os.Open(filePath)
+	file, err := Open(filePath)
 	if err != nil {
 		switch {
 		case osIsNotExist(err):
@@ -1302,6 +1311,8 @@ func (s *xlStorage) ReadFile(ctx context.Context, volume string, path string, of
 		return 0, errIsNotRegular
 	}
 
+	defer traceOS(osMetricRead, filePath)()
+
 	if verifier == nil {
 		n, err = file.ReadAt(buffer, offset)
 		return int64(n), err
@@ -1348,7 +1359,7 @@ func (s *xlStorage) openFile(volume, path string, mode int) (f *os.File, err err
 		return nil, err
 	}
 
-	w, err := os.OpenFile(filePath, mode|writeMode, 0666)
+	w, err := OpenFile(filePath, mode|writeMode, 0666)
 	if err != nil {
 		// File path cannot be verified since one of the parents is a file.
 		switch {
@@ -1377,6 +1388,9 @@ type odirectReader struct {
 	smallFile bool
 	s         *xlStorage
 	err       error
+	// Bytes to drop from the start of the file, where the
+	// requested offset was not aligned for O_DIRECT.
+	skip int64
 }
 
 // Read - Implements Reader interface.
@@ -1391,7 +1405,7 @@ func (o *odirectReader) Read(buf []byte) (n int, err error) {
 			o.bufp = o.s.poolLarge.Get().(*[]byte)
 		}
 	}
-	if o.freshRead {
+	for o.freshRead {
 		o.buf = *o.bufp
 		n, err = o.f.Read(o.buf)
 		if err != nil && err != io.EOF {
@@ -1405,6 +1419,15 @@ func (o *odirectReader) Read(buf []byte) (n int, err error) {
 		}
 		o.buf = o.buf[:n]
 		o.freshRead = false
+		if o.skip > 0 {
+			skip := o.skip
+			if skip > int64(n) {
+				skip = int64(n)
+			}
+			o.buf = o.buf[skip:]
+			o.skip -= skip
+			o.freshRead = len(o.buf) == 0
+		}
 	}
 	if len(buf) >= len(o.buf) {
 		n = copy(buf, o.buf)
@@ -1418,10 +1441,13 @@ func (o *odirectReader) Read(buf []byte) (n int, err error) {
 
 // Close - Release the buffer and close the file.
 func (o *odirectReader) Close() error {
-	if o.smallFile {
-		o.s.poolSmall.Put(o.bufp)
-	} else {
-		o.s.poolLarge.Put(o.bufp)
+	// The buffer is taken on the first Read only.
+	if o.bufp != nil {
+		if o.smallFile {
+			o.s.poolSmall.Put(o.bufp)
+		} else {
+			o.s.poolLarge.Put(o.bufp)
+		}
 	}
 	defer func() {
 		atomic.AddInt32(&o.s.activeIOCount, -1)
@@ -1429,6 +1455,20 @@ func (o *odirectReader) Close() error {
 	return o.f.Close()
 }
 
+// readDirectIO returns true if a read of length bytes of object data
+// at offset should bypass the page cache. Without a read threshold
+// only reads from the start of the file bypass it.
+func (s *xlStorage) readDirectIO(offset, length int64) bool {
+	if !s.readODirectSupported || globalStorageClass.GetDMA() != storageclass.DMAReadWrite {
+		return false
+	}
+	threshold := globalStorageClass.GetDMAReadThreshold()
+	if threshold <= 0 {
+		return offset == 0
+	}
+	return length >= threshold
+}
+
 // ReadFileStream - Returns the read stream of the file.
 func (s *xlStorage) ReadFileStream(ctx context.Context, volume, path string, offset, length int64) (io.ReadCloser, error) {
 	if offset < 0 {
@@ -1447,12 +1487,12 @@ func (s *xlStorage) ReadFileStream(ctx context.Context, volume, path string, off
 	}
 
 	var file *os.File
-	// O_DIRECT only supported if offset is zero
-	if offset == 0 && globalStorageClass.GetDMA() == storageclass.DMAReadWrite && s.readODirectSupported {
-		file, err = disk.OpenFileDirectIO(filePath, os.O_RDONLY, 0666)
+	directIO := s.readDirectIO(offset, length)
+	if directIO {
+		file, err = OpenFileDirectIO(filePath, os.O_RDONLY, 0666)
 	} else {
 		// Open the file for reading.
-		file, err = os.Open(filePath)
+		file, err = Open(filePath)
 	}
 	if err != nil {
 		switch {
@@ -1489,11 +1529,22 @@ func (s *xlStorage) ReadFileStream(ctx context.Context, volume, path string, off
 	}
 
 	atomic.AddInt32(&s.activeIOCount, 1)
-	if offset == 0 && globalStorageClass.GetDMA() == storageclass.DMAReadWrite && s.readODirectSupported {
-		if length <= smallFileThreshold {
-			return &odirectReader{file, nil, nil, true, true, s, nil}, nil
+	if directIO {
+		// O_DIRECT reads must start at an aligned offset, read
+		// from the preceding aligned offset and drop the excess.
+		alignedOffset := offset - offset%directioAlignSize
+		if alignedOffset > 0 {
+			if _, err = file.Seek(alignedOffset, io.SeekStart); err != nil {
+				atomic.AddInt32(&s.activeIOCount, -1)
+				file.Close()
+				return nil, err
+			}
 		}
-		return &odirectReader{file, nil, nil, true, false, s, nil}, nil
+		or := &odirectReader{file, nil, nil, true, length <= smallFileThreshold, s, nil, offset - alignedOffset}
+		return struct {
+			io.Reader
+			io.Closer
+		}{Reader: io.LimitReader(or, length), Closer: or}, nil
 	}
 
 	if offset > 0 {
@@ -1585,7 +1636,7 @@ func (s *xlStorage) CreateFile(ctx context.Context, volume, path string, fileSiz
 		return err
 	}
 
-	w, err := disk.OpenFileDirectIO(filePath, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0666)
+	w, err := OpenFileDirectIO(filePath, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0666)
 	if err != nil {
 		switch {
 		case osIsPermission(err):
@@ -1845,7 +1896,7 @@ func (s *xlStorage) deleteFile(basePath, deletePath string, recursive bool) erro
 		tmpuuid := mustGetUUID()
 		err = renameAll(deletePath, pathutil.Join(s.diskPath, minioMetaTmpDeletedBucket, tmpuuid))
 	} else {
-		err = os.Remove(deletePath)
+		err = Remove(deletePath)
 	}
 	if err != nil {
 		switch {
@@ -2106,7 +2157,7 @@ func (s *xlStorage) RenameData(ctx context.Context, srcVolume, srcPath, dataDir,
 				continue
 			}
 
-			if err = os.Rename(pathJoin(currentDataPath, entry), pathJoin(legacyDataPath, entry)); err != nil {
+			if err = Rename(pathJoin(currentDataPath, entry), pathJoin(legacyDataPath, entry)); err != nil {
 				return osErrToFileErr(err)
 			}
 		}
@@ -2236,7 +2287,7 @@ func (s *xlStorage) RenameFile(ctx context.Context, srcVolume, srcPath, dstVolum
 		}
 		// Empty destination remove it before rename.
 		if isDirEmpty(dstFilePath) {
-			if err = os.Remove(dstFilePath); err != nil {
+			if err = Remove(dstFilePath); err != nil {
 				if isSysErrNotEmpty(err) {
 					return errFileAccessDenied
 				}
@@ -2258,18 +2309,35 @@ func (s *xlStorage) RenameFile(ctx context.Context, srcVolume, srcPath, dstVolum
 }
 
 func (s *xlStorage) bitrotVerify(partPath string, partSize int64, algo BitrotAlgorithm, sum []byte, shardSize int64) error {
-	// Open the file for reading.
-	file, err := os.Open(partPath)
+	// Open the file for reading, with a read threshold large parts
+	// bypass the page cache so that verifying them does not evict
+	// hot data.
+	var file *os.File
+	var err error
+	directIO := globalStorageClass.GetDMAReadThreshold() > 0 && s.readDirectIO(0, partSize)
+	if directIO {
+		file, err = OpenFileDirectIO(partPath, os.O_RDONLY, 0666)
+	} else {
+		file, err = Open(partPath)
+	}
 	if err != nil {
 		return osErrToFileErr(err)
 	}
 
-	// Close the file descriptor.
-	defer file.Close()
+	var r io.Reader = file
+	if directIO {
+		atomic.AddInt32(&s.activeIOCount, 1)
+		rd := &odirectReader{file, nil, nil, true, false, s, nil, 0}
+		defer rd.Close() // activeIOCount is decremented in Close()
+		r = rd
+	} else {
+		// Close the file descriptor.
+		defer file.Close()
+	}
 
 	if algo != HighwayHash256S {
 		h := algo.New()
-		if _, err = io.Copy(h, file); err != nil {
+		if _, err = io.Copy(h, r); err != nil {
 			// Premature failure in reading the object,file is corrupt.
 			return errFileCorrupt
 		}
@@ -2303,7 +2371,7 @@ func (s *xlStorage) bitrotVerify(partPath string, partSize int64, algo BitrotAlg
 			return nil
 		}
 		h.Reset()
-		n, err = file.Read(hashBuf)
+		n, err = io.ReadFull(r, hashBuf)
 		if err != nil {
 			// Read's failed for object with right size, file is corrupt.
 			return err
@@ -2312,7 +2380,7 @@ func (s *xlStorage) bitrotVerify(partPath string, partSize int64, algo BitrotAlg
 		if size < int64(len(buf)) {
 			buf = buf[:size]
 		}
-		n, err = file.Read(buf)
+		n, err = io.ReadFull(r, buf)
 		if err != nil {
 			// Read's failed for object with right size, at different offsets.
 			return err
@@ -1819,3 +1819,131 @@ func TestXLStorageVerifyFile(t *testing.T) {
 		t.Fatal("expected to fail bitrot check")
 	}
 }
+
+// TestXLStorageReadFileStreamDirectIO - tests reading ranges and
+// verifying bitrot of files with O_DIRECT.
+func TestXLStorageReadFileStreamDirectIO(t *testing.T) {
+	xlStorage, path, err := newXLStorageTestSetup()
+	if err != nil {
+		t.Fatalf("Unable to create xlStorage test setup, %s", err)
+	}
+	defer os.RemoveAll(path)
+
+	restoreGlobalStorageClass := globalStorageClass
+	defer func() {
+		globalStorageClass = restoreGlobalStorageClass
+	}()
+	globalStorageClass = storageclass.Config{
+		DMA:              storageclass.DMAReadWrite,
+		DMAReadThreshold: 1,
+	}
+
+	volName := "testvol"
+	fileName := "testfile"
+	if err := xlStorage.MakeVol(context.Background(), volName); err != nil {
+		t.Fatal(err)
+	}
+
+	size := int64(5*1024*1024 + 100*1024)
+	data := make([]byte, size)
+	if _, err := rand.Read(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := xlStorage.WriteAll(context.Background(), volName, fileName, data); err != nil {
+		t.Fatal(err)
+	}
+	if !xlStorage.storage.readDirectIO(1, size) {
+		t.Skip("drive does not support O_DIRECT reads")
+	}
+
+	testCases := []struct {
+		offset, length int64
+	}{
+		{0, size},
+		{0, 10},
+		{1, 10},
+		{4095, 2},
+		{4096, 4096},
+		{blockSizeLarge - 1, blockSizeLarge + 2},
+		{size - 100, 100},
+		{12345, size - 12345},
+		// Unaligned offset and a length which is not a multiple of
+		// the alignment, ending before the end of the file.
+		{directioAlignSize + 123, 3*directioAlignSize + 1000},
+	}
+	for i, testCase := range testCases {
+		rc, err := xlStorage.ReadFileStream(context.Background(), volName, fileName, testCase.offset, testCase.length)
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		got, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatalf("Test %d: %v", i+1, err)
+		}
+		if !bytes.Equal(got, data[testCase.offset:testCase.offset+testCase.length]) {
+			t.Errorf("Test %d: read %d bytes at offset %d do not match", i+1, len(got), testCase.offset)
+		}
+	}
+
+	algo := HighwayHash256
+	h := algo.New()
+	h.Write(data)
+	if err := xlStorage.storage.bitrotVerify(pathJoin(path, volName, fileName), size, algo, h.Sum(nil), 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := xlStorage.Delete(context.Background(), volName, fileName, false); err != nil {
+		t.Fatal(err)
+	}
+
+	algo = HighwayHash256S
+	shardSize := int64(1024 * 1024)
+	w := newStreamingBitrotWriter(xlStorage, volName, fileName, size, algo, shardSize)
+	for offset := int64(0); offset < size; offset += shardSize {
+		end := offset + shardSize
+		if end > size {
+			end = size
+		}
+		if _, err := w.Write(data[offset:end]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w.Close()
+	if err := xlStorage.storage.bitrotVerify(pathJoin(path, volName, fileName), size, algo, nil, shardSize); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Tests which reads bypass the page cache with and without a read threshold.
+func TestXLStorageReadDirectIO(t *testing.T) {
+	restoreGlobalStorageClass := globalStorageClass
+	defer func() {
+		globalStorageClass = restoreGlobalStorageClass
+	}()
+
+	s := &xlStorage{readODirectSupported: true}
+	testCases := []struct {
+		dma            string
+		threshold      int64
+		offset, length int64
+		directIO       bool
+	}{
+		// Without a threshold only reads from the start of the file.
+		{storageclass.DMAReadWrite, 0, 0, 10, true},
+		{storageclass.DMAReadWrite, 0, 4096, 1 << 20, false},
+		{storageclass.DMAWrite, 0, 0, 10, false},
+		{storageclass.DMAReadWrite, 1 << 20, 0, 10, false},
+		{storageclass.DMAReadWrite, 1 << 20, 12345, 1 << 20, true},
+		{storageclass.DMAWrite, 1 << 20, 0, 1 << 20, false},
+	}
+	for i, testCase := range testCases {
+		globalStorageClass = storageclass.Config{
+			DMA:              testCase.dma,
+			DMAReadThreshold: testCase.threshold,
+		}
+		if directIO := s.readDirectIO(testCase.offset, testCase.length); directIO != testCase.directIO {
+			t.Errorf("Test %d: expected O_DIRECT %v, got %v", i+1, testCase.directIO, directIO)
+		}
+	}
+}
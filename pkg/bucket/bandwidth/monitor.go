@@ -19,6 +19,7 @@ package bandwidth
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/minio/minio/pkg/bandwidth"
@@ -42,7 +43,7 @@ func (m *Monitor) throttleBandwidth(ctx context.Context, bucket string, bandwidt
 // SubscribeToBuckets subscribes to buckets. Empty array for monitoring all buckets.
 func (m *Monitor) SubscribeToBuckets(subCh chan interface{}, doneCh <-chan struct{}, buckets []string) {
 	m.pubsub.Subscribe(subCh, doneCh, func(f interface{}) bool {
-		if buckets != nil || len(buckets) == 0 {
+		if len(buckets) == 0 {
 			return true
 		}
 		report, ok := f.(*bandwidth.Report)
@@ -123,10 +124,13 @@ func (m *Monitor) getReport(selectBucket SelectionFunction) *bandwidth.Report {
 		if !selectBucket(bucket) {
 			continue
 		}
-		report.BucketStats[bucket] = bandwidth.Details{
-			LimitInBytesPerSecond:            m.bucketThrottle[bucket].clusterBandwidth,
+		details := bandwidth.Details{
 			CurrentBandwidthInBytesPerSecond: bucketMeasurement.getExpMovingAvgBytesPerSecond(),
 		}
+		if throttle, ok := m.bucketThrottle[bucket]; ok {
+			details.LimitInBytesPerSecond = atomic.LoadInt64(&throttle.clusterBandwidth)
+		}
+		report.BucketStats[bucket] = details
 	}
 	return report
 }
@@ -29,7 +29,6 @@ const (
 
 // throttle implements the throttling for bandwidth
 type throttle struct {
-	generateTicker   *time.Ticker    // Ticker to generate available bandwidth
 	freeBytes        int64           // unused bytes in the interval
 	bytesPerSecond   int64           // max limit for bandwidth
 	bytesPerInterval int64           // bytes allocated for the interval
@@ -41,19 +40,11 @@ type throttle struct {
 
 // newThrottle returns a new bandwidth throttle. Set bytesPerSecond to 0 for no limit
 func newThrottle(ctx context.Context, bytesPerSecond int64, clusterBandwidth int64) *throttle {
-	if bytesPerSecond == 0 {
-		return &throttle{}
-	}
 	t := &throttle{
-		bytesPerSecond:   bytesPerSecond,
-		generateTicker:   time.NewTicker(throttleInternal),
-		clusterBandwidth: clusterBandwidth,
-		ctx:              ctx,
+		cond: sync.NewCond(&sync.Mutex{}),
+		ctx:  ctx,
 	}
-
-	t.cond = sync.NewCond(&sync.Mutex{})
 	t.SetBandwidth(bytesPerSecond, clusterBandwidth)
-	t.freeBytes = t.bytesPerInterval
 	return t
 }
 
@@ -67,6 +58,10 @@ func (t *throttle) GetLimitForBytes(want int64) int64 {
 	t.cond.L.Lock()
 	defer t.cond.L.Unlock()
 	for {
+		// The limit may have been removed while waiting.
+		if atomic.LoadInt64(&t.bytesPerInterval) == 0 {
+			return want
+		}
 		var send int64
 		freeBytes := atomic.LoadInt64(&t.freeBytes)
 		send = want
@@ -87,10 +82,24 @@ func (t *throttle) GetLimitForBytes(want int64) int64 {
 	}
 }
 
-// SetBandwidth sets a new bandwidth limit in bytes per second.
+// SetBandwidth sets a new bandwidth limit in bytes per second, a limit
+// of 0 removes the limit.
 func (t *throttle) SetBandwidth(bandwidthBiPS int64, clusterBandwidth int64) {
 	bpi := int64(throttleInternal) * bandwidthBiPS / int64(time.Second)
-	atomic.StoreInt64(&t.bytesPerInterval, bpi)
+	if bandwidthBiPS > 0 && bpi == 0 {
+		// Allow at least a byte per interval for very low limits.
+		bpi = 1
+	}
+	atomic.StoreInt64(&t.bytesPerSecond, bandwidthBiPS)
+	atomic.StoreInt64(&t.clusterBandwidth, clusterBandwidth)
+	if atomic.SwapInt64(&t.bytesPerInterval, bpi) != bpi && t.cond != nil {
+		// Start a new window with the new limit and wake up waiting
+		// readers to pick it up.
+		t.cond.L.Lock()
+		atomic.StoreInt64(&t.freeBytes, bpi)
+		t.cond.Broadcast()
+		t.cond.L.Unlock()
+	}
 }
 
 // ReleaseUnusedBandwidth releases bandwidth that was allocated for a user
@@ -100,20 +109,24 @@ func (t *throttle) ReleaseUnusedBandwidth(bytes int64) {
 
 // generateBandwidth periodically allocates new bandwidth to use
 func (t *throttle) generateBandwidth(ctx context.Context) {
+	ticker := time.NewTicker(throttleInternal)
+	defer ticker.Stop()
 	for {
 		select {
-		case <-t.generateTicker.C:
-			if atomic.LoadInt64(&t.freeBytes) == atomic.LoadInt64(&t.bytesPerInterval) {
+		case <-ticker.C:
+			bpi := atomic.LoadInt64(&t.bytesPerInterval)
+			if atomic.LoadInt64(&t.freeBytes) == bpi || bpi == 0 {
 				// No bandwidth consumption stop the routine.
 				atomic.StoreInt64(&t.goGenerate, 0)
 				return
 			}
 			// A new window is available
 			t.cond.L.Lock()
-			atomic.StoreInt64(&t.freeBytes, atomic.LoadInt64(&t.bytesPerInterval))
+			atomic.StoreInt64(&t.freeBytes, bpi)
 			t.cond.Broadcast()
 			t.cond.L.Unlock()
 		case <-ctx.Done():
+			atomic.StoreInt64(&t.goGenerate, 0)
 			return
 		}
 	}
@@ -0,0 +1,59 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bandwidth
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestThrottleSetBandwidth(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// No limit, everything is sent at once.
+	thr := newThrottle(ctx, 0, 0)
+	if got := thr.GetLimitForBytes(1 << 20); got != 1<<20 {
+		t.Fatalf("expected no limit, got %d", got)
+	}
+
+	// Adding a limit applies to the next reads.
+	thr.SetBandwidth(400, 800)
+	if got := thr.GetLimitForBytes(1 << 20); got != 100 {
+		t.Fatalf("expected 100 bytes per interval, got %d", got)
+	}
+	if thr.clusterBandwidth != 800 {
+		t.Fatalf("expected the cluster bandwidth to be updated, got %d", thr.clusterBandwidth)
+	}
+
+	// Removing the limit releases readers waiting for bandwidth.
+	done := make(chan int64)
+	go func() {
+		done <- thr.GetLimitForBytes(1 << 20)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	thr.SetBandwidth(0, 0)
+	select {
+	case got := <-done:
+		if got != 1<<20 {
+			t.Fatalf("expected no limit, got %d", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("reader still waiting after removing the limit")
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"crypto/x509"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net"
 	"net/url"
 	"os"
@@ -115,6 +116,19 @@ func (k KafkaArgs) Validate() error {
 			return err
 		}
 	}
+	if k.TLS.ClientTLSCert != "" && k.TLS.ClientTLSKey == "" || k.TLS.ClientTLSCert == "" && k.TLS.ClientTLSKey != "" {
+		return errors.New("cert and key must be specified as a pair")
+	}
+	if k.SASL.Enable {
+		if k.SASL.User == "" || k.SASL.Password == "" {
+			return errors.New("SASL username and password must be specified")
+		}
+		switch k.SASL.Mechanism {
+		case "", "plain", "sha256", "sha512":
+		default:
+			return fmt.Errorf("unknown SASL mechanism '%s', expected 'plain', 'sha256' or 'sha512'", k.SASL.Mechanism)
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,62 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package target
+
+import (
+	"testing"
+
+	xnet "github.com/minio/minio/pkg/net"
+)
+
+func TestKafkaArgsValidate(t *testing.T) {
+	newArgs := func() KafkaArgs {
+		args := KafkaArgs{
+			Enable:  true,
+			Brokers: []xnet.Host{{Name: "localhost", Port: 9092, IsPortSet: true}},
+			Topic:   "minio",
+		}
+		args.SASL.Enable = true
+		args.SASL.User = "minio"
+		args.SASL.Password = "minio123"
+		return args
+	}
+
+	testCases := []struct {
+		update    func(args *KafkaArgs)
+		expectErr bool
+	}{
+		{func(args *KafkaArgs) {}, false},
+		{func(args *KafkaArgs) { args.SASL.Mechanism = "plain" }, false},
+		{func(args *KafkaArgs) { args.SASL.Mechanism = "sha256" }, false},
+		{func(args *KafkaArgs) { args.SASL.Mechanism = "sha512" }, false},
+		{func(args *KafkaArgs) { args.SASL.Mechanism = "md5" }, true},
+		{func(args *KafkaArgs) { args.SASL.Password = "" }, true},
+		{func(args *KafkaArgs) { args.SASL.Enable, args.SASL.User, args.SASL.Password = false, "", "" }, false},
+		{func(args *KafkaArgs) { args.TLS.ClientTLSCert, args.TLS.ClientTLSKey = "client.crt", "client.key" }, false},
+		{func(args *KafkaArgs) { args.TLS.ClientTLSCert = "client.crt" }, true},
+		{func(args *KafkaArgs) { args.TLS.ClientTLSKey = "client.key" }, true},
+	}
+
+	for i, testCase := range testCases {
+		args := newArgs()
+		testCase.update(&args)
+		if err := args.Validate(); (err != nil) != testCase.expectErr {
+			t.Errorf("Test %d: expected error %v, got %v", i+1, testCase.expectErr, err)
+		}
+	}
+}
@@ -22,9 +22,11 @@ import (
 	"crypto/x509"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/url"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/minio/minio/pkg/event"
 	xnet "github.com/minio/minio/pkg/net"
@@ -54,6 +56,9 @@ const (
 	NATSStreamingAsync              = "streaming_async"
 	NATSStreamingMaxPubAcksInFlight = "streaming_max_pub_acks_in_flight"
 
+	// JetStream constants
+	NATSJetStream = "jetstream"
+
 	EnvNATSEnable        = "MINIO_NOTIFY_NATS_ENABLE"
 	EnvNATSAddress       = "MINIO_NOTIFY_NATS_ADDRESS"
 	EnvNATSSubject       = "MINIO_NOTIFY_NATS_SUBJECT"
@@ -74,8 +79,14 @@ const (
 	EnvNATSStreamingClusterID          = "MINIO_NOTIFY_NATS_STREAMING_CLUSTER_ID"
 	EnvNATSStreamingAsync              = "MINIO_NOTIFY_NATS_STREAMING_ASYNC"
 	EnvNATSStreamingMaxPubAcksInFlight = "MINIO_NOTIFY_NATS_STREAMING_MAX_PUB_ACKS_IN_FLIGHT"
+
+	// JetStream constants
+	EnvNATSJetStream = "MINIO_NOTIFY_NATS_JETSTREAM"
 )
 
+// Time to wait for JetStream to acknowledge that an event is persisted.
+const natsJetStreamAckTimeout = 5 * time.Second
+
 // NATSArgs - NATS target arguments.
 type NATSArgs struct {
 	Enable        bool      `json:"enable"`
@@ -99,6 +110,9 @@ type NATSArgs struct {
 		Async              bool   `json:"async"`
 		MaxPubAcksInflight int    `json:"maxPubAcksInflight"`
 	} `json:"streaming"`
+	JetStream struct {
+		Enable bool `json:"enable"`
+	} `json:"jetStream"`
 
 	RootCAs *x509.CertPool `json:"-"`
 }
@@ -129,6 +143,9 @@ func (n NATSArgs) Validate() error {
 		if n.Streaming.ClusterID == "" {
 			return errors.New("empty cluster id")
 		}
+		if n.JetStream.Enable {
+			return errors.New("streaming and jetstream cannot be enabled together")
+		}
 	}
 
 	if n.QueueDir != "" {
@@ -274,12 +291,47 @@ func (target *NATSTarget) send(eventData event.Event) error {
 		} else {
 			err = target.stanConn.Publish(target.args.Subject, data)
 		}
+	} else if target.args.JetStream.Enable {
+		err = target.publishJetStream(data)
 	} else {
 		err = target.natsConn.Publish(target.args.Subject, data)
 	}
 	return err
 }
 
+// natsJetStreamPubAck is the acknowledgement sent by JetStream once a
+// message published to a subject of a stream is persisted.
+type natsJetStreamPubAck struct {
+	Stream string `json:"stream"`
+	Seq    uint64 `json:"seq"`
+	Error  *struct {
+		Code        int    `json:"code"`
+		Description string `json:"description"`
+	} `json:"error,omitempty"`
+}
+
+// publishJetStream - publishes the event to a JetStream stream and waits
+// until JetStream acknowledges that the event is persisted, such that
+// events are only removed from the queue store once persisted.
+func (target *NATSTarget) publishJetStream(data []byte) error {
+	msg, err := target.natsConn.Request(target.args.Subject, data, natsJetStreamAckTimeout)
+	if err != nil {
+		return err
+	}
+
+	var ack natsJetStreamPubAck
+	if err = json.Unmarshal(msg.Data, &ack); err != nil {
+		return fmt.Errorf("jetstream: invalid publish acknowledgement: %w", err)
+	}
+	if ack.Error != nil {
+		return fmt.Errorf("jetstream: %s (%d)", ack.Error.Description, ack.Error.Code)
+	}
+	if ack.Stream == "" {
+		return errors.New("jetstream: no stream acknowledged the event")
+	}
+	return nil
+}
+
 // Send - sends event to Nats.
 func (target *NATSTarget) Send(eventKey string) error {
 	_, err := target.IsActive()
@@ -21,6 +21,7 @@ import (
 
 	xnet "github.com/minio/minio/pkg/net"
 	natsserver "github.com/nats-io/nats-server/v2/test"
+	"github.com/nats-io/nats.go"
 )
 
 func TestNatsConnPlain(t *testing.T) {
@@ -90,3 +91,75 @@ func TestNatsConnToken(t *testing.T) {
 	}
 	defer con.Close()
 }
+
+func TestNatsJetStreamPublish(t *testing.T) {
+	opts := natsserver.DefaultTestOptions
+	opts.Port = 14224
+	s := natsserver.RunServer(&opts)
+	defer s.Shutdown()
+
+	args := NATSArgs{
+		Enable: true,
+		Address: xnet.Host{Name: "localhost",
+			Port:      (xnet.Port(opts.Port)),
+			IsPortSet: true},
+		Subject: "test",
+	}
+	args.JetStream.Enable = true
+
+	con, err := args.connectNats()
+	if err != nil {
+		t.Fatalf("Could not connect to nats: %v", err)
+	}
+	defer con.Close()
+	target := &NATSTarget{args: args, natsConn: con}
+
+	// Without a stream on the subject nothing acknowledges the event.
+	if err = target.publishJetStream([]byte("event")); err == nil {
+		t.Fatal("expected an error without a stream")
+	}
+
+	// Respond like a JetStream stream would.
+	testCases := []struct {
+		ack       string
+		expectErr bool
+	}{
+		{`{"stream":"events","seq":1}`, false},
+		{`{"error":{"code":503,"description":"insufficient resources"}}`, true},
+		{`{}`, true},
+		{`+OK`, true},
+	}
+	for i, testCase := range testCases {
+		ack := testCase.ack
+		sub, err := con.Subscribe(args.Subject, func(msg *nats.Msg) {
+			msg.Respond([]byte(ack))
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = target.publishJetStream([]byte("event")); (err != nil) != testCase.expectErr {
+			t.Errorf("Test %d: expected error %v, got %v", i+1, testCase.expectErr, err)
+		}
+		sub.Unsubscribe()
+	}
+}
+
+func TestNatsArgsValidate(t *testing.T) {
+	args := NATSArgs{
+		Enable: true,
+		Address: xnet.Host{Name: "localhost",
+			Port:      14222,
+			IsPortSet: true},
+		Subject: "test",
+	}
+	args.JetStream.Enable = true
+	if err := args.Validate(); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	args.Streaming.Enable = true
+	args.Streaming.ClusterID = "cluster"
+	if err := args.Validate(); err == nil {
+		t.Fatal("expected streaming and jetstream to be exclusive")
+	}
+}
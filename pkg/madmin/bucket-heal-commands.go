@@ -0,0 +1,109 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// BucketHealConfig holds the heal settings of a bucket.
+type BucketHealConfig struct {
+	// Priority orders the buckets healed after a drive is replaced,
+	// buckets with a higher priority are healed first. Defaults to 0,
+	// a negative priority heals the bucket after all others.
+	Priority int `json:"priority"`
+
+	// HealOnRead queues objects found with missing or corrupted
+	// parts during a read for healing. Defaults to enabled, it can
+	// be disabled to avoid writes caused by reads of the bucket.
+	HealOnRead *bool `json:"healOnRead,omitempty"`
+}
+
+// HealOnReadEnabled returns whether objects of the bucket are
+// healed when a read finds them degraded.
+func (cfg BucketHealConfig) HealOnReadEnabled() bool {
+	return cfg.HealOnRead == nil || *cfg.HealOnRead
+}
+
+// GetBucketHealConfig - get the heal settings of a bucket
+func (adm *AdminClient) GetBucketHealConfig(ctx context.Context, bucket string) (cfg BucketHealConfig, err error) {
+	queryValues := url.Values{}
+	queryValues.Set("bucket", bucket)
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/get-bucket-heal",
+		queryValues: queryValues,
+	}
+
+	// Execute GET on /minio/admin/v3/get-bucket-heal
+	resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
+
+	defer closeResponse(resp)
+	if err != nil {
+		return cfg, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return cfg, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return cfg, err
+	}
+	if err = json.Unmarshal(b, &cfg); err != nil {
+		return cfg, err
+	}
+
+	return cfg, nil
+}
+
+// SetBucketHealConfig - sets the heal settings of a bucket.
+func (adm *AdminClient) SetBucketHealConfig(ctx context.Context, bucket string, cfg *BucketHealConfig) error {
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+
+	queryValues := url.Values{}
+	queryValues.Set("bucket", bucket)
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/set-bucket-heal",
+		queryValues: queryValues,
+		content:     data,
+	}
+
+	// Execute PUT on /minio/admin/v3/set-bucket-heal to set heal settings for a bucket.
+	resp, err := adm.executeMethod(ctx, http.MethodPut, reqData)
+
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return httpRespToErrorResponse(resp)
+	}
+
+	return nil
+}
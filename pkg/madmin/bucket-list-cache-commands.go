@@ -0,0 +1,103 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// BucketListCacheConfig holds the listing cache settings of a bucket.
+type BucketListCacheConfig struct {
+	// Enabled serves listings of the bucket from a cache of the
+	// bucket namespace which is kept up to date by the scanner.
+	Enabled bool `json:"enabled"`
+
+	// MaxStaleness is the maximum age of the cache a listing is
+	// served from, changes made to the bucket within this duration
+	// may be missing from listings. Defaults to 15 minutes.
+	MaxStaleness time.Duration `json:"maxStaleness,omitempty"`
+}
+
+// GetBucketListCacheConfig - get the listing cache settings of a bucket
+func (adm *AdminClient) GetBucketListCacheConfig(ctx context.Context, bucket string) (cfg BucketListCacheConfig, err error) {
+	queryValues := url.Values{}
+	queryValues.Set("bucket", bucket)
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/get-bucket-list-cache",
+		queryValues: queryValues,
+	}
+
+	// Execute GET on /minio/admin/v3/get-bucket-list-cache
+	resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
+
+	defer closeResponse(resp)
+	if err != nil {
+		return cfg, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return cfg, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return cfg, err
+	}
+	if err = json.Unmarshal(b, &cfg); err != nil {
+		return cfg, err
+	}
+
+	return cfg, nil
+}
+
+// SetBucketListCacheConfig - sets the listing cache settings of a bucket.
+func (adm *AdminClient) SetBucketListCacheConfig(ctx context.Context, bucket string, cfg *BucketListCacheConfig) error {
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+
+	queryValues := url.Values{}
+	queryValues.Set("bucket", bucket)
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/set-bucket-list-cache",
+		queryValues: queryValues,
+		content:     data,
+	}
+
+	// Execute PUT on /minio/admin/v3/set-bucket-list-cache to set listing cache settings for a bucket.
+	resp, err := adm.executeMethod(ctx, http.MethodPut, reqData)
+
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return httpRespToErrorResponse(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/minio/minio/pkg/smart"
+)
+
+// Drive health states reported by the drive health monitor.
+const (
+	DriveHealthOK          = "ok"
+	DriveHealthFailingSoon = "failing-soon"
+	DriveHealthOffline     = "offline"
+)
+
+// DriveLatency holds the latency percentiles of the recent IO
+// calls served by a drive.
+type DriveLatency struct {
+	P50 time.Duration `json:"p50"`
+	P90 time.Duration `json:"p90"`
+	P99 time.Duration `json:"p99"`
+}
+
+// DriveHealth holds the health of a drive as last checked by the
+// drive health monitor of the server the drive is attached to.
+type DriveHealth struct {
+	Endpoint    string       `json:"endpoint"`
+	Path        string       `json:"path"`
+	Device      string       `json:"device,omitempty"`
+	State       string       `json:"state"`
+	Reasons     []string     `json:"reasons,omitempty"`
+	ReadErrors  uint64       `json:"readErrors"`
+	WriteErrors uint64       `json:"writeErrors"`
+	Latency     DriveLatency `json:"latency"`
+	SmartInfo   smart.Info   `json:"smartInfo"`
+	LastCheck   time.Time    `json:"lastCheck"`
+}
+
+// ServerDrivesHealth holds the health of the drives of a server.
+type ServerDrivesHealth struct {
+	Addr   string        `json:"addr"`
+	Drives []DriveHealth `json:"drives,omitempty"`
+	Error  string        `json:"error,omitempty"`
+}
+
+// DrivesHealthInfo holds the health of the drives of all servers.
+type DrivesHealthInfo struct {
+	Servers []ServerDrivesHealth `json:"servers"`
+}
+
+// DrivesHealth - returns the health of all drives of the cluster.
+func (adm *AdminClient) DrivesHealth(ctx context.Context) (DrivesHealthInfo, error) {
+	// Execute GET on /minio/admin/v3/drive-health
+	resp, err := adm.executeMethod(ctx,
+		http.MethodGet, requestData{
+			relPath: adminAPIPrefix + "/drive-health",
+		},
+	)
+	defer closeResponse(resp)
+	if err != nil {
+		return DrivesHealthInfo{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return DrivesHealthInfo{}, httpRespToErrorResponse(resp)
+	}
+
+	var info DrivesHealthInfo
+	if err = json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return DrivesHealthInfo{}, err
+	}
+	return info, nil
+}
@@ -62,6 +62,21 @@ func main() {
 	}
 	fmt.Println(creds)
 
+	// Create a new service account for another user, needs
+	// the admin:CreateServiceAccount permission
+	userCreds, err := madmClnt.AddServiceAccountForUser(context.Background(), "newuser", &p)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	fmt.Println(userCreds)
+
+	// Get the parent user and the inline policy of a service account
+	info, err := madmClnt.InfoServiceAccount(context.Background(), creds.AccessKey)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	fmt.Println(info)
+
 	// List all services accounts
 	list, err := madmClnt.ListServiceAccounts(context.Background())
 	if err != nil {
@@ -21,10 +21,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"io"
 	"io/ioutil"
+	"math"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
+
+	"github.com/minio/minio/pkg/wildcard"
 )
 
 // HealScanMode represents the type of healing scan
@@ -39,6 +45,10 @@ const (
 
 	// HealDeepScan checks for parts bitrot checksums
 	HealDeepScan
+
+	// HealMetadataScan only reconciles object metadata across
+	// disks, data parts are never verified nor rewritten
+	HealMetadataScan
 )
 
 // HealOpts - collection of options for a heal sequence
@@ -48,6 +58,48 @@ type HealOpts struct {
 	Remove    bool         `json:"remove"`
 	Recreate  bool         `json:"recreate"` // only used when bucket needs to be healed
 	ScanMode  HealScanMode `json:"scanMode"`
+
+	// Remote rewrites objects which permanently lost read quorum
+	// with the copy held by the bucket replication target, before
+	// they are considered for removal.
+	Remote bool `json:"remote,omitempty"`
+
+	// Dangling only looks for dangling entries, object versions
+	// which cannot be reconstructed, metadata left on fewer drives
+	// than read quorum and leftover parts of aborted multipart
+	// uploads, without healing any other object. The entries
+	// found are reported per bucket and only removed when
+	// PurgeDangling is also set.
+	Dangling      bool `json:"dangling,omitempty"`
+	PurgeDangling bool `json:"purgeDangling,omitempty"`
+
+	// Only objects with one of these prefixes are healed,
+	// all objects are healed when empty. A prefix containing
+	// '*' or '?' is matched as a glob against the object name.
+	IncludePrefixes []string `json:"includePrefixes,omitempty"`
+	// Objects with one of these prefixes are never healed.
+	ExcludePrefixes []string `json:"excludePrefixes,omitempty"`
+
+	// Only object versions carrying all of these tags are healed.
+	IncludeTags map[string]string `json:"includeTags,omitempty"`
+	// Object versions carrying any of these tags are never healed.
+	ExcludeTags map[string]string `json:"excludeTags,omitempty"`
+
+	// Only object versions modified within this time range are
+	// healed, zero values leave the range open on that side.
+	ModifiedAfter  time.Time `json:"modifiedAfter"`
+	ModifiedBefore time.Time `json:"modifiedBefore"`
+
+	// Only the objects of this pool and erasure set are healed, a
+	// set without a pool selects the set in every pool. All pools
+	// and sets are healed when nil.
+	Pool *int `json:"pool,omitempty"`
+	Set  *int `json:"set,omitempty"`
+
+	// Fraction of object versions, between 0 and 1, verified
+	// with a deep scan while the others are checked according
+	// to ScanMode.
+	SampleRate float64 `json:"sampleRate,omitempty"`
 }
 
 // Equal returns true if no is same as o.
@@ -61,9 +113,148 @@ func (o HealOpts) Equal(no HealOpts) bool {
 	if o.Remove != no.Remove {
 		return false
 	}
+	if o.Remote != no.Remote {
+		return false
+	}
+	if o.Dangling != no.Dangling || o.PurgeDangling != no.PurgeDangling {
+		return false
+	}
+	if !equalStrings(o.IncludePrefixes, no.IncludePrefixes) {
+		return false
+	}
+	if !equalStrings(o.ExcludePrefixes, no.ExcludePrefixes) {
+		return false
+	}
+	if !equalTags(o.IncludeTags, no.IncludeTags) || !equalTags(o.ExcludeTags, no.ExcludeTags) {
+		return false
+	}
+	if !o.ModifiedAfter.Equal(no.ModifiedAfter) || !o.ModifiedBefore.Equal(no.ModifiedBefore) {
+		return false
+	}
+	if !equalIndex(o.Pool, no.Pool) || !equalIndex(o.Set, no.Set) {
+		return false
+	}
+	if o.SampleRate != no.SampleRate {
+		return false
+	}
 	return o.ScanMode == no.ScanMode
 }
 
+// Sampled returns true if the object version is part of the sample
+// verified with a deep scan, the sample is stable across servers.
+func (o HealOpts) Sampled(object, versionID string) bool {
+	if o.SampleRate <= 0 {
+		return false
+	}
+	if o.SampleRate >= 1 {
+		return true
+	}
+	h := fnv.New64a()
+	h.Write([]byte(object))
+	h.Write([]byte{0})
+	h.Write([]byte(versionID))
+	return float64(h.Sum64())/float64(math.MaxUint64) < o.SampleRate
+}
+
+// MatchesModTime returns true if an object version modified at
+// modTime should be healed according to the modification range.
+func (o HealOpts) MatchesModTime(modTime time.Time) bool {
+	if !o.ModifiedAfter.IsZero() && !modTime.After(o.ModifiedAfter) {
+		return false
+	}
+	if !o.ModifiedBefore.IsZero() && !modTime.Before(o.ModifiedBefore) {
+		return false
+	}
+	return true
+}
+
+// MatchesPrefixes returns true if the object should be healed
+// according to the include and exclude prefixes.
+func (o HealOpts) MatchesPrefixes(object string) bool {
+	for _, prefix := range o.ExcludePrefixes {
+		if matchPrefix(prefix, object) {
+			return false
+		}
+	}
+	if len(o.IncludePrefixes) == 0 {
+		return true
+	}
+	for _, prefix := range o.IncludePrefixes {
+		if matchPrefix(prefix, object) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchesSet returns true if the objects of the erasure set
+// should be healed according to the targeted pool and set.
+func (o HealOpts) MatchesSet(poolIndex, setIndex int) bool {
+	if o.Pool != nil && *o.Pool != poolIndex {
+		return false
+	}
+	if o.Set != nil && *o.Set != setIndex {
+		return false
+	}
+	return true
+}
+
+// MatchesTags returns true if an object version with these tags
+// should be healed according to the include and exclude tags.
+func (o HealOpts) MatchesTags(tags map[string]string) bool {
+	for k, v := range o.ExcludeTags {
+		if tv, ok := tags[k]; ok && tv == v {
+			return false
+		}
+	}
+	for k, v := range o.IncludeTags {
+		if tv, ok := tags[k]; !ok || tv != v {
+			return false
+		}
+	}
+	return true
+}
+
+// matchPrefix matches the object against a plain prefix,
+// or against a glob if the prefix contains '*' or '?'.
+func matchPrefix(prefix, object string) bool {
+	if strings.ContainsAny(prefix, "*?") {
+		return wildcard.Match(prefix, object)
+	}
+	return strings.HasPrefix(object, prefix)
+}
+
+func equalIndex(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func equalTags(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 // HealStartSuccess - holds information about a successfully started
 // heal operation
 type HealStartSuccess struct {
@@ -84,6 +275,26 @@ type HealTaskStatus struct {
 	HealSettings  HealOpts  `json:"settings"`
 
 	Items []HealResultItem `json:"items,omitempty"`
+
+	// Dangling entries found by the heal sequence, keyed by
+	// bucket, set when HealOpts.Dangling is used.
+	Dangling map[string]HealDanglingCounts `json:"dangling,omitempty"`
+}
+
+// Kinds of dangling entries
+const (
+	HealDanglingVersion  = "version"
+	HealDanglingMetadata = "metadata"
+	HealDanglingUpload   = "upload"
+)
+
+// HealDanglingCounts - number of dangling entries of a bucket found
+// by a heal sequence, Purged counts the removed ones.
+type HealDanglingCounts struct {
+	Versions int64 `json:"versions,omitempty"`
+	Metadata int64 `json:"metadata,omitempty"`
+	Uploads  int64 `json:"uploads,omitempty"`
+	Purged   int64 `json:"purged,omitempty"`
 }
 
 // HealItemType - specify the type of heal operation in a healing
@@ -137,6 +348,11 @@ type HealResultItem struct {
 		Drives []HealDriveInfo `json:"drives"`
 	} `json:"after"`
 	ObjectSize int64 `json:"objectSize"`
+
+	// Kind of dangling entry, one of HealDanglingVersion,
+	// HealDanglingMetadata or HealDanglingUpload, empty if
+	// the item is not dangling.
+	Dangling string `json:"dangling,omitempty"`
 }
 
 // GetMissingCounts - returns the number of missing disks before
@@ -301,6 +517,225 @@ type BgHealState struct {
 	LastHealActivity  time.Time
 	NextHealRound     time.Time
 	HealDisks         []string
+
+	// Progress of healing each fresh drive, keyed by drive endpoint.
+	DiskHealProgress map[string]HealDriveProgress `json:"diskHealProgress,omitempty"`
+
+	// Progress of the erasure sets being healed.
+	SetHealProgress []SetHealProgress `json:"setHealProgress,omitempty"`
+
+	// Number of objects queued for a deep heal after being
+	// read from drives reporting a rising rate of read errors.
+	DiskErrorHealCount int64
+
+	// Number of object versions sampled for a deep scan.
+	SampledItemsCount int64 `json:"sampledItemsCount,omitempty"`
+
+	// Number of object versions not healed as a lifecycle
+	// rule expires them within the configured horizon.
+	ExpiringItemsCount int64 `json:"expiringItemsCount,omitempty"`
+
+	// Number of scanned and healed items per heal item type.
+	ScannedItemsByType map[HealItemType]int64 `json:"scannedItemsByType,omitempty"`
+	HealedItemsByType  map[HealItemType]int64 `json:"healedItemsByType,omitempty"`
+
+	// DryRun is true when background healing only reports the
+	// items which would be healed, WouldHealItemsByType holds
+	// their number per heal item type.
+	DryRun               bool                   `json:"dryRun,omitempty"`
+	WouldHealItemsByType map[HealItemType]int64 `json:"wouldHealItemsByType,omitempty"`
+
+	// Paused is true when background healing is paused.
+	Paused bool `json:"paused,omitempty"`
+
+	// Most recent objects which could not be healed, latest first.
+	HealFailedItems []HealFailedItem `json:"healFailedItems,omitempty"`
+
+	// Number of queued items which could not be healed
+	// before background healing was stopped.
+	InterruptedItemsCount int64 `json:"interruptedItemsCount,omitempty"`
+
+	// Final summary, set once background healing has stopped.
+	Summary *HealSummary `json:"summary,omitempty"`
+
+	// Node is the server reporting the heal status.
+	Node string `json:"node,omitempty"`
+
+	// Heal status of each server, only set on the
+	// status aggregated across the cluster.
+	Nodes []BgHealNodeState `json:"nodes,omitempty"`
+}
+
+// BgHealNodeState holds the background heal status of a single server.
+type BgHealNodeState struct {
+	Node              string    `json:"node"`
+	Error             string    `json:"error,omitempty"`
+	ScannedItemsCount int64     `json:"scannedItemsCount"`
+	HealedItemsCount  int64     `json:"healedItemsCount"`
+	FailedItemsCount  int64     `json:"failedItemsCount"`
+	LastHealActivity  time.Time `json:"lastHealActivity"`
+	HealDisks         []string  `json:"healDisks,omitempty"`
+	Paused            bool      `json:"paused,omitempty"`
+}
+
+// HealSummary holds the totals of a heal sequence once it has stopped.
+type HealSummary struct {
+	ScannedItemsCount     int64     `json:"scannedItemsCount"`
+	HealedItemsCount      int64     `json:"healedItemsCount"`
+	FailedItemsCount      int64     `json:"failedItemsCount"`
+	InterruptedItemsCount int64     `json:"interruptedItemsCount"`
+	Stopped               time.Time `json:"stopped"`
+}
+
+// HealDriveProgress holds the progress of healing a fresh drive.
+type HealDriveProgress struct {
+	Started        time.Time `json:"started"`
+	ObjectsScanned uint64    `json:"objectsScanned"`
+	ObjectsHealed  uint64    `json:"objectsHealed"`
+	// Estimated number of bytes written to the drive.
+	BytesHealed uint64 `json:"bytesHealed,omitempty"`
+	// Estimated number of objects left to scan, as found by the
+	// last data usage scan of the erasure set, 0 if unknown.
+	ObjectsRemaining uint64 `json:"objectsRemaining,omitempty"`
+	// Number of buckets completely healed on the drive.
+	BucketsHealed int `json:"bucketsHealed,omitempty"`
+}
+
+// SetHealProgress holds the progress of healing an erasure set.
+type SetHealProgress struct {
+	PoolIndex int       `json:"pool"`
+	SetIndex  int       `json:"set"`
+	Started   time.Time `json:"started"`
+	// Estimated number of objects in the erasure set,
+	// as found by the last data usage scan.
+	ObjectsTotal   uint64 `json:"objectsTotal"`
+	ObjectsScanned uint64 `json:"objectsScanned"`
+	ObjectsHealed  uint64 `json:"objectsHealed"`
+	// Rolling average of the objects scanned per second.
+	Throughput float64 `json:"throughput"`
+	// Estimated completion of healing the erasure set,
+	// zero while unknown.
+	ETA time.Time `json:"eta,omitempty"`
+}
+
+// HealFailedItem holds an object which background heal failed
+// to repair along with the reason of the last failed attempt.
+type HealFailedItem struct {
+	Bucket      string    `json:"bucket"`
+	Object      string    `json:"object,omitempty"`
+	VersionID   string    `json:"versionId,omitempty"`
+	Reason      string    `json:"reason"`
+	LastAttempt time.Time `json:"lastAttempt"`
+}
+
+// BgHealAction - type to restrict background heal action values
+type BgHealAction string
+
+const (
+	// BgHealActionPause pauses background healing
+	BgHealActionPause BgHealAction = "pause"
+	// BgHealActionResume resumes background healing
+	BgHealActionResume BgHealAction = "resume"
+)
+
+// PauseBackgroundHeal - pauses background healing on all servers,
+// objects already queued for heal are held until healing is resumed.
+func (adm *AdminClient) PauseBackgroundHeal(ctx context.Context) error {
+	return adm.backgroundHealCallAction(ctx, BgHealActionPause)
+}
+
+// ResumeBackgroundHeal - resumes background healing paused by PauseBackgroundHeal.
+func (adm *AdminClient) ResumeBackgroundHeal(ctx context.Context) error {
+	return adm.backgroundHealCallAction(ctx, BgHealActionResume)
+}
+
+func (adm *AdminClient) backgroundHealCallAction(ctx context.Context, action BgHealAction) error {
+	// Execute POST on /minio/admin/v3/background-heal/{action}
+	resp, err := adm.executeMethod(ctx,
+		http.MethodPost,
+		requestData{relPath: adminAPIPrefix + "/background-heal/" + string(action)})
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return httpRespToErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// HealThrottle holds the settings which throttle background healing,
+// they match the max_sleep, max_io, workers, max_iops and bucket_workers
+// keys of the heal config.
+type HealThrottle struct {
+	// Sleep is the maximum time waited for client requests to drop
+	// below IOCount before healing the next object.
+	Sleep   time.Duration `json:"sleep"`
+	IOCount int           `json:"ioCount"`
+	// Workers is the number of buckets healed concurrently on each
+	// server, 0 for the number of CPUs.
+	Workers int `json:"workers"`
+	// MaxIOPS is the maximum heal operations per second on each
+	// server, 0 for unlimited.
+	MaxIOPS int `json:"maxIOPS"`
+	// BucketWorkers is the number of buckets healed concurrently on
+	// each erasure set, 0 for the number of CPUs divided by the
+	// drives per set.
+	BucketWorkers int `json:"bucketWorkers"`
+}
+
+// BackgroundHealThrottle returns the settings which currently
+// throttle background healing.
+func (adm *AdminClient) BackgroundHealThrottle(ctx context.Context) (HealThrottle, error) {
+	// Execute GET on /minio/admin/v3/background-heal/throttle
+	resp, err := adm.executeMethod(ctx,
+		http.MethodGet,
+		requestData{relPath: adminAPIPrefix + "/background-heal/throttle"})
+	defer closeResponse(resp)
+	if err != nil {
+		return HealThrottle{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return HealThrottle{}, httpRespToErrorResponse(resp)
+	}
+
+	var throttle HealThrottle
+	if err = json.NewDecoder(resp.Body).Decode(&throttle); err != nil {
+		return HealThrottle{}, err
+	}
+	return throttle, nil
+}
+
+// SetBackgroundHealThrottle - changes the settings which throttle
+// background healing on all servers, heal rounds in progress are
+// throttled with the new settings right away. The settings are
+// saved to the heal config.
+func (adm *AdminClient) SetBackgroundHealThrottle(ctx context.Context, throttle HealThrottle) error {
+	data, err := json.Marshal(throttle)
+	if err != nil {
+		return err
+	}
+
+	// Execute PUT on /minio/admin/v3/background-heal/throttle
+	resp, err := adm.executeMethod(ctx,
+		http.MethodPut,
+		requestData{
+			relPath: adminAPIPrefix + "/background-heal/throttle",
+			content: data,
+		})
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return httpRespToErrorResponse(resp)
+	}
+
+	return nil
 }
 
 // BackgroundHealStatus returns the background heal status of the
@@ -332,3 +767,315 @@ func (adm *AdminClient) BackgroundHealStatus(ctx context.Context) (BgHealState,
 	}
 	return healState, nil
 }
+
+// HealReportItem is an item which a dry-run heal found in need of repair.
+type HealReportItem struct {
+	Node       string       `json:"node,omitempty"`
+	Type       HealItemType `json:"type"`
+	Bucket     string       `json:"bucket"`
+	Object     string       `json:"object,omitempty"`
+	VersionID  string       `json:"versionId,omitempty"`
+	ObjectSize int64        `json:"objectSize,omitempty"`
+	// Drives to be repaired with their state, either
+	// DriveStateMissing or DriveStateCorrupt.
+	Drives []HealDriveInfo `json:"drives"`
+}
+
+// HealReport lists the items found in need of repair by the current
+// dry-run heal round of background healing.
+type HealReport struct {
+	Started time.Time        `json:"started"`
+	Items   []HealReportItem `json:"items"`
+	// Truncated is true when items were left out of the report.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// BackgroundHealReport returns the items which background healing
+// would repair, when running in dry-run mode, across the cluster.
+func (adm *AdminClient) BackgroundHealReport(ctx context.Context) (HealReport, error) {
+	// Execute GET on /minio/admin/v3/background-heal/report
+	resp, err := adm.executeMethod(ctx,
+		http.MethodGet,
+		requestData{relPath: adminAPIPrefix + "/background-heal/report"})
+	defer closeResponse(resp)
+	if err != nil {
+		return HealReport{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return HealReport{}, httpRespToErrorResponse(resp)
+	}
+
+	var report HealReport
+	if err = json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		return HealReport{}, err
+	}
+	return report, nil
+}
+
+// Repair outcomes of a scrub report item
+const (
+	ScrubRepaired     = "repaired"
+	ScrubRepairFailed = "failed"
+	ScrubNotRepaired  = "not-repaired" // dry-run
+)
+
+// ScrubDriveInfo is a drive on which the scrubber found a shard of an
+// object version missing or failing its bitrot checksum.
+type ScrubDriveInfo struct {
+	Endpoint string `json:"endpoint"`
+	State    string `json:"state"`
+	// Detail describes the problem, such as a checksum mismatch
+	// along with the bitrot algorithm.
+	Detail string `json:"detail"`
+}
+
+// ScrubReportItem is an object version found damaged by the scrubber.
+type ScrubReportItem struct {
+	Bucket    string           `json:"bucket"`
+	Object    string           `json:"object"`
+	VersionID string           `json:"versionId,omitempty"`
+	PoolIndex int              `json:"poolIndex"`
+	SetIndex  int              `json:"setIndex"`
+	Drives    []ScrubDriveInfo `json:"drives"`
+	Outcome   string           `json:"outcome"`
+	Error     string           `json:"error,omitempty"`
+	Time      time.Time        `json:"time"`
+}
+
+// ScrubReport lists the object versions found damaged by a scrub cycle.
+type ScrubReport struct {
+	ID       string            `json:"id"`
+	Node     string            `json:"node,omitempty"`
+	Started  time.Time         `json:"started"`
+	Finished time.Time         `json:"finished"`
+	Scanned  int64             `json:"scanned"`
+	Items    []ScrubReportItem `json:"items"`
+	// Truncated is true when items were left out of the report.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// ScrubReportInfo summarizes a saved scrub report.
+type ScrubReportInfo struct {
+	ID       string    `json:"id"`
+	Started  time.Time `json:"started"`
+	Finished time.Time `json:"finished"`
+	Scanned  int64     `json:"scanned"`
+	Items    int       `json:"items"`
+}
+
+// ListScrubReports returns the saved scrub reports, oldest first.
+func (adm *AdminClient) ListScrubReports(ctx context.Context) ([]ScrubReportInfo, error) {
+	// Execute GET on /minio/admin/v3/background-heal/scrub-reports
+	resp, err := adm.executeMethod(ctx,
+		http.MethodGet,
+		requestData{relPath: adminAPIPrefix + "/background-heal/scrub-reports"})
+	defer closeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+
+	var reports []ScrubReportInfo
+	if err = json.NewDecoder(resp.Body).Decode(&reports); err != nil {
+		return nil, err
+	}
+	return reports, nil
+}
+
+// DownloadScrubReport returns the JSON encoded scrub report with the
+// given id, the caller must close the returned reader.
+func (adm *AdminClient) DownloadScrubReport(ctx context.Context, id string) (io.ReadCloser, error) {
+	queryValues := url.Values{}
+	queryValues.Set("id", id)
+
+	// Execute GET on /minio/admin/v3/background-heal/scrub-report
+	resp, err := adm.executeMethod(ctx,
+		http.MethodGet,
+		requestData{
+			relPath:     adminAPIPrefix + "/background-heal/scrub-report",
+			queryValues: queryValues,
+		})
+	if err != nil {
+		closeResponse(resp)
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer closeResponse(resp)
+		return nil, httpRespToErrorResponse(resp)
+	}
+	return resp.Body, nil
+}
+
+// ScrubReport returns the scrub report with the given id.
+func (adm *AdminClient) ScrubReport(ctx context.Context, id string) (ScrubReport, error) {
+	body, err := adm.DownloadScrubReport(ctx, id)
+	if err != nil {
+		return ScrubReport{}, err
+	}
+	defer body.Close()
+
+	var report ScrubReport
+	if err = json.NewDecoder(body).Decode(&report); err != nil {
+		return ScrubReport{}, err
+	}
+	return report, nil
+}
+
+// HealDeadLetterItem is an object which background healing could
+// not heal after all retries.
+type HealDeadLetterItem struct {
+	Node      string `json:"node,omitempty"`
+	Bucket    string `json:"bucket"`
+	Object    string `json:"object,omitempty"`
+	VersionID string `json:"versionId,omitempty"`
+	PoolIndex int    `json:"poolIndex"`
+	SetIndex  int    `json:"setIndex"`
+	// Attempts is the number of failed attempts to heal the object.
+	Attempts int `json:"attempts"`
+	// Reason is the error of the last failed attempt.
+	Reason       string    `json:"reason"`
+	FirstFailure time.Time `json:"firstFailure"`
+	LastAttempt  time.Time `json:"lastAttempt"`
+}
+
+// BackgroundHealDeadLetters returns the objects which background
+// healing could not heal after all retries across the cluster,
+// latest first.
+func (adm *AdminClient) BackgroundHealDeadLetters(ctx context.Context) ([]HealDeadLetterItem, error) {
+	// Execute GET on /minio/admin/v3/background-heal/dead-letters
+	resp, err := adm.executeMethod(ctx,
+		http.MethodGet,
+		requestData{relPath: adminAPIPrefix + "/background-heal/dead-letters"})
+	defer closeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+
+	var items []HealDeadLetterItem
+	if err = json.NewDecoder(resp.Body).Decode(&items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// HealQueueItem is an item queued for background healing.
+type HealQueueItem struct {
+	Node      string       `json:"node,omitempty"`
+	Bucket    string       `json:"bucket"`
+	Object    string       `json:"object,omitempty"`
+	VersionID string       `json:"versionId,omitempty"`
+	ScanMode  HealScanMode `json:"scanMode"`
+	// Source is what queued the item, one of "api" for HealObjects,
+	// "mrf" for partially written objects, "read" for objects found
+	// degraded while being read and "scanner" for objects selected
+	// for a heal check by the data scanner.
+	Source string    `json:"source"`
+	Queued time.Time `json:"queued"`
+	Err    error     `json:"-"`
+}
+
+// BackgroundHealQueue streams the items currently queued for
+// background healing on all servers.
+func (adm *AdminClient) BackgroundHealQueue(ctx context.Context) <-chan HealQueueItem {
+	itemCh := make(chan HealQueueItem, 1)
+	go func(itemCh chan<- HealQueueItem) {
+		defer close(itemCh)
+
+		// Execute GET on /minio/admin/v3/background-heal/queue
+		resp, err := adm.executeMethod(ctx,
+			http.MethodGet,
+			requestData{relPath: adminAPIPrefix + "/background-heal/queue"})
+		defer closeResponse(resp)
+		if err != nil {
+			itemCh <- HealQueueItem{Err: err}
+			return
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			itemCh <- HealQueueItem{Err: httpRespToErrorResponse(resp)}
+			return
+		}
+
+		dec := json.NewDecoder(resp.Body)
+		for {
+			var item HealQueueItem
+			if err = dec.Decode(&item); err != nil {
+				if err != io.EOF {
+					itemCh <- HealQueueItem{Err: err}
+				}
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case itemCh <- item:
+			}
+		}
+	}(itemCh)
+
+	return itemCh
+}
+
+// HealObjectsItem is an object version to be healed by HealObjects.
+type HealObjectsItem struct {
+	Bucket    string `json:"bucket"`
+	Object    string `json:"object"`
+	VersionID string `json:"versionId,omitempty"`
+}
+
+// HealObjectsStatus is the outcome of healing an object version.
+type HealObjectsStatus string
+
+// HealObjectsStatus values
+const (
+	HealObjectsHealed   HealObjectsStatus = "healed"
+	HealObjectsFailed   HealObjectsStatus = "failed"
+	HealObjectsNotFound HealObjectsStatus = "not-found"
+)
+
+// HealObjectsResult holds the outcome of healing an object version.
+type HealObjectsResult struct {
+	HealObjectsItem
+	Status HealObjectsStatus `json:"status"`
+	Detail string            `json:"detail,omitempty"`
+}
+
+// HealObjects heals the given object versions without crawling and
+// waits for all of them to be healed, the results are returned in
+// the order of the given items.
+func (adm *AdminClient) HealObjects(ctx context.Context, items []HealObjectsItem) ([]HealObjectsResult, error) {
+	body, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adm.executeMethod(ctx,
+		http.MethodPost,
+		requestData{
+			relPath: adminAPIPrefix + "/heal-objects",
+			content: body,
+		})
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponse(resp)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+
+	var results []HealObjectsResult
+	if err = json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
@@ -18,7 +18,9 @@
 package madmin
 
 import (
+	"fmt"
 	"testing"
+	"time"
 )
 
 // Tests heal drives missing and offline counts.
@@ -71,3 +73,127 @@ func TestHealDriveCounts(t *testing.T) {
 		t.Errorf("Expected '4', got %d after missing disks", i)
 	}
 }
+
+// Tests heal include and exclude prefixes.
+func TestHealOptsMatchesPrefixes(t *testing.T) {
+	testCases := []struct {
+		opts     HealOpts
+		object   string
+		expected bool
+	}{
+		{HealOpts{}, "tmp/a", true},
+		{HealOpts{ExcludePrefixes: []string{"tmp/"}}, "tmp/a", false},
+		{HealOpts{ExcludePrefixes: []string{"tmp/"}}, "archive/a", true},
+		{HealOpts{IncludePrefixes: []string{"archive/"}}, "archive/a", true},
+		{HealOpts{IncludePrefixes: []string{"archive/"}}, "tmp/a", false},
+		{HealOpts{IncludePrefixes: []string{"archive/"}, ExcludePrefixes: []string{"archive/old/"}}, "archive/old/a", false},
+		{HealOpts{ExcludePrefixes: []string{"*/tmp/*"}}, "2021/tmp/a", false},
+		{HealOpts{ExcludePrefixes: []string{"*/tmp/*"}}, "tmp/a", true},
+		{HealOpts{IncludePrefixes: []string{"logs/*.gz"}}, "logs/a.gz", true},
+		{HealOpts{IncludePrefixes: []string{"logs/*.gz"}}, "logs/a.txt", false},
+		{HealOpts{IncludePrefixes: []string{"logs/?.gz"}}, "logs/ab.gz", false},
+	}
+	for i, testCase := range testCases {
+		if got := testCase.opts.MatchesPrefixes(testCase.object); got != testCase.expected {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.expected, got)
+		}
+	}
+}
+
+// Tests heal include and exclude tags.
+func TestHealOptsMatchesTags(t *testing.T) {
+	testCases := []struct {
+		opts     HealOpts
+		tags     map[string]string
+		expected bool
+	}{
+		{HealOpts{}, nil, true},
+		{HealOpts{ExcludeTags: map[string]string{"tier": "tmp"}}, map[string]string{"tier": "tmp"}, false},
+		{HealOpts{ExcludeTags: map[string]string{"tier": "tmp"}}, map[string]string{"tier": "gold"}, true},
+		{HealOpts{ExcludeTags: map[string]string{"tier": "tmp"}}, nil, true},
+		{HealOpts{IncludeTags: map[string]string{"tier": "gold"}}, map[string]string{"tier": "gold", "app": "x"}, true},
+		{HealOpts{IncludeTags: map[string]string{"tier": "gold"}}, map[string]string{"app": "x"}, false},
+		{HealOpts{IncludeTags: map[string]string{"tier": "gold", "app": "x"}}, map[string]string{"tier": "gold"}, false},
+	}
+	for i, testCase := range testCases {
+		if got := testCase.opts.MatchesTags(testCase.tags); got != testCase.expected {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.expected, got)
+		}
+	}
+}
+
+// Tests heal pool and erasure set targeting.
+func TestHealOptsMatchesSet(t *testing.T) {
+	index := func(i int) *int { return &i }
+	testCases := []struct {
+		opts      HealOpts
+		poolIndex int
+		setIndex  int
+		expected  bool
+	}{
+		{HealOpts{}, 1, 3, true},
+		{HealOpts{Set: index(3)}, 0, 3, true},
+		{HealOpts{Set: index(3)}, 1, 3, true},
+		{HealOpts{Set: index(3)}, 0, 2, false},
+		{HealOpts{Pool: index(1)}, 1, 0, true},
+		{HealOpts{Pool: index(1)}, 0, 0, false},
+		{HealOpts{Pool: index(1), Set: index(3)}, 1, 3, true},
+		{HealOpts{Pool: index(1), Set: index(3)}, 0, 3, false},
+	}
+	for i, testCase := range testCases {
+		if got := testCase.opts.MatchesSet(testCase.poolIndex, testCase.setIndex); got != testCase.expected {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.expected, got)
+		}
+	}
+	if (HealOpts{Set: index(3)}).Equal(HealOpts{Set: index(2)}) || !(HealOpts{Set: index(3)}).Equal(HealOpts{Set: index(3)}) {
+		t.Error("Expected heal options to be compared by set index")
+	}
+}
+
+func TestHealOptsMatchesModTime(t *testing.T) {
+	start := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	testCases := []struct {
+		opts     HealOpts
+		modTime  time.Time
+		expected bool
+	}{
+		{HealOpts{}, start, true},
+		{HealOpts{ModifiedAfter: start}, start, false},
+		{HealOpts{ModifiedAfter: start}, start.Add(time.Minute), true},
+		{HealOpts{ModifiedBefore: end}, end, false},
+		{HealOpts{ModifiedBefore: end}, start, true},
+		{HealOpts{ModifiedAfter: start, ModifiedBefore: end}, start.Add(time.Minute), true},
+		{HealOpts{ModifiedAfter: start, ModifiedBefore: end}, end.Add(time.Minute), false},
+	}
+	for i, testCase := range testCases {
+		if got := testCase.opts.MatchesModTime(testCase.modTime); got != testCase.expected {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.expected, got)
+		}
+	}
+}
+
+func TestHealOptsSampled(t *testing.T) {
+	if (HealOpts{}).Sampled("object", "") {
+		t.Fatal("expected no object to be sampled without a sample rate")
+	}
+	if !(HealOpts{SampleRate: 1}).Sampled("object", "") {
+		t.Fatal("expected all objects to be sampled with a sample rate of 1")
+	}
+
+	opts := HealOpts{SampleRate: 0.1}
+	sampled := 0
+	for i := 0; i < 10000; i++ {
+		object := fmt.Sprintf("prefix/object-%d", i)
+		s := opts.Sampled(object, "")
+		if s != opts.Sampled(object, "") {
+			t.Fatalf("expected sampling of %s to be stable", object)
+		}
+		if s {
+			sampled++
+		}
+	}
+	if sampled < 800 || sampled > 1200 {
+		t.Fatalf("expected about 1000 sampled objects, got %d", sampled)
+	}
+}
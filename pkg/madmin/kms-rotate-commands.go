@@ -0,0 +1,148 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Key rotation status
+const (
+	KeyRotationStarted   = "Started"
+	KeyRotationCompleted = "Completed"
+	KeyRotationStopped   = "Stopped"
+	KeyRotationFailed    = "Failed"
+)
+
+// KeyRotationStatus holds the progress of the current or last key
+// rotation of the SSE-S3 encrypted objects of a bucket. KeyID is the
+// KMS master key the object keys are sealed with.
+type KeyRotationStatus struct {
+	ID         string    `json:"id"`
+	Bucket     string    `json:"bucket"`
+	Prefix     string    `json:"prefix,omitempty"`
+	KeyID      string    `json:"keyId"`
+	Status     string    `json:"status"`
+	StartedAt  time.Time `json:"startedAt"`
+	StoppedAt  time.Time `json:"stoppedAt,omitempty"`
+	LastUpdate time.Time `json:"lastUpdate"`
+	Scanned    uint64    `json:"scanned"`
+	Rotated    uint64    `json:"rotated"`
+	Skipped    uint64    `json:"skipped"`
+	Failed     uint64    `json:"failed"`
+	Object     string    `json:"object,omitempty"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// keyRotationCall starts or resumes a key rotation and returns its id.
+func (adm *AdminClient) keyRotationCall(ctx context.Context, relPath string, queryValues url.Values) (id string, err error) {
+	resp, err := adm.executeMethod(ctx,
+		http.MethodPost, requestData{
+			relPath:     adminAPIPrefix + relPath,
+			queryValues: queryValues,
+		},
+	)
+	defer closeResponse(resp)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", httpRespToErrorResponse(resp)
+	}
+
+	var rotateInfo struct {
+		ID string `json:"id"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&rotateInfo); err != nil {
+		return "", err
+	}
+	return rotateInfo.ID, nil
+}
+
+// StartKeyRotation - starts re-sealing the object keys of all SSE-S3
+// encrypted object versions of the bucket under prefix with the KMS
+// default master key, it returns the id of the key rotation.
+func (adm *AdminClient) StartKeyRotation(ctx context.Context, bucket, prefix string) (id string, err error) {
+	// Execute POST on /minio/admin/v3/kms/rotate/start?bucket=bucket&prefix=prefix
+	queryValues := url.Values{}
+	queryValues.Set("bucket", bucket)
+	queryValues.Set("prefix", prefix)
+	return adm.keyRotationCall(ctx, "/kms/rotate/start", queryValues)
+}
+
+// ResumeKeyRotation - resumes the last stopped or failed key rotation
+// from its last saved position, it returns the id of the key rotation.
+func (adm *AdminClient) ResumeKeyRotation(ctx context.Context) (id string, err error) {
+	// Execute POST on /minio/admin/v3/kms/rotate/resume
+	return adm.keyRotationCall(ctx, "/kms/rotate/resume", nil)
+}
+
+// StopKeyRotation - stops the running key rotation, it can be resumed
+// later with ResumeKeyRotation.
+func (adm *AdminClient) StopKeyRotation(ctx context.Context) error {
+	// Execute POST on /minio/admin/v3/kms/rotate/stop
+	resp, err := adm.executeMethod(ctx,
+		http.MethodPost, requestData{
+			relPath: adminAPIPrefix + "/kms/rotate/stop",
+		},
+	)
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return httpRespToErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// KeyRotationStatus - returns the progress of the current or last
+// key rotation.
+func (adm *AdminClient) KeyRotationStatus(ctx context.Context) (status KeyRotationStatus, err error) {
+	// Execute GET on /minio/admin/v3/kms/rotate/status
+	resp, err := adm.executeMethod(ctx,
+		http.MethodGet, requestData{
+			relPath: adminAPIPrefix + "/kms/rotate/status",
+		},
+	)
+	defer closeResponse(resp)
+	if err != nil {
+		return status, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return status, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return status, err
+	}
+	if err = json.Unmarshal(b, &status); err != nil {
+		return status, err
+	}
+
+	return status, nil
+}
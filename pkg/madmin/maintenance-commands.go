@@ -0,0 +1,136 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Background subsystems paused in maintenance mode.
+const (
+	MaintenanceHeal        = "heal"
+	MaintenanceScanner     = "scanner"
+	MaintenanceLifecycle   = "lifecycle"
+	MaintenanceReplication = "replication"
+	MaintenanceRebalance   = "rebalance"
+)
+
+// MaintenanceSubsystems lists all background subsystems
+// paused in maintenance mode.
+var MaintenanceSubsystems = []string{
+	MaintenanceHeal,
+	MaintenanceScanner,
+	MaintenanceLifecycle,
+	MaintenanceReplication,
+	MaintenanceRebalance,
+}
+
+// MaintenanceAction - type to restrict maintenance-action values
+type MaintenanceAction string
+
+const (
+	// MaintenanceActionStart pauses all background subsystems
+	MaintenanceActionStart MaintenanceAction = "start"
+	// MaintenanceActionStop resumes all background subsystems
+	MaintenanceActionStop MaintenanceAction = "stop"
+)
+
+// ServerMaintenanceStatus holds the paused background subsystems of a server.
+type ServerMaintenanceStatus struct {
+	Endpoint string `json:"endpoint"`
+	// Enabled is true when maintenance mode is active on this server.
+	Enabled bool      `json:"enabled"`
+	Since   time.Time `json:"since,omitempty"`
+	// Paused lists the background subsystems currently paused.
+	Paused []string `json:"paused,omitempty"`
+	Err    string   `json:"error,omitempty"`
+}
+
+// MaintenanceStatus holds the maintenance mode status of the cluster.
+type MaintenanceStatus struct {
+	// Enabled is true when maintenance mode is active on all servers.
+	Enabled bool                      `json:"enabled"`
+	Servers []ServerMaintenanceStatus `json:"servers"`
+}
+
+// StartMaintenance - pauses heal, scanner, lifecycle, replication and
+// rebalance on all servers, S3 requests continue to be served.
+func (adm *AdminClient) StartMaintenance(ctx context.Context) error {
+	return adm.maintenanceCallAction(ctx, MaintenanceActionStart)
+}
+
+// StopMaintenance - resumes all background subsystems paused by StartMaintenance.
+func (adm *AdminClient) StopMaintenance(ctx context.Context) error {
+	return adm.maintenanceCallAction(ctx, MaintenanceActionStop)
+}
+
+func (adm *AdminClient) maintenanceCallAction(ctx context.Context, action MaintenanceAction) error {
+	queryValues := url.Values{}
+	queryValues.Set("action", string(action))
+
+	// Execute POST on /minio/admin/v3/maintenance
+	resp, err := adm.executeMethod(ctx,
+		http.MethodPost, requestData{
+			relPath:     adminAPIPrefix + "/maintenance",
+			queryValues: queryValues,
+		},
+	)
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return httpRespToErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// GetMaintenanceStatus - returns the maintenance mode status of all servers.
+func (adm *AdminClient) GetMaintenanceStatus(ctx context.Context) (status MaintenanceStatus, err error) {
+	// Execute GET on /minio/admin/v3/maintenance/status
+	resp, err := adm.executeMethod(ctx,
+		http.MethodGet, requestData{
+			relPath: adminAPIPrefix + "/maintenance/status",
+		},
+	)
+	defer closeResponse(resp)
+	if err != nil {
+		return status, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return status, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return status, err
+	}
+	if err = json.Unmarshal(b, &status); err != nil {
+		return status, err
+	}
+
+	return status, nil
+}
@@ -0,0 +1,142 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// Rebalance status of a pool
+const (
+	RebalanceStarted   = "Started"
+	RebalanceCompleted = "Completed"
+	RebalanceStopped   = "Stopped"
+	RebalanceFailed    = "Failed"
+)
+
+// RebalancePoolProgress holds the objects moved away from a pool.
+type RebalancePoolProgress struct {
+	NumObjects  uint64        `json:"objects"`
+	NumVersions uint64        `json:"versions"`
+	Bytes       uint64        `json:"bytes"`
+	Bucket      string        `json:"bucket,omitempty"`
+	Object      string        `json:"object,omitempty"`
+	Elapsed     time.Duration `json:"elapsed"`
+}
+
+// RebalancePoolStatus holds the rebalance status of a pool, Status
+// is empty if the pool receives objects instead of moving them.
+type RebalancePoolStatus struct {
+	ID       int                   `json:"id"`
+	Status   string                `json:"status,omitempty"`
+	Used     float64               `json:"used"` // fraction of the pool capacity used
+	Progress RebalancePoolProgress `json:"progress,omitempty"`
+	Error    string                `json:"error,omitempty"`
+}
+
+// RebalanceStatus holds the status of the current or last rebalance
+// of the pools, Goal is the fraction of capacity every pool moves
+// objects away to reach.
+type RebalanceStatus struct {
+	ID        string                `json:"id"`
+	StartedAt time.Time             `json:"startedAt"`
+	StoppedAt time.Time             `json:"stoppedAt,omitempty"`
+	Goal      float64               `json:"goal"`
+	Pools     []RebalancePoolStatus `json:"pools"`
+}
+
+// RebalanceStart - starts moving objects from the fullest pools to
+// the other pools until all pools are evenly used, it returns the
+// id of the rebalance.
+func (adm *AdminClient) RebalanceStart(ctx context.Context) (id string, err error) {
+	// Execute POST on /minio/admin/v3/rebalance/start
+	resp, err := adm.executeMethod(ctx,
+		http.MethodPost, requestData{
+			relPath: adminAPIPrefix + "/rebalance/start",
+		},
+	)
+	defer closeResponse(resp)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", httpRespToErrorResponse(resp)
+	}
+
+	var rebalInfo struct {
+		ID string `json:"id"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&rebalInfo); err != nil {
+		return "", err
+	}
+	return rebalInfo.ID, nil
+}
+
+// RebalanceStop - stops the running rebalance on all servers, objects
+// already moved stay in their new pool.
+func (adm *AdminClient) RebalanceStop(ctx context.Context) error {
+	// Execute POST on /minio/admin/v3/rebalance/stop
+	resp, err := adm.executeMethod(ctx,
+		http.MethodPost, requestData{
+			relPath: adminAPIPrefix + "/rebalance/stop",
+		},
+	)
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return httpRespToErrorResponse(resp)
+	}
+
+	return nil
+}
+
+// RebalanceStatus - returns the status of the current or last rebalance
+// with the progress of every pool.
+func (adm *AdminClient) RebalanceStatus(ctx context.Context) (status RebalanceStatus, err error) {
+	// Execute GET on /minio/admin/v3/rebalance/status
+	resp, err := adm.executeMethod(ctx,
+		http.MethodGet, requestData{
+			relPath: adminAPIPrefix + "/rebalance/status",
+		},
+	)
+	defer closeResponse(resp)
+	if err != nil {
+		return status, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return status, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return status, err
+	}
+	if err = json.Unmarshal(b, &status); err != nil {
+		return status, err
+	}
+
+	return status, nil
+}
@@ -0,0 +1,125 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Replication resync status of a bucket
+const (
+	ReplicationResyncStarted   = "Started"
+	ReplicationResyncCompleted = "Completed"
+	ReplicationResyncStopped   = "Stopped"
+	ReplicationResyncFailed    = "Failed"
+)
+
+// ReplicationResyncFailure is an object version which could not be
+// replicated by a resync.
+type ReplicationResyncFailure struct {
+	Object    string `json:"object"`
+	VersionID string `json:"versionId,omitempty"`
+	Error     string `json:"error"`
+}
+
+// ReplicationResyncStatus holds the progress of the current or last
+// replication resync of a bucket, FailedObjects holds up to the first
+// 1000 object versions which could not be replicated.
+type ReplicationResyncStatus struct {
+	ID            string                     `json:"id"`
+	Bucket        string                     `json:"bucket"`
+	Status        string                     `json:"status"`
+	StartedAt     time.Time                  `json:"startedAt"`
+	StoppedAt     time.Time                  `json:"stoppedAt,omitempty"`
+	LastUpdate    time.Time                  `json:"lastUpdate"`
+	Scanned       uint64                     `json:"scanned"`
+	Queued        uint64                     `json:"queued"`
+	Replicated    uint64                     `json:"replicated"`
+	Failed        uint64                     `json:"failed"`
+	FailedObjects []ReplicationResyncFailure `json:"failedObjects,omitempty"`
+	Error         string                     `json:"error,omitempty"`
+}
+
+// ResyncBucketReplication - starts queueing all existing object versions
+// of the bucket for replication to its replication target, it returns
+// the id of the resync.
+func (adm *AdminClient) ResyncBucketReplication(ctx context.Context, bucket string) (id string, err error) {
+	queryValues := url.Values{}
+	queryValues.Set("bucket", bucket)
+
+	// Execute POST on /minio/admin/v3/replication/resync?bucket=bucket
+	resp, err := adm.executeMethod(ctx,
+		http.MethodPost, requestData{
+			relPath:     adminAPIPrefix + "/replication/resync",
+			queryValues: queryValues,
+		},
+	)
+	defer closeResponse(resp)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", httpRespToErrorResponse(resp)
+	}
+
+	var resyncInfo struct {
+		ID string `json:"id"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&resyncInfo); err != nil {
+		return "", err
+	}
+	return resyncInfo.ID, nil
+}
+
+// BucketReplicationResyncStatus - returns the progress of the current
+// or last replication resync of the bucket.
+func (adm *AdminClient) BucketReplicationResyncStatus(ctx context.Context, bucket string) (status ReplicationResyncStatus, err error) {
+	queryValues := url.Values{}
+	queryValues.Set("bucket", bucket)
+
+	// Execute GET on /minio/admin/v3/replication/resync/status?bucket=bucket
+	resp, err := adm.executeMethod(ctx,
+		http.MethodGet, requestData{
+			relPath:     adminAPIPrefix + "/replication/resync/status",
+			queryValues: queryValues,
+		},
+	)
+	defer closeResponse(resp)
+	if err != nil {
+		return status, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return status, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return status, err
+	}
+	if err = json.Unmarshal(b, &status); err != nil {
+		return status, err
+	}
+
+	return status, nil
+}
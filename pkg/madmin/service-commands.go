@@ -77,16 +77,38 @@ type ServiceTraceInfo struct {
 	Err   error `json:"-"`
 }
 
+// ServiceTraceOpts holds tracing options
+type ServiceTraceOpts struct {
+	S3         bool // Trace S3 API calls
+	Internal   bool // Trace internal (node to node) calls
+	Storage    bool // Trace storage layer calls
+	OS         bool // Trace OS calls made by the storage layer
+	OnlyErrors bool // Only report calls that failed
+}
+
 // ServiceTrace - listen on http trace notifications.
 func (adm AdminClient) ServiceTrace(ctx context.Context, allTrace, errTrace bool) <-chan ServiceTraceInfo {
+	return adm.ServiceTraceWithOpts(ctx, ServiceTraceOpts{
+		S3:         true,
+		Internal:   allTrace,
+		OnlyErrors: errTrace,
+	})
+}
+
+// ServiceTraceWithOpts - listen on trace notifications of the
+// categories selected in opts.
+func (adm AdminClient) ServiceTraceWithOpts(ctx context.Context, opts ServiceTraceOpts) <-chan ServiceTraceInfo {
 	traceInfoCh := make(chan ServiceTraceInfo)
 	// Only success, start a routine to start reading line by line.
 	go func(traceInfoCh chan<- ServiceTraceInfo) {
 		defer close(traceInfoCh)
 		for {
 			urlValues := make(url.Values)
-			urlValues.Set("all", strconv.FormatBool(allTrace))
-			urlValues.Set("err", strconv.FormatBool(errTrace))
+			urlValues.Set("s3", strconv.FormatBool(opts.S3))
+			urlValues.Set("all", strconv.FormatBool(opts.Internal))
+			urlValues.Set("storage", strconv.FormatBool(opts.Storage))
+			urlValues.Set("os", strconv.FormatBool(opts.OS))
+			urlValues.Set("err", strconv.FormatBool(opts.OnlyErrors))
 			reqData := requestData{
 				relPath:     adminAPIPrefix + "/trace",
 				queryValues: urlValues,
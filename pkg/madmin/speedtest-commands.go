@@ -0,0 +1,104 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// SpeedtestOpts holds the options of a speedtest.
+type SpeedtestOpts struct {
+	// Size is the number of bytes written to and read back from
+	// each drive, defaults to 256MiB.
+	Size int64
+	// BlockSize is the size of each write and read, defaults to 4MiB.
+	BlockSize int64
+	// Drives runs the drive speedtest.
+	Drives bool
+	// Net runs the network speedtest between all servers.
+	Net bool
+}
+
+// DriveSpeedtestResult holds the throughput of a drive, in bytes per
+// second, measured with direct IO.
+type DriveSpeedtestResult struct {
+	Path            string `json:"path"`
+	ReadThroughput  uint64 `json:"readThroughput"`
+	WriteThroughput uint64 `json:"writeThroughput"`
+	Error           string `json:"error,omitempty"`
+}
+
+// ServerDrivesSpeedtest holds the drive speedtest results of a server,
+// measured one drive at a time and on all drives in parallel.
+type ServerDrivesSpeedtest struct {
+	Addr     string                 `json:"addr"`
+	Serial   []DriveSpeedtestResult `json:"serial,omitempty"`
+	Parallel []DriveSpeedtestResult `json:"parallel,omitempty"`
+	Error    string                 `json:"error,omitempty"`
+}
+
+// SpeedtestResult holds the results of a speedtest.
+type SpeedtestResult struct {
+	Drives []ServerDrivesSpeedtest `json:"drives,omitempty"`
+	Net    []ServerNetHealthInfo   `json:"net,omitempty"`
+}
+
+// Speedtest - runs a drive and network speedtest on all servers, if
+// neither opts.Drives nor opts.Net is set both are run. The test puts
+// load on the cluster and can take minutes on large setups.
+func (adm *AdminClient) Speedtest(ctx context.Context, opts SpeedtestOpts) (SpeedtestResult, error) {
+	queryValues := url.Values{}
+	if opts.Size > 0 {
+		queryValues.Set("size", strconv.FormatInt(opts.Size, 10))
+	}
+	if opts.BlockSize > 0 {
+		queryValues.Set("blocksize", strconv.FormatInt(opts.BlockSize, 10))
+	}
+	if opts.Drives || !opts.Net {
+		queryValues.Set("drive", "true")
+	}
+	if opts.Net || !opts.Drives {
+		queryValues.Set("net", "true")
+	}
+
+	// Execute POST on /minio/admin/v3/speedtest
+	resp, err := adm.executeMethod(ctx,
+		http.MethodPost, requestData{
+			relPath:     adminAPIPrefix + "/speedtest",
+			queryValues: queryValues,
+		},
+	)
+	defer closeResponse(resp)
+	if err != nil {
+		return SpeedtestResult{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return SpeedtestResult{}, httpRespToErrorResponse(resp)
+	}
+
+	var result SpeedtestResult
+	if err = json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return SpeedtestResult{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,97 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// BucketStorageClass holds the default storage class of a bucket,
+// applied to new objects uploaded without an explicit storage class.
+type BucketStorageClass struct {
+	StorageClass string `json:"storageClass"`
+}
+
+// GetBucketStorageClass - get the default storage class of a bucket
+func (adm *AdminClient) GetBucketStorageClass(ctx context.Context, bucket string) (sc BucketStorageClass, err error) {
+	queryValues := url.Values{}
+	queryValues.Set("bucket", bucket)
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/get-bucket-storage-class",
+		queryValues: queryValues,
+	}
+
+	// Execute GET on /minio/admin/v3/get-bucket-storage-class
+	resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
+
+	defer closeResponse(resp)
+	if err != nil {
+		return sc, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return sc, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return sc, err
+	}
+	if err = json.Unmarshal(b, &sc); err != nil {
+		return sc, err
+	}
+
+	return sc, nil
+}
+
+// SetBucketStorageClass - sets the default storage class of a bucket,
+// an empty storage class removes the bucket default.
+func (adm *AdminClient) SetBucketStorageClass(ctx context.Context, bucket string, sc *BucketStorageClass) error {
+	data, err := json.Marshal(sc)
+	if err != nil {
+		return err
+	}
+
+	queryValues := url.Values{}
+	queryValues.Set("bucket", bucket)
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/set-bucket-storage-class",
+		queryValues: queryValues,
+		content:     data,
+	}
+
+	// Execute PUT on /minio/admin/v3/set-bucket-storage-class to set default storage class for a bucket.
+	resp, err := adm.executeMethod(ctx, http.MethodPut, reqData)
+
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return httpRespToErrorResponse(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+)
+
+// TierConfig is a remote S3 compatible bucket which lifecycle rules of
+// any bucket transition objects to, by naming the tier as the storage
+// class of their transition. Objects are stored below Prefix in the
+// remote bucket.
+type TierConfig struct {
+	Name      string `json:"name"`
+	Endpoint  string `json:"endpoint"`
+	Secure    bool   `json:"secure"`
+	AccessKey string `json:"accessKey"`
+	SecretKey string `json:"secretKey,omitempty"`
+	Bucket    string `json:"bucket"`
+	Prefix    string `json:"prefix,omitempty"`
+	Region    string `json:"region,omitempty"`
+}
+
+// AddTier adds a remote tier, the tier credentials are sent encrypted.
+func (adm *AdminClient) AddTier(ctx context.Context, cfg TierConfig) error {
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+	encData, err := EncryptData(adm.getSecretKey(), data)
+	if err != nil {
+		return err
+	}
+
+	reqData := requestData{
+		relPath: adminAPIPrefix + "/tier",
+		content: encData,
+	}
+
+	// Execute PUT on /minio/admin/v3/tier to add a remote tier.
+	resp, err := adm.executeMethod(ctx, http.MethodPut, reqData)
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return httpRespToErrorResponse(resp)
+	}
+	return nil
+}
+
+// ListTiers returns the remote tiers, without their secret keys.
+func (adm *AdminClient) ListTiers(ctx context.Context) ([]TierConfig, error) {
+	reqData := requestData{
+		relPath: adminAPIPrefix + "/tier",
+	}
+
+	// Execute GET on /minio/admin/v3/tier to list remote tiers.
+	resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
+	defer closeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var tiers []TierConfig
+	if err = json.Unmarshal(b, &tiers); err != nil {
+		return nil, err
+	}
+	return tiers, nil
+}
@@ -32,15 +32,18 @@ import (
 // servers holding the lock, source on the client machine,
 // ID, type(read or write) and time stamp.
 type LockEntry struct {
-	Timestamp  time.Time `json:"time"`       // When the lock was first granted
-	Resource   string    `json:"resource"`   // Resource contains info like bucket+object
-	Type       string    `json:"type"`       // Type indicates if 'Write' or 'Read' lock
-	Source     string    `json:"source"`     // Source at which lock was granted
-	ServerList []string  `json:"serverlist"` // List of servers participating in the lock.
-	Owner      string    `json:"owner"`      // Owner UUID indicates server owns the lock.
-	ID         string    `json:"id"`         // UID to uniquely identify request of client.
+	Timestamp  time.Time     `json:"time"`       // When the lock was first granted
+	Elapsed    time.Duration `json:"elapsed"`    // Time since the lock was granted, or requested if waiting
+	Resource   string        `json:"resource"`   // Resource contains info like bucket+object
+	Type       string        `json:"type"`       // Type indicates if 'Write' or 'Read' lock
+	Source     string        `json:"source"`     // Source at which lock was granted
+	ServerList []string      `json:"serverlist"` // List of servers participating in the lock.
+	Owner      string        `json:"owner"`      // Owner UUID indicates server owns the lock.
+	ID         string        `json:"id"`         // UID to uniquely identify request of client.
 	// Represents quorum number of servers required to hold this lock, used to look for stale locks.
 	Quorum int `json:"quorum"`
+	// Waiting is set if the lock was requested but is not granted yet.
+	Waiting bool `json:"waiting,omitempty"`
 }
 
 // LockEntries - To sort the locks
@@ -62,13 +65,39 @@ func (l LockEntries) Swap(i, j int) {
 type TopLockOpts struct {
 	Count int
 	Stale bool
+	// Waiting also lists lock requests which are not granted yet.
+	Waiting bool
+	// OlderThan lists only locks granted, or requested, longer ago.
+	OlderThan time.Duration
 }
 
-// ForceUnlock force unlocks input paths...
+// ForceUnlockOpts force unlock options
+type ForceUnlockOpts struct {
+	Paths []string
+	// OlderThan refuses to unlock paths locked more recently,
+	// the server defaults to one minute if not set.
+	OlderThan time.Duration
+	// Force unlocks the paths regardless of the age of their locks.
+	Force bool
+}
+
+// ForceUnlock force unlocks input paths, the server refuses to unlock
+// paths locked less than a minute ago.
 func (adm *AdminClient) ForceUnlock(ctx context.Context, paths ...string) error {
+	return adm.ForceUnlockWithOpts(ctx, ForceUnlockOpts{Paths: paths})
+}
+
+// ForceUnlockWithOpts force unlocks the paths in opts, if all of them
+// have been locked for at least opts.OlderThan.
+func (adm *AdminClient) ForceUnlockWithOpts(ctx context.Context, opts ForceUnlockOpts) error {
 	// Execute POST on /minio/admin/v3/force-unlock
 	queryVals := make(url.Values)
-	queryVals.Set("paths", strings.Join(paths, ","))
+	queryVals.Set("paths", strings.Join(opts.Paths, ","))
+	if opts.Force {
+		queryVals.Set("older-than", "0s")
+	} else if opts.OlderThan > 0 {
+		queryVals.Set("older-than", opts.OlderThan.String())
+	}
 	resp, err := adm.executeMethod(ctx,
 		http.MethodPost,
 		requestData{
@@ -97,6 +126,12 @@ func (adm *AdminClient) TopLocksWithOpts(ctx context.Context, opts TopLockOpts)
 	queryVals := make(url.Values)
 	queryVals.Set("count", strconv.Itoa(opts.Count))
 	queryVals.Set("stale", strconv.FormatBool(opts.Stale))
+	if opts.Waiting {
+		queryVals.Set("waiting", "true")
+	}
+	if opts.OlderThan > 0 {
+		queryVals.Set("older-than", opts.OlderThan.String())
+	}
 	resp, err := adm.executeMethod(ctx,
 		http.MethodGet,
 		requestData{
@@ -268,7 +268,8 @@ func (adm *AdminClient) SetUserStatus(ctx context.Context, accessKey string, sta
 
 // AddServiceAccountReq is the request body of the add service account admin call
 type AddServiceAccountReq struct {
-	Policy *iampolicy.Policy `json:"policy,omitempty"`
+	Policy     *iampolicy.Policy `json:"policy,omitempty"`
+	TargetUser string            `json:"targetUser,omitempty"`
 }
 
 // AddServiceAccountResp is the response body of the add service account admin call
@@ -279,6 +280,13 @@ type AddServiceAccountResp struct {
 // AddServiceAccount - creates a new service account belonging to the user sending
 // the request while restricting the service account permission by the given policy document.
 func (adm *AdminClient) AddServiceAccount(ctx context.Context, policy *iampolicy.Policy) (auth.Credentials, error) {
+	return adm.AddServiceAccountForUser(ctx, "", policy)
+}
+
+// AddServiceAccountForUser - creates a new service account belonging to the target user
+// while restricting the service account permission by the given policy document. The
+// service account belongs to the user sending the request when targetUser is empty.
+func (adm *AdminClient) AddServiceAccountForUser(ctx context.Context, targetUser string, policy *iampolicy.Policy) (auth.Credentials, error) {
 	if policy != nil {
 		if err := policy.Validate(); err != nil {
 			return auth.Credentials{}, err
@@ -286,7 +294,8 @@ func (adm *AdminClient) AddServiceAccount(ctx context.Context, policy *iampolicy
 	}
 
 	data, err := json.Marshal(AddServiceAccountReq{
-		Policy: policy,
+		Policy:     policy,
+		TargetUser: targetUser,
 	})
 	if err != nil {
 		return auth.Credentials{}, err
@@ -330,10 +339,22 @@ type ListServiceAccountsResp struct {
 	Accounts []string `json:"accounts"`
 }
 
-// ListServiceAccounts - list service accounts belonging to the specified user
+// ListServiceAccounts - list service accounts belonging to the user sending the request
 func (adm *AdminClient) ListServiceAccounts(ctx context.Context) (ListServiceAccountsResp, error) {
+	return adm.ListServiceAccountsForUser(ctx, "")
+}
+
+// ListServiceAccountsForUser - list service accounts belonging to the specified user,
+// or to the user sending the request when user is empty
+func (adm *AdminClient) ListServiceAccountsForUser(ctx context.Context, user string) (ListServiceAccountsResp, error) {
+	queryValues := url.Values{}
+	if user != "" {
+		queryValues.Set("user", user)
+	}
+
 	reqData := requestData{
-		relPath: adminAPIPrefix + "/list-service-accounts",
+		relPath:     adminAPIPrefix + "/list-service-accounts",
+		queryValues: queryValues,
 	}
 
 	// Execute GET on /minio/admin/v3/list-service-accounts
@@ -359,6 +380,52 @@ func (adm *AdminClient) ListServiceAccounts(ctx context.Context) (ListServiceAcc
 	return listResp, nil
 }
 
+// InfoServiceAccountResp is the response body of the info service account call
+type InfoServiceAccountResp struct {
+	ParentUser    string        `json:"parentUser"`
+	AccountStatus AccountStatus `json:"accountStatus"`
+	ImpliedPolicy bool          `json:"impliedPolicy"`
+	Policy        string        `json:"policy,omitempty"`
+}
+
+// InfoServiceAccount - returns the parent user, the status and the inline policy of
+// a service account, the policy is empty when the parent's policies apply unchanged.
+func (adm *AdminClient) InfoServiceAccount(ctx context.Context, serviceAccount string) (InfoServiceAccountResp, error) {
+	if !auth.IsAccessKeyValid(serviceAccount) {
+		return InfoServiceAccountResp{}, auth.ErrInvalidAccessKeyLength
+	}
+
+	queryValues := url.Values{}
+	queryValues.Set("accessKey", serviceAccount)
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/info-service-account",
+		queryValues: queryValues,
+	}
+
+	// Execute GET on /minio/admin/v3/info-service-account
+	resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
+	defer closeResponse(resp)
+	if err != nil {
+		return InfoServiceAccountResp{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return InfoServiceAccountResp{}, httpRespToErrorResponse(resp)
+	}
+
+	data, err := DecryptData(adm.getSecretKey(), resp.Body)
+	if err != nil {
+		return InfoServiceAccountResp{}, err
+	}
+
+	var infoResp InfoServiceAccountResp
+	if err = json.Unmarshal(data, &infoResp); err != nil {
+		return InfoServiceAccountResp{}, err
+	}
+	return infoResp, nil
+}
+
 // DeleteServiceAccount - delete a specified service account. The server will reject
 // the request if the service account does not belong to the user initiating the request
 func (adm *AdminClient) DeleteServiceAccount(ctx context.Context, serviceAccount string) error {
@@ -40,7 +40,10 @@ func getColumns(
 		}
 		columnName := strings.Join(meta.GetPathInSchema(), ".")
 		if columnNames != nil && !columnNames.Contains(columnName) {
-			continue
+			// Nested columns are selected by their top level name.
+			if path := meta.GetPathInSchema(); len(path) < 2 || !columnNames.Contains(path[0]) {
+				continue
+			}
 		}
 
 		// Ignore column spanning into another file.
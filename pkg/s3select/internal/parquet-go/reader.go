@@ -88,10 +88,11 @@ type Reader struct {
 	rowGroups      []*parquet.RowGroup
 	rowGroupIndex  int
 
-	nameList    []string
-	columnNames set.StringSet
-	columns     map[string]*column
-	rowIndex    int64
+	nameList     []string
+	columnNames  set.StringSet
+	columns      map[string]*column
+	rowIndex     int64
+	skipRowGroup func(rowGroup *parquet.RowGroup) bool
 }
 
 // NewReader - creates new parquet reader. Reader calls getReaderFunc to get required data range for given columnNames. If columnNames is empty, all columns are used.
@@ -122,6 +123,15 @@ func (reader *Reader) Read() (record *Record, err error) {
 		return nil, io.EOF
 	}
 
+	if reader.columns == nil && reader.skipRowGroup != nil {
+		for reader.rowGroupIndex < len(reader.rowGroups) && reader.skipRowGroup(reader.rowGroups[reader.rowGroupIndex]) {
+			reader.rowGroupIndex++
+		}
+		if reader.rowGroupIndex >= len(reader.rowGroups) {
+			return nil, io.EOF
+		}
+	}
+
 	if reader.columns == nil {
 		reader.columns, err = getColumns(
 			reader.rowGroups[reader.rowGroupIndex],
@@ -153,6 +163,11 @@ func (reader *Reader) Read() (record *Record, err error) {
 	return record, nil
 }
 
+// SetRowGroupFilter - sets a function called before reading each row group, row groups for which it returns true are skipped.
+func (reader *Reader) SetRowGroupFilter(skip func(rowGroup *parquet.RowGroup) bool) {
+	reader.skipRowGroup = skip
+}
+
 // Close - closes underneath readers.
 func (reader *Reader) Close() (err error) {
 	for _, column := range reader.columns {
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/minio/minio-go/v7/pkg/set"
+	"github.com/minio/minio/pkg/s3select/internal/parquet-go/gen-go/parquet"
 )
 
 func getReader(name string, offset int64, length int64) (io.ReadCloser, error) {
@@ -88,3 +89,30 @@ func TestReader(t *testing.T) {
 
 	reader.Close()
 }
+
+func TestReaderSkipRowGroup(t *testing.T) {
+	name := "example.parquet"
+	reader, err := NewReader(
+		func(offset, length int64) (io.ReadCloser, error) {
+			return getReader(name, offset, length)
+		},
+		set.CreateStringSet("one", "two", "three"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	var skipped int
+	reader.SetRowGroupFilter(func(rowGroup *parquet.RowGroup) bool {
+		skipped++
+		return true
+	})
+
+	if _, err = reader.Read(); err != io.EOF {
+		t.Fatalf("expected: %v, got: %v", io.EOF, err)
+	}
+	if skipped != 1 {
+		t.Fatalf("expected 1 skipped row group, got %v", skipped)
+	}
+}
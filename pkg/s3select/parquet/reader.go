@@ -17,10 +17,14 @@
 package parquet
 
 import (
+	"encoding/binary"
 	"fmt"
 	"io"
+	"math"
+	"strings"
 
 	"github.com/bcicen/jstream"
+	"github.com/minio/minio-go/v7/pkg/set"
 	parquetgo "github.com/minio/minio/pkg/s3select/internal/parquet-go"
 	parquetgen "github.com/minio/minio/pkg/s3select/internal/parquet-go/gen-go/parquet"
 	jsonfmt "github.com/minio/minio/pkg/s3select/json"
@@ -99,13 +103,24 @@ func (r *Reader) Close() error {
 }
 
 // NewReader - creates new Parquet reader using readerFunc callback.
-func NewReader(getReaderFunc func(offset, length int64) (io.ReadCloser, error), args *ReaderArgs) (r *Reader, err error) {
+// Only the columns referenced by the statement are read and row
+// groups whose statistics show that no record can pass the statement's
+// WHERE clause are skipped.
+func NewReader(getReaderFunc func(offset, length int64) (io.ReadCloser, error), args *ReaderArgs, stmt *sql.SelectStatement) (r *Reader, err error) {
 	defer func() {
 		if rec := recover(); rec != nil {
 			err = fmt.Errorf("panic reading parquet header: %v", rec)
 		}
 	}()
-	reader, err := parquetgo.NewReader(getReaderFunc, nil)
+
+	var columnNames set.StringSet
+	if stmt != nil {
+		if columns, all := stmt.ReferencedColumns(); !all {
+			columnNames = set.CreateStringSet(columns...)
+		}
+	}
+
+	reader, err := parquetgo.NewReader(getReaderFunc, columnNames)
 	if err != nil {
 		if err != io.EOF {
 			return nil, errParquetParsingError(err)
@@ -114,8 +129,70 @@ func NewReader(getReaderFunc func(offset, length int64) (io.ReadCloser, error),
 		return nil, err
 	}
 
+	if stmt != nil {
+		reader.SetRowGroupFilter(func(rowGroup *parquetgen.RowGroup) bool {
+			return stmt.SkipBlock(func(column string) (min, max float64, ok bool) {
+				return columnStats(rowGroup, column)
+			})
+		})
+	}
+
 	return &Reader{
 		args:   args,
 		reader: reader,
 	}, nil
 }
+
+// columnStats returns the numeric minimum and maximum values of a
+// top level column in a row group, if its statistics are present.
+func columnStats(rowGroup *parquetgen.RowGroup, column string) (min, max float64, ok bool) {
+	for _, columnChunk := range rowGroup.GetColumns() {
+		meta := columnChunk.GetMetaData()
+		if meta == nil || strings.Join(meta.GetPathInSchema(), ".") != column {
+			continue
+		}
+		stats := meta.GetStatistics()
+		if stats == nil {
+			return 0, 0, false
+		}
+		minBytes, maxBytes := stats.GetMinValue(), stats.GetMaxValue()
+		if minBytes == nil || maxBytes == nil {
+			// Statistics written by older writers.
+			minBytes, maxBytes = stats.GetMin(), stats.GetMax()
+		}
+		var minOK, maxOK bool
+		min, minOK = decodeNumber(meta.GetType(), minBytes)
+		max, maxOK = decodeNumber(meta.GetType(), maxBytes)
+		return min, max, minOK && maxOK
+	}
+	return 0, 0, false
+}
+
+// Integers beyond this magnitude cannot be compared exactly as float64.
+const maxExactFloatInt = 1 << 53
+
+// decodeNumber decodes a plain encoded statistics value of a numeric
+// column.
+func decodeNumber(typ parquetgen.Type, b []byte) (float64, bool) {
+	var v float64
+	switch {
+	case typ == parquetgen.Type_INT32 && len(b) == 4:
+		v = float64(int32(binary.LittleEndian.Uint32(b)))
+	case typ == parquetgen.Type_INT64 && len(b) == 8:
+		i := int64(binary.LittleEndian.Uint64(b))
+		if i > maxExactFloatInt || i < -maxExactFloatInt {
+			return 0, false
+		}
+		v = float64(i)
+	case typ == parquetgen.Type_FLOAT && len(b) == 4:
+		v = float64(math.Float32frombits(binary.LittleEndian.Uint32(b)))
+	case typ == parquetgen.Type_DOUBLE && len(b) == 8:
+		v = math.Float64frombits(binary.LittleEndian.Uint64(b))
+	default:
+		return 0, false
+	}
+	if math.IsNaN(v) {
+		return 0, false
+	}
+	return v, true
+}
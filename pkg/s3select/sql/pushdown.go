@@ -0,0 +1,215 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sql
+
+import (
+	"reflect"
+)
+
+// ColumnStatsFunc returns the minimum and maximum numeric values of a
+// column in a block of records, ok is false if they are not known.
+type ColumnStatsFunc func(column string) (min, max float64, ok bool)
+
+// ReferencedColumns returns the names of the top level columns the
+// statement refers to in its select expressions and its WHERE
+// clause. If all is true every column is needed to evaluate the
+// statement, columns is then nil.
+func (e *SelectStatement) ReferencedColumns() (columns []string, all bool) {
+	if e.selectAST.Expression.All {
+		return nil, true
+	}
+
+	seen := make(map[string]struct{})
+	visit := func(p *JSONPath) bool {
+		column, ok := jsonPathColumn(p)
+		if !ok {
+			all = true
+			return false
+		}
+		if _, ok = seen[column]; !ok {
+			seen[column] = struct{}{}
+			columns = append(columns, column)
+		}
+		return true
+	}
+
+	if !walkJSONPaths(reflect.ValueOf(e.selectAST.Expression), visit) ||
+		!walkJSONPaths(reflect.ValueOf(e.selectAST.Where), visit) {
+		return nil, true
+	}
+
+	// Queries such as `SELECT COUNT(*) FROM S3Object` do not refer to
+	// any column, read them all to find the records.
+	if len(columns) == 0 {
+		return nil, true
+	}
+	return columns, false
+}
+
+// SkipBlock returns true if the statement's WHERE clause is false for
+// every record of a block of records, given the numeric column ranges
+// of the block. Only conjunctions of comparisons between a column and
+// a number are considered, for other clauses the block is never
+// skipped.
+func (e *SelectStatement) SkipBlock(stats ColumnStatsFunc) bool {
+	where := e.selectAST.Where
+	if where == nil || len(where.And) != 1 {
+		return false
+	}
+
+	for _, cond := range where.And[0].Condition {
+		if cond.Not != nil || cond.Operand == nil || cond.Operand.ConditionRHS == nil {
+			continue
+		}
+
+		rhs := cond.Operand.ConditionRHS
+		switch {
+		case rhs.Compare != nil:
+			op := rhs.Compare.Operator
+			column, ok := operandColumn(cond.Operand.Operand)
+			value, vok := operandNumber(rhs.Compare.Operand)
+			if !ok || !vok {
+				// Try `<number> <op> <column>` form.
+				column, ok = operandColumn(rhs.Compare.Operand)
+				value, vok = operandNumber(cond.Operand.Operand)
+				if !ok || !vok {
+					continue
+				}
+				op = flipCompareOp(op)
+			}
+			min, max, ok := stats(column)
+			if ok && !rangeMayCompare(min, max, op, value) {
+				return true
+			}
+		case rhs.Between != nil && !rhs.Between.Not:
+			column, ok := operandColumn(cond.Operand.Operand)
+			start, sok := operandNumber(rhs.Between.Start)
+			end, eok := operandNumber(rhs.Between.End)
+			if !ok || !sok || !eok {
+				continue
+			}
+			min, max, ok := stats(column)
+			if ok && (max < start || min > end) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// rangeMayCompare returns false if no value in [min, max] satisfies
+// `<value> <op> v`.
+func rangeMayCompare(min, max float64, op string, v float64) bool {
+	switch op {
+	case opEq:
+		return min <= v && v <= max
+	case opLt:
+		return min < v
+	case opLte:
+		return min <= v
+	case opGt:
+		return max > v
+	case opGte:
+		return max >= v
+	}
+	return true
+}
+
+// flipCompareOp returns the operator which gives the same result when
+// the operands of the comparison are swapped.
+func flipCompareOp(op string) string {
+	switch op {
+	case opLt:
+		return opGt
+	case opLte:
+		return opGte
+	case opGt:
+		return opLt
+	case opGte:
+		return opLte
+	}
+	return op
+}
+
+// jsonPathColumn returns the top level column a path expression
+// refers to, after stripping the table alias.
+func jsonPathColumn(p *JSONPath) (string, bool) {
+	if len(p.PathExpr) == 0 {
+		return p.BaseKey.String(), true
+	}
+	if p.PathExpr[0].Key == nil {
+		return "", false
+	}
+	return p.PathExpr[0].Key.keyString(), true
+}
+
+// operandColumn returns the column if the operand is a plain path
+// expression.
+func operandColumn(o *Operand) (string, bool) {
+	if o == nil || len(o.Right) > 0 || o.Left == nil || len(o.Left.Right) > 0 ||
+		o.Left.Left == nil || o.Left.Left.Primary == nil || o.Left.Left.Primary.JPathExpr == nil {
+		return "", false
+	}
+	return jsonPathColumn(o.Left.Left.Primary.JPathExpr)
+}
+
+// operandNumber returns the value if the operand is a, possibly
+// negated, number.
+func operandNumber(o *Operand) (float64, bool) {
+	if o == nil || len(o.Right) > 0 || o.Left == nil || len(o.Left.Right) > 0 || o.Left.Left == nil {
+		return 0, false
+	}
+	term, sign := o.Left.Left.Primary, 1.0
+	if o.Left.Left.Negated != nil {
+		term, sign = o.Left.Left.Negated.Term, -1.0
+	}
+	if term == nil || term.Value == nil || term.Value.Number == nil {
+		return 0, false
+	}
+	return sign * *term.Value.Number, true
+}
+
+var jsonPathType = reflect.TypeOf(&JSONPath{})
+
+// walkJSONPaths calls visit on every path expression found in the
+// given syntax tree node, it stops and returns false as soon as visit
+// returns false.
+func walkJSONPaths(v reflect.Value, visit func(*JSONPath) bool) bool {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return true
+		}
+		if v.Type() == jsonPathType {
+			return visit(v.Interface().(*JSONPath))
+		}
+		return walkJSONPaths(v.Elem(), visit)
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if !walkJSONPaths(v.Index(i), visit) {
+				return false
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if !walkJSONPaths(v.Field(i), visit) {
+				return false
+			}
+		}
+	}
+	return true
+}
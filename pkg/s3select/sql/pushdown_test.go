@@ -0,0 +1,98 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sql
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReferencedColumns(t *testing.T) {
+	testCases := []struct {
+		query   string
+		columns []string
+		all     bool
+	}{
+		{"SELECT * FROM S3Object", nil, true},
+		{"SELECT s.* FROM S3Object s", nil, true},
+		{"SELECT COUNT(*) FROM S3Object", nil, true},
+		{"SELECT one, two FROM S3Object", []string{"one", "two"}, false},
+		{"SELECT s.one, UPPER(s.two) FROM S3Object s WHERE s.three = true", []string{"one", "two", "three"}, false},
+		{"SELECT COUNT(*) FROM S3Object WHERE one > 1 AND one < 5", []string{"one"}, false},
+		{"SELECT s.\"one\", CAST(s['two'] AS INT) FROM S3Object s", []string{"one", "two"}, false},
+		{"SELECT one FROM S3Object s WHERE s[0] = 1", nil, true},
+	}
+
+	for i, testCase := range testCases {
+		stmt, err := ParseSelectStatement(testCase.query)
+		if err != nil {
+			t.Fatalf("case %d: %v", i+1, err)
+		}
+		columns, all := stmt.ReferencedColumns()
+		if all != testCase.all || !reflect.DeepEqual(columns, testCase.columns) {
+			t.Errorf("case %d: expected %v %v, got %v %v", i+1, testCase.columns, testCase.all, columns, all)
+		}
+	}
+}
+
+func TestSkipBlock(t *testing.T) {
+	// Block with one in [10, 20] and two in [-5, 5].
+	stats := func(column string) (min, max float64, ok bool) {
+		switch column {
+		case "one":
+			return 10, 20, true
+		case "two":
+			return -5, 5, true
+		}
+		return 0, 0, false
+	}
+
+	testCases := []struct {
+		query string
+		skip  bool
+	}{
+		{"SELECT * FROM S3Object", false},
+		{"SELECT * FROM S3Object WHERE one = 15", false},
+		{"SELECT * FROM S3Object WHERE one = 25", true},
+		{"SELECT * FROM S3Object WHERE one < 10", true},
+		{"SELECT * FROM S3Object WHERE one <= 10", false},
+		{"SELECT * FROM S3Object WHERE one > 20", true},
+		{"SELECT * FROM S3Object WHERE one >= 20", false},
+		{"SELECT * FROM S3Object WHERE 25 < one", true},
+		{"SELECT * FROM S3Object WHERE 25 > one", false},
+		{"SELECT * FROM S3Object s WHERE s.two < -5", true},
+		{"SELECT * FROM S3Object s WHERE s.two < -4", false},
+		{"SELECT * FROM S3Object WHERE one BETWEEN 21 AND 30", true},
+		{"SELECT * FROM S3Object WHERE one BETWEEN 0 AND 10", false},
+		{"SELECT * FROM S3Object WHERE one NOT BETWEEN 10 AND 20", false},
+		{"SELECT * FROM S3Object WHERE one > 15 AND two > 5", true},
+		{"SELECT * FROM S3Object WHERE one > 20 OR two > 0", false},
+		{"SELECT * FROM S3Object WHERE NOT one > 20", false},
+		{"SELECT * FROM S3Object WHERE one + 10 > 30", false},
+		{"SELECT * FROM S3Object WHERE three > 100", false},
+	}
+
+	for i, testCase := range testCases {
+		stmt, err := ParseSelectStatement(testCase.query)
+		if err != nil {
+			t.Fatalf("case %d: %v", i+1, err)
+		}
+		if skip := stmt.SkipBlock(stats); skip != testCase.skip {
+			t.Errorf("case %d: %s: expected %v, got %v", i+1, testCase.query, testCase.skip, skip)
+		}
+	}
+}